@@ -3,14 +3,19 @@ package cmd
 import (
 	"context"
 	"log"
+	"os"
 
 	"github.com/joho/godotenv"
+	"github.com/llascola/web-backend/internal/adapters/driving/jobs"
 	"github.com/llascola/web-backend/internal/adapters/driving/rest"
 	"github.com/llascola/web-backend/internal/app"
 	"github.com/llascola/web-backend/internal/config"
 	"github.com/spf13/cobra"
 )
 
+// devMode runs the server on in-memory repositories and storage, same as APP_MODE=memory
+var devMode bool
+
 var serveCmd = &cobra.Command{
 	Use:   "serve",
 	Short: "Start the server",
@@ -22,19 +27,49 @@ var serveCmd = &cobra.Command{
 
 		// Load Config
 		cfg := config.Load()
+		if devMode {
+			cfg.Mode = config.ModeMemory
+		}
 
 		// Initialize Application
 		application := app.NewApplication(cfg)
+		if cfg.Mode == config.ModeMemory {
+			seedDevAdmin(application)
+		}
 
 		// Initialize Router from rest package
 		r := rest.NewRouter(application, cfg)
 
+		ctx := context.Background()
+		if cfg.Storage.GCInterval > 0 {
+			go jobs.Every(ctx, "storage-gc", cfg.Storage.GCInterval, jobs.StorageGC(application.Service.StorageService, cfg.Storage.GCGracePeriod))
+		}
+		if cfg.Posts.SchedulerInterval > 0 {
+			go jobs.Every(ctx, "post-scheduler", cfg.Posts.SchedulerInterval, jobs.PublishScheduledPosts(application.Service.PostService))
+		}
+
 		// Run Server
 		server := rest.NewServer(r)
-		server.Run(context.Background())
+		server.Run(ctx)
 	},
 }
 
+// seedDevAdmin creates the admin from DEV_ADMIN_EMAIL and DEV_ADMIN_PASSWORD, in memory mode
+// there is no database for create-admin to write to
+func seedDevAdmin(application *app.Application) {
+	email, password := os.Getenv("DEV_ADMIN_EMAIL"), os.Getenv("DEV_ADMIN_PASSWORD")
+	if email == "" || password == "" {
+		log.Println("DEV_ADMIN_EMAIL or DEV_ADMIN_PASSWORD not set, no admin user was created")
+		return
+	}
+	if err := application.Service.AuthService.RegisterAdmin(context.Background(), email, password); err != nil {
+		log.Fatalf("Failed to create admin user: %v", err)
+	}
+	log.Printf("Admin user created: %s", email)
+}
+
 func init() {
+	serveCmd.Flags().BoolVar(&devMode, "dev", false, "Run without Postgres or MinIO, all data is kept in memory")
+
 	rootCmd.AddCommand(serveCmd)
 }
@@ -0,0 +1,173 @@
+package cmd
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/llascola/web-backend/internal/app"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// progressEvery is how many objects pass between progress lines
+const progressEvery = 100
+
+var (
+	migrateFrom    string
+	migrateTo      string
+	migrateOptions domain.MigrationOptions
+)
+
+var gcOptions domain.GCOptions
+
+var storageCmd = &cobra.Command{
+	Use:   "storage",
+	Short: "Maintain the stored files",
+}
+
+var storageMigrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Copy every stored file to another storage driver",
+	Long: `Copies every object from one storage driver to another and verifies each copy by its SHA-256.
+Objects already at the destination with the same checksum are skipped, so an interrupted
+migration can be run again, or resumed faster with --start-after.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		// Load .env file
+		if err := godotenv.Load(); err != nil {
+			log.Println("No .env file found")
+		}
+		if migrateFrom == migrateTo {
+			log.Fatalf("--from and --to are both %q", migrateFrom)
+		}
+
+		// Load Config, the source is the storage the application runs on
+		cfg := config.Load()
+		cfg.Storage.Driver = migrateFrom
+		application := app.NewApplication(cfg)
+		dest := app.NewObjectStore(cfg, migrateTo)
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+
+		if migrateOptions.DryRun {
+			log.Printf("Dry run, nothing is written")
+		}
+		report, err := application.Service.StorageService.Migrate(ctx, dest, migrateOptions, func(r domain.MigrationReport) {
+			if visited := r.Copied + r.Skipped + len(r.Failures); visited%progressEvery == 0 {
+				log.Printf("%d objects, %d copied (%d bytes), %d skipped, %d failed, last %s",
+					visited, r.Copied, r.Bytes, r.Skipped, len(r.Failures), r.Last)
+			}
+		})
+
+		for _, f := range report.Failures {
+			log.Printf("Failed %s: %v", f.Name, f.Err)
+		}
+		log.Printf("Copied %d objects (%d bytes), skipped %d, failed %d, rewrote %d image URLs",
+			report.Copied, report.Bytes, report.Skipped, len(report.Failures), report.URLsRewritten)
+		if err != nil {
+			log.Fatalf("Migration stopped: %v, resume with --start-after %q", err, report.Last)
+		}
+		if len(report.Failures) > 0 {
+			os.Exit(1)
+		}
+	},
+}
+
+var storageGCCmd = &cobra.Command{
+	Use:   "gc",
+	Short: "Report and delete stored files nothing refers to",
+	Long: `Compares the stored objects with the image, blob and upload records. Objects nothing refers to
+are orphans and are deleted once older than the grace period, objects a record refers to but that
+are absent are reported as missing. Expired upload records are removed as well.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		// Load .env file
+		if err := godotenv.Load(); err != nil {
+			log.Println("No .env file found")
+		}
+
+		// Load Config
+		cfg := config.Load()
+		application := app.NewApplication(cfg)
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+
+		report, err := application.Service.StorageService.CollectGarbage(ctx, gcOptions)
+		if err != nil {
+			log.Fatalf("Garbage collection failed: %v", err)
+		}
+
+		for _, name := range report.Orphans {
+			log.Printf("Orphan %s", name)
+		}
+		for _, name := range report.Missing {
+			log.Printf("Missing %s", name)
+		}
+		for _, f := range report.Failures {
+			log.Printf("Failed %s: %v", f.Name, f.Err)
+		}
+		log.Printf("Scanned %d objects: %d orphans (%d bytes), %d deleted (%d bytes), %d missing, %d expired uploads removed",
+			report.Scanned, len(report.Orphans), report.OrphanBytes, report.Deleted, report.DeletedBytes, len(report.Missing), report.ExpiredUploads)
+		if gcOptions.DryRun {
+			log.Printf("Dry run, nothing was deleted")
+		}
+		if len(report.Failures) > 0 {
+			os.Exit(1)
+		}
+	},
+}
+
+var storageRelocateCmd = &cobra.Command{
+	Use:   "relocate",
+	Short: "Move image files stored at the bucket root under public/ or private/",
+	Long: `Images uploaded before visibility prefixes existed are stored at the root of the bucket, where the
+default bucket policy no longer exposes them. Each file is copied under the prefix of its images and
+verified by its SHA-256 before the records point at the copy, an interrupted run can be repeated.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		// Load .env file
+		if err := godotenv.Load(); err != nil {
+			log.Println("No .env file found")
+		}
+
+		// Load Config
+		cfg := config.Load()
+		application := app.NewApplication(cfg)
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+
+		report, err := application.Service.StorageService.RelocateLegacyImages(ctx)
+		for _, f := range report.Failures {
+			log.Printf("Failed %s: %v", f.Name, f.Err)
+		}
+		log.Printf("Relocated %d images, failed %d files", report.Moved, len(report.Failures))
+		if err != nil {
+			log.Fatalf("Relocation stopped: %v", err)
+		}
+		if len(report.Failures) > 0 {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	storageMigrateCmd.Flags().StringVar(&migrateFrom, "from", "minio", "Source storage driver (minio or fs)")
+	storageMigrateCmd.Flags().StringVar(&migrateTo, "to", "fs", "Destination storage driver (minio or fs)")
+	storageMigrateCmd.Flags().StringVar(&migrateOptions.Prefix, "prefix", "", "Only migrate objects whose name starts with this")
+	storageMigrateCmd.Flags().StringVar(&migrateOptions.StartAfter, "start-after", "", "Skip objects up to and including this name")
+	storageMigrateCmd.Flags().BoolVar(&migrateOptions.DryRun, "dry-run", false, "Report what would be copied without writing anything")
+	storageMigrateCmd.Flags().BoolVar(&migrateOptions.RewriteURLs, "rewrite-urls", false, "Point image records at the destination")
+
+	storageGCCmd.Flags().DurationVar(&gcOptions.GracePeriod, "grace", 24*time.Hour, "Keep orphans younger than this")
+	storageGCCmd.Flags().BoolVar(&gcOptions.DryRun, "dry-run", false, "Report without deleting anything")
+
+	storageCmd.AddCommand(storageMigrateCmd)
+	storageCmd.AddCommand(storageGCCmd)
+	storageCmd.AddCommand(storageRelocateCmd)
+	rootCmd.AddCommand(storageCmd)
+}
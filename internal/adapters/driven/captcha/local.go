@@ -0,0 +1,41 @@
+package captcha
+
+import (
+	"context"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+// FakeToken is the only token the fake verifier accepts
+const FakeToken = "fake-captcha-token"
+
+var (
+	_ outports.CaptchaVerifier = (*Fake)(nil)
+	_ outports.CaptchaVerifier = (*Disabled)(nil)
+)
+
+// Fake stands in for a provider in tests and local development, without network access
+type Fake struct{}
+
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+func (f *Fake) Verify(ctx context.Context, token, remoteIP string) error {
+	if token != FakeToken {
+		return domain.ErrCaptchaFailed
+	}
+	return nil
+}
+
+// Disabled accepts every submission, for sites relying on the honeypot and rate limits alone
+type Disabled struct{}
+
+func NewDisabled() *Disabled {
+	return &Disabled{}
+}
+
+func (d *Disabled) Verify(ctx context.Context, token, remoteIP string) error {
+	return nil
+}
@@ -0,0 +1,79 @@
+// Package captcha checks the challenges solved by visitors before they write to the site
+package captcha
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+// Verification endpoints of the supported providers, they share the same protocol
+var Providers = map[string]string{
+	"turnstile": "https://challenges.cloudflare.com/turnstile/v0/siteverify",
+	"hcaptcha":  "https://api.hcaptcha.com/siteverify",
+	"recaptcha": "https://www.google.com/recaptcha/api/siteverify",
+}
+
+// requestTimeout bounds a verification when the context has no deadline
+const requestTimeout = 10 * time.Second
+
+var _ outports.CaptchaVerifier = (*SiteVerifier)(nil)
+
+// SiteVerifier posts tokens to the siteverify endpoint of a provider
+type SiteVerifier struct {
+	endpoint string
+	secret   string
+	client   *http.Client
+}
+
+func NewSiteVerifier(endpoint, secret string) *SiteVerifier {
+	return &SiteVerifier{
+		endpoint: endpoint,
+		secret:   secret,
+		client:   &http.Client{Timeout: requestTimeout},
+	}
+}
+
+type siteverifyResponse struct {
+	Success    bool     `json:"success"`
+	ErrorCodes []string `json:"error-codes"`
+}
+
+func (v *SiteVerifier) Verify(ctx context.Context, token, remoteIP string) error {
+	if token == "" {
+		return domain.ErrCaptchaFailed
+	}
+	form := url.Values{"secret": {v.secret}, "response": {token}}
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("captcha verification: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("captcha verification: unexpected status %s", resp.Status)
+	}
+	var result siteverifyResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("captcha verification: %w", err)
+	}
+	if !result.Success {
+		return fmt.Errorf("%w: %s", domain.ErrCaptchaFailed, strings.Join(result.ErrorCodes, ", "))
+	}
+	return nil
+}
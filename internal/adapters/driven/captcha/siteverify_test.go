@@ -0,0 +1,50 @@
+package captcha_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/llascola/web-backend/internal/adapters/driven/captcha"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSiteVerifier(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "secret", r.PostForm.Get("secret"))
+		assert.Equal(t, "203.0.113.7", r.PostForm.Get("remoteip"))
+		if r.PostForm.Get("response") == "solved" {
+			json.NewEncoder(w).Encode(map[string]any{"success": true})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{"success": false, "error-codes": []string{"invalid-input-response"}})
+	}))
+	defer server.Close()
+
+	verifier := captcha.NewSiteVerifier(server.URL, "secret")
+	ctx := context.Background()
+	assert.NoError(t, verifier.Verify(ctx, "solved", "203.0.113.7"))
+
+	err := verifier.Verify(ctx, "forged", "203.0.113.7")
+	assert.ErrorIs(t, err, domain.ErrCaptchaFailed)
+	assert.ErrorContains(t, err, "invalid-input-response")
+
+	assert.ErrorIs(t, verifier.Verify(ctx, "", "203.0.113.7"), domain.ErrCaptchaFailed)
+}
+
+func TestSiteVerifierUnavailable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	// An outage is not the visitor's fault, it must not read as a failed challenge
+	err := captcha.NewSiteVerifier(server.URL, "secret").Verify(context.Background(), "solved", "")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, domain.ErrCaptchaFailed)
+}
@@ -0,0 +1,107 @@
+package imaging
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"image"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+// contentTypesByFormat maps the names registered in the image package to MIME types
+var contentTypesByFormat = map[string]string{
+	"jpeg": "image/jpeg",
+	"png":  "image/png",
+	"webp": "image/webp",
+}
+
+var pngTrailer = []byte{0, 0, 0, 0, 'I', 'E', 'N', 'D', 0xAE, 0x42, 0x60, 0x82}
+
+// markupSignatures are never part of legitimate image data but make a file executable by browsers or servers
+var markupSignatures = [][]byte{
+	[]byte("<script"),
+	[]byte("<html"),
+	[]byte("<svg"),
+	[]byte("<?php"),
+	[]byte("<!doctype"),
+}
+
+func (p *Processor) Inspect(ctx context.Context, data []byte) (domain.ImageInfo, error) {
+	// DecodeConfig only reads the header, so dimensions are known before allocating pixels
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return domain.ImageInfo{}, domain.ErrInvalidFormat
+	}
+
+	contentType, ok := contentTypesByFormat[format]
+	if !ok {
+		return domain.ImageInfo{}, domain.ErrInvalidFormat
+	}
+
+	if cfg.Width <= 0 || cfg.Height <= 0 {
+		return domain.ImageInfo{}, domain.ErrInvalidFormat
+	}
+	if int64(cfg.Width)*int64(cfg.Height) > domain.MaxImagePixels {
+		return domain.ImageInfo{}, domain.ErrImageTooManyPixels
+	}
+
+	if _, _, err := image.Decode(bytes.NewReader(data)); err != nil {
+		return domain.ImageInfo{}, domain.ErrInvalidFormat
+	}
+
+	if hasTrailingData(format, data) || hasMarkup(data) {
+		return domain.ImageInfo{}, domain.ErrSuspiciousImage
+	}
+
+	length := len(data)
+	if format == "jpeg" {
+		length = jpegLength(data)
+	}
+
+	return domain.ImageInfo{
+		ContentType: contentType,
+		Width:       cfg.Width,
+		Height:      cfg.Height,
+		Length:      int64(length),
+	}, nil
+}
+
+// hasTrailingData reports whether something was appended after the end of the image stream
+func hasTrailingData(format string, data []byte) bool {
+	switch format {
+	case "png":
+		return !bytes.HasSuffix(data, pngTrailer)
+	case "jpeg":
+		// Phones append data after the end marker, e.g. the video of Google motion photos or
+		// Samsung SEF trailers. Length leaves it out, only markup in it is refused.
+		return false
+	case "webp":
+		// RIFF header: "RIFF" + little endian payload size + "WEBP"
+		if len(data) < 12 {
+			return true
+		}
+		size := int(binary.LittleEndian.Uint32(data[4:8]))
+		return len(data) > size+8
+	}
+	return true
+}
+
+// jpegLength returns the size of the JPEG stream, without whatever was appended after EOI
+func jpegLength(data []byte) int {
+	_, scan, err := splitJPEG(data)
+	if err != nil {
+		return len(data)
+	}
+	return len(data) - len(scan) + jpegStreamEnd(scan)
+}
+
+func hasMarkup(data []byte) bool {
+	lower := bytes.ToLower(data)
+	for _, sig := range markupSignatures {
+		if bytes.Contains(lower, sig) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package imaging_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/llascola/web-backend/internal/adapters/driven/imaging"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func encodePNG(t *testing.T, w, h int) []byte {
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, w, h))))
+	return buf.Bytes()
+}
+
+func TestInspectDetectsRealType(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 30, 20)), nil))
+
+	info, err := imaging.NewProcessor().Inspect(context.Background(), buf.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, domain.ImageInfo{ContentType: "image/jpeg", Width: 30, Height: 20, Length: int64(buf.Len())}, info)
+}
+
+func TestInspectRejectsNonImages(t *testing.T) {
+	_, err := imaging.NewProcessor().Inspect(context.Background(), []byte("<html><body>hi</body></html>"))
+	assert.ErrorIs(t, err, domain.ErrInvalidFormat)
+}
+
+func TestInspectRejectsPolyglots(t *testing.T) {
+	p := imaging.NewProcessor()
+
+	appended := append(encodePNG(t, 10, 10), []byte("PK\x03\x04 zip payload")...)
+	_, err := p.Inspect(context.Background(), appended)
+	assert.ErrorIs(t, err, domain.ErrSuspiciousImage)
+}
+
+func TestInspectRejectsDecompressionBombs(t *testing.T) {
+	// Rewrite the IHDR dimensions of a tiny PNG, the header is all Inspect needs to refuse it
+	data := encodePNG(t, 1, 1)
+	binary.BigEndian.PutUint32(data[16:20], 100_000)
+	binary.BigEndian.PutUint32(data[20:24], 100_000)
+	binary.BigEndian.PutUint32(data[29:33], crc32.ChecksumIEEE(data[12:29]))
+
+	_, err := imaging.NewProcessor().Inspect(context.Background(), data)
+	assert.ErrorIs(t, err, domain.ErrImageTooManyPixels)
+}
+
+func TestInspectAcceptsJPEGTrailers(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 30, 20)), nil))
+	data := buf.Bytes()
+	// What a motion photo appends: an MP4 after the end of the JPEG stream
+	withTrailer := append(append([]byte{}, data...), []byte("\x00\x00\x00\x18ftypmp42 video data")...)
+
+	p := imaging.NewProcessor()
+	info, err := p.Inspect(context.Background(), withTrailer)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(data)), info.Length)
+
+	stripped, err := p.StripMetadata(context.Background(), withTrailer, false)
+	assert.NoError(t, err)
+	assert.Equal(t, data, stripped)
+
+	_, err = p.Inspect(context.Background(), append(append([]byte{}, data...), []byte("<script>alert(1)</script>")...))
+	assert.ErrorIs(t, err, domain.ErrSuspiciousImage)
+}
@@ -0,0 +1,435 @@
+package imaging
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/jpeg"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+	"golang.org/x/image/draw"
+)
+
+const reencodeQuality = 92
+
+var errMalformed = errors.New("malformed image metadata")
+
+// StripMetadata applies the EXIF orientation to the pixels and removes EXIF, XMP, ICC and
+// comment metadata. With keepCopyright the copyright and artist/author fields survive.
+func (p *Processor) StripMetadata(ctx context.Context, data []byte, keepCopyright bool) ([]byte, error) {
+	_, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, domain.ErrInvalidFormat
+	}
+
+	switch format {
+	case "jpeg":
+		return stripJPEG(data, keepCopyright)
+	case "png":
+		return stripPNG(data, keepCopyright)
+	case "webp":
+		return stripWebP(data)
+	}
+	return nil, domain.ErrInvalidFormat
+}
+
+// --- JPEG ---
+
+type jpegSegment struct {
+	marker byte
+	data   []byte // Whole segment, marker included
+}
+
+var exifHeader = []byte("Exif\x00\x00")
+
+// stripJPEG keeps only the segments needed to decode the image.
+// APP0 (JFIF) and APP14 (Adobe color transform) are kept, other APPn and COM are dropped.
+func stripJPEG(data []byte, keepCopyright bool) ([]byte, error) {
+	segments, scan, err := splitJPEG(data)
+	if err != nil {
+		return nil, err
+	}
+
+	orientation := 1
+	var copyrightSegment []byte
+	for _, seg := range segments {
+		if seg.marker == 0xE1 && bytes.HasPrefix(seg.data[4:], exifHeader) {
+			tiff := seg.data[4+len(exifHeader):]
+			orientation = exifOrientation(tiff)
+			if keepCopyright {
+				copyrightSegment = copyrightExif(tiff)
+			}
+			break
+		}
+	}
+
+	if orientation > 1 && orientation <= 8 {
+		return reorientJPEG(data, orientation, copyrightSegment)
+	}
+
+	var out bytes.Buffer
+	out.Write([]byte{0xFF, 0xD8})
+	for _, seg := range segments {
+		switch {
+		case seg.marker == 0xE0 || seg.marker == 0xEE:
+			out.Write(seg.data)
+		case seg.marker == 0xE1 && copyrightSegment != nil && bytes.HasPrefix(seg.data[4:], exifHeader):
+			out.Write(copyrightSegment)
+			copyrightSegment = nil
+		case seg.marker >= 0xE0 && seg.marker <= 0xEF, seg.marker == 0xFE:
+			// Application data and comments
+		default:
+			out.Write(seg.data)
+		}
+	}
+	out.Write(scan[:jpegStreamEnd(scan)])
+	return out.Bytes(), nil
+}
+
+// jpegStreamEnd returns where the image ends in scan, just after EOI. Marker segments between
+// the scans of progressive images are skipped whole, so their payload cannot end the image.
+func jpegStreamEnd(scan []byte) int {
+	pos := 0
+	for pos+1 < len(scan) {
+		if scan[pos] != 0xFF { // Entropy coded data
+			pos++
+			continue
+		}
+		marker := scan[pos+1]
+		switch {
+		case marker == 0xD9: // End of image
+			return pos + 2
+		case marker == 0x00, marker == 0xFF, marker >= 0xD0 && marker <= 0xD7:
+			// Stuffed zero, fill byte or restart marker, still entropy coded data
+			pos++
+		default:
+			if pos+4 > len(scan) {
+				return len(scan)
+			}
+			pos += 2 + int(binary.BigEndian.Uint16(scan[pos+2:]))
+		}
+	}
+	return len(scan)
+}
+
+// splitJPEG returns the marker segments before the first scan and the remaining bytes from SOS on
+func splitJPEG(data []byte) ([]jpegSegment, []byte, error) {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil, nil, errMalformed
+	}
+
+	var segments []jpegSegment
+	pos := 2
+	for pos+4 <= len(data) {
+		if data[pos] != 0xFF {
+			return nil, nil, errMalformed
+		}
+		marker := data[pos+1]
+		if marker == 0xFF { // Fill byte
+			pos++
+			continue
+		}
+		if marker == 0xDA { // Start of scan, entropy coded data follows
+			return segments, data[pos:], nil
+		}
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		end := pos + 2 + length
+		if length < 2 || end > len(data) {
+			return nil, nil, errMalformed
+		}
+		segments = append(segments, jpegSegment{marker: marker, data: data[pos:end]})
+		pos = end
+	}
+	return nil, nil, errMalformed
+}
+
+// reorientJPEG decodes the image, rotates the pixels upright and encodes it without metadata
+func reorientJPEG(data []byte, orientation int, copyrightSegment []byte) ([]byte, error) {
+	img, err := jpeg.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, domain.ErrInvalidFormat
+	}
+
+	var encoded bytes.Buffer
+	if err := jpeg.Encode(&encoded, orient(img, orientation), &jpeg.Options{Quality: reencodeQuality}); err != nil {
+		return nil, err
+	}
+	if copyrightSegment == nil {
+		return encoded.Bytes(), nil
+	}
+
+	out := encoded.Bytes()
+	result := make([]byte, 0, len(out)+len(copyrightSegment))
+	result = append(result, out[:2]...) // SOI
+	result = append(result, copyrightSegment...)
+	return append(result, out[2:]...), nil
+}
+
+// orient transforms img so that EXIF orientation 1 applies
+func orient(img image.Image, orientation int) image.Image {
+	b := img.Bounds()
+	src := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(src, src.Bounds(), img, b.Min, draw.Src)
+
+	w, h := b.Dx(), b.Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+
+	for sy := 0; sy < h; sy++ {
+		for sx := 0; sx < w; sx++ {
+			var dx, dy int
+			switch orientation {
+			case 2: // Mirror horizontal
+				dx, dy = w-1-sx, sy
+			case 3: // Rotate 180
+				dx, dy = w-1-sx, h-1-sy
+			case 4: // Mirror vertical
+				dx, dy = sx, h-1-sy
+			case 5: // Transpose
+				dx, dy = sy, sx
+			case 6: // Rotate 90 CW
+				dx, dy = h-1-sy, sx
+			case 7: // Transverse
+				dx, dy = h-1-sy, w-1-sx
+			case 8: // Rotate 270 CW
+				dx, dy = sy, w-1-sx
+			default:
+				dx, dy = sx, sy
+			}
+			copy(dst.Pix[dst.PixOffset(dx, dy):][:4], src.Pix[src.PixOffset(sx, sy):][:4])
+		}
+	}
+	return dst
+}
+
+// --- EXIF (TIFF structure) ---
+
+const (
+	tagOrientation = 0x0112
+	tagArtist      = 0x013B
+	tagCopyright   = 0x8298
+
+	tiffShort = 3
+	tiffASCII = 2
+)
+
+type ifdEntry struct {
+	tag   uint16
+	typ   uint16
+	count uint32
+	value []byte // Raw 4 byte value field
+}
+
+// readIFD0 parses the first IFD of a TIFF block, returning its entries and byte order
+func readIFD0(tiff []byte) ([]ifdEntry, binary.ByteOrder, bool) {
+	if len(tiff) < 8 {
+		return nil, nil, false
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return nil, nil, false
+	}
+
+	offset := int(order.Uint32(tiff[4:8]))
+	if offset+2 > len(tiff) {
+		return nil, nil, false
+	}
+	count := int(order.Uint16(tiff[offset:]))
+	entries := make([]ifdEntry, 0, count)
+	for i := 0; i < count; i++ {
+		start := offset + 2 + i*12
+		if start+12 > len(tiff) {
+			break
+		}
+		e := tiff[start : start+12]
+		entries = append(entries, ifdEntry{
+			tag:   order.Uint16(e[0:2]),
+			typ:   order.Uint16(e[2:4]),
+			count: order.Uint32(e[4:8]),
+			value: e[8:12],
+		})
+	}
+	return entries, order, true
+}
+
+func exifOrientation(tiff []byte) int {
+	entries, order, ok := readIFD0(tiff)
+	if !ok {
+		return 1
+	}
+	for _, e := range entries {
+		if e.tag == tagOrientation && e.typ == tiffShort {
+			return int(order.Uint16(e.value))
+		}
+	}
+	return 1
+}
+
+// exifString reads an ASCII entry, inline when it fits in 4 bytes, otherwise at its offset
+func exifString(tiff []byte, order binary.ByteOrder, e ifdEntry) (string, bool) {
+	if e.typ != tiffASCII || e.count == 0 {
+		return "", false
+	}
+	raw := e.value
+	if e.count > 4 {
+		offset := int(order.Uint32(e.value))
+		if offset+int(e.count) > len(tiff) {
+			return "", false
+		}
+		raw = tiff[offset : offset+int(e.count)]
+	}
+	return string(bytes.TrimRight(raw[:min(int(e.count), len(raw))], "\x00")), true
+}
+
+// copyrightExif builds a minimal APP1 segment holding only the artist and copyright of the original
+func copyrightExif(tiff []byte) []byte {
+	entries, order, ok := readIFD0(tiff)
+	if !ok {
+		return nil
+	}
+
+	var kept []ifdEntry
+	var values [][]byte
+	for _, e := range entries {
+		if e.tag != tagArtist && e.tag != tagCopyright {
+			continue
+		}
+		if s, ok := exifString(tiff, order, e); ok && s != "" {
+			kept = append(kept, ifdEntry{tag: e.tag, typ: tiffASCII, count: uint32(len(s) + 1)})
+			values = append(values, append([]byte(s), 0))
+		}
+	}
+	if len(kept) == 0 {
+		return nil
+	}
+
+	le := binary.LittleEndian
+	var t bytes.Buffer
+	t.Write([]byte{'I', 'I', 0x2A, 0x00})
+	binary.Write(&t, le, uint32(8))
+	binary.Write(&t, le, uint16(len(kept)))
+
+	dataOffset := 8 + 2 + len(kept)*12 + 4
+	var data bytes.Buffer
+	for i, e := range kept {
+		binary.Write(&t, le, e.tag)
+		binary.Write(&t, le, e.typ)
+		binary.Write(&t, le, e.count)
+		if len(values[i]) <= 4 {
+			var inline [4]byte
+			copy(inline[:], values[i])
+			t.Write(inline[:])
+		} else {
+			binary.Write(&t, le, uint32(dataOffset+data.Len()))
+			data.Write(values[i])
+		}
+	}
+	binary.Write(&t, le, uint32(0)) // No next IFD
+	t.Write(data.Bytes())
+
+	payload := append(append([]byte{}, exifHeader...), t.Bytes()...)
+	if len(payload)+2 > 0xFFFF {
+		return nil
+	}
+	segment := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	return append(segment, payload...)
+}
+
+// --- PNG ---
+
+// pngKeptChunks are the critical chunks plus ancillary chunks that only affect rendering
+var pngKeptChunks = map[string]bool{
+	"IHDR": true, "PLTE": true, "IDAT": true, "IEND": true,
+	"tRNS": true, "gAMA": true, "cHRM": true, "sRGB": true, "sBIT": true, "bKGD": true, "pHYs": true,
+}
+
+func stripPNG(data []byte, keepCopyright bool) ([]byte, error) {
+	const signatureLen = 8
+	if len(data) < signatureLen {
+		return nil, errMalformed
+	}
+
+	out := bytes.NewBuffer(append([]byte{}, data[:signatureLen]...))
+	pos := signatureLen
+	for pos+12 <= len(data) {
+		length := int(binary.BigEndian.Uint32(data[pos:]))
+		end := pos + 12 + length
+		if length < 0 || end > len(data) {
+			return nil, errMalformed
+		}
+		typ := string(data[pos+4 : pos+8])
+		chunkData := data[pos+8 : pos+8+length]
+
+		if pngKeptChunks[typ] || (keepCopyright && typ == "tEXt" && isCopyrightText(chunkData)) {
+			out.Write(data[pos:end])
+		}
+		pos = end
+		if typ == "IEND" {
+			break
+		}
+	}
+	return out.Bytes(), nil
+}
+
+// isCopyrightText reports whether a tEXt chunk uses one of the registered authorship keywords
+func isCopyrightText(chunk []byte) bool {
+	keyword, _, _ := bytes.Cut(chunk, []byte{0})
+	switch string(keyword) {
+	case "Copyright", "Author":
+		return true
+	}
+	return false
+}
+
+// --- WebP ---
+
+const (
+	vp8xFlagICC  = 0x20
+	vp8xFlagEXIF = 0x08
+	vp8xFlagXMP  = 0x04
+)
+
+func stripWebP(data []byte) ([]byte, error) {
+	if len(data) < 12 || string(data[:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return nil, errMalformed
+	}
+
+	var body bytes.Buffer
+	body.WriteString("WEBP")
+	pos := 12
+	for pos+8 <= len(data) {
+		fourcc := string(data[pos : pos+4])
+		size := int(binary.LittleEndian.Uint32(data[pos+4:]))
+		end := pos + 8 + size + size%2
+		if end > len(data) {
+			return nil, errMalformed
+		}
+
+		switch fourcc {
+		case "EXIF", "XMP ", "ICCP":
+		case "VP8X":
+			chunk := append([]byte{}, data[pos:end]...)
+			chunk[8] &^= vp8xFlagICC | vp8xFlagEXIF | vp8xFlagXMP
+			body.Write(chunk)
+		default:
+			body.Write(data[pos:end])
+		}
+		pos = end
+	}
+
+	out := make([]byte, 8, 8+body.Len())
+	copy(out, "RIFF")
+	binary.LittleEndian.PutUint32(out[4:], uint32(body.Len()))
+	return append(out, body.Bytes()...), nil
+}
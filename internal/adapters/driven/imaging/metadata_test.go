@@ -0,0 +1,74 @@
+package imaging_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"image"
+	"image/jpeg"
+	"testing"
+
+	"github.com/llascola/web-backend/internal/adapters/driven/imaging"
+	"github.com/stretchr/testify/assert"
+)
+
+// exifSegment builds an APP1 segment with an orientation and a copyright entry
+func exifSegment(orientation uint16, copyright string) []byte {
+	le := binary.LittleEndian
+	var t bytes.Buffer
+	t.Write([]byte{'I', 'I', 0x2A, 0x00})
+	binary.Write(&t, le, uint32(8))
+	binary.Write(&t, le, uint16(2))
+	// Orientation, SHORT inline
+	binary.Write(&t, le, uint16(0x0112))
+	binary.Write(&t, le, uint16(3))
+	binary.Write(&t, le, uint32(1))
+	binary.Write(&t, le, uint32(orientation))
+	// Copyright, ASCII after the IFD
+	binary.Write(&t, le, uint16(0x8298))
+	binary.Write(&t, le, uint16(2))
+	binary.Write(&t, le, uint32(len(copyright)+1))
+	binary.Write(&t, le, uint32(8+2+2*12+4))
+	binary.Write(&t, le, uint32(0))
+	t.WriteString(copyright + "\x00")
+
+	payload := append([]byte("Exif\x00\x00"), t.Bytes()...)
+	seg := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(seg[2:], uint16(len(payload)+2))
+	return append(seg, payload...)
+}
+
+func jpegWithExif(t *testing.T, w, h int, orientation uint16) []byte {
+	var buf bytes.Buffer
+	assert.NoError(t, jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, w, h)), nil))
+	data := buf.Bytes()
+	return append(append(append([]byte{}, data[:2]...), exifSegment(orientation, "Jane Doe")...), data[2:]...)
+}
+
+func TestStripMetadataRemovesExif(t *testing.T) {
+	data := jpegWithExif(t, 40, 20, 1)
+
+	out, err := imaging.NewProcessor().StripMetadata(context.Background(), data, false)
+	assert.NoError(t, err)
+	assert.False(t, bytes.Contains(out, []byte("Exif")))
+	assert.False(t, bytes.Contains(out, []byte("Jane Doe")))
+
+	_, err = jpeg.Decode(bytes.NewReader(out))
+	assert.NoError(t, err)
+}
+
+func TestStripMetadataKeepsCopyright(t *testing.T) {
+	out, err := imaging.NewProcessor().StripMetadata(context.Background(), jpegWithExif(t, 40, 20, 1), true)
+	assert.NoError(t, err)
+	assert.True(t, bytes.Contains(out, []byte("Jane Doe")))
+}
+
+func TestStripMetadataAppliesOrientation(t *testing.T) {
+	out, err := imaging.NewProcessor().StripMetadata(context.Background(), jpegWithExif(t, 40, 20, 6), false)
+	assert.NoError(t, err)
+
+	cfg, err := jpeg.DecodeConfig(bytes.NewReader(out))
+	assert.NoError(t, err)
+	assert.Equal(t, 20, cfg.Width)
+	assert.Equal(t, 40, cfg.Height)
+}
@@ -0,0 +1,77 @@
+package imaging
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+
+	"github.com/buckket/go-blurhash"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"golang.org/x/image/draw"
+)
+
+// previewSize bounds the thumbnail the placeholders are computed from, BlurHash cost grows with the pixel count
+const previewSize = 64
+
+func (p *Processor) Preview(ctx context.Context, data []byte) (domain.ImagePreview, error) {
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return domain.ImagePreview{}, fmt.Errorf("decoding image: %w", err)
+	}
+
+	bounds := img.Bounds()
+	w, h := containSize(bounds.Dx(), bounds.Dy(), previewSize, previewSize)
+	thumb := image.NewNRGBA(image.Rect(0, 0, w, h))
+	draw.ApproxBiLinear.Scale(thumb, thumb.Bounds(), img, bounds, draw.Src, nil)
+
+	// More components along the longer side keep the hash balanced
+	xComponents, yComponents := 4, 3
+	if h > w {
+		xComponents, yComponents = 3, 4
+	}
+	hash, err := blurhash.Encode(xComponents, yComponents, thumb)
+	if err != nil {
+		return domain.ImagePreview{}, fmt.Errorf("encoding blurhash: %w", err)
+	}
+
+	return domain.ImagePreview{
+		Width:         bounds.Dx(),
+		Height:        bounds.Dy(),
+		BlurHash:      hash,
+		DominantColor: dominantColor(thumb),
+	}, nil
+}
+
+// dominantColor buckets the opaque pixels into a coarse palette and averages the most common bucket.
+// Averaging the whole image instead tends to produce a muddy grey.
+func dominantColor(img *image.NRGBA) string {
+	type bucket struct {
+		count   int
+		r, g, b int
+	}
+	var buckets [4096]bucket
+	best := -1
+
+	for i := 0; i < len(img.Pix); i += 4 {
+		r, g, b, a := int(img.Pix[i]), int(img.Pix[i+1]), int(img.Pix[i+2]), img.Pix[i+3]
+		if a < 128 {
+			continue
+		}
+		key := (r>>4)<<8 | (g>>4)<<4 | b>>4
+		bk := &buckets[key]
+		bk.count++
+		bk.r += r
+		bk.g += g
+		bk.b += b
+		if best < 0 || bk.count > buckets[best].count {
+			best = key
+		}
+	}
+
+	if best < 0 {
+		return "#000000"
+	}
+	bk := buckets[best]
+	return fmt.Sprintf("#%02x%02x%02x", bk.r/bk.count, bk.g/bk.count, bk.b/bk.count)
+}
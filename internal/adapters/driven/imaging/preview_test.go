@@ -0,0 +1,41 @@
+package imaging_test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/buckket/go-blurhash"
+	"github.com/llascola/web-backend/internal/adapters/driven/imaging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreview(t *testing.T) {
+	// Mostly red with a blue stripe, red must win
+	img := image.NewRGBA(image.Rect(0, 0, 200, 100))
+	for y := 0; y < 100; y++ {
+		for x := 0; x < 200; x++ {
+			c := color.RGBA{R: 255, A: 255}
+			if x < 40 {
+				c = color.RGBA{B: 255, A: 255}
+			}
+			img.Set(x, y, c)
+		}
+	}
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, img))
+
+	preview, err := imaging.NewProcessor().Preview(context.Background(), buf.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, 200, preview.Width)
+	assert.Equal(t, 100, preview.Height)
+	assert.Equal(t, "#ff0000", preview.DominantColor)
+
+	x, y, err := blurhash.Components(preview.BlurHash)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, x)
+	assert.Equal(t, 3, y)
+}
@@ -0,0 +1,90 @@
+package imaging
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // register the webp decoder
+)
+
+type Processor struct{}
+
+var _ outports.ImageProcessor = (*Processor)(nil)
+
+func NewProcessor() *Processor {
+	return &Processor{}
+}
+
+func (p *Processor) Transform(ctx context.Context, dst io.Writer, src io.Reader, t domain.ImageTransform) error {
+	img, _, err := image.Decode(src)
+	if err != nil {
+		return fmt.Errorf("decoding image: %w", err)
+	}
+
+	if t.Width != 0 || t.Height != 0 {
+		img = resize(img, t)
+	}
+
+	switch t.Format {
+	case "jpeg":
+		return jpeg.Encode(dst, img, &jpeg.Options{Quality: t.Quality})
+	case "png":
+		return png.Encode(dst, img)
+	default:
+		return domain.ErrInvalidTransform
+	}
+}
+
+// resize scales img into the box described by t, cropping when fit is cover
+func resize(img image.Image, t domain.ImageTransform) image.Image {
+	srcBounds := img.Bounds()
+	srcW, srcH := srcBounds.Dx(), srcBounds.Dy()
+
+	dstW, dstH := t.Width, t.Height
+	srcRect := srcBounds
+
+	switch t.Fit {
+	case domain.FitContain:
+		dstW, dstH = containSize(srcW, srcH, t.Width, t.Height)
+	case domain.FitCover:
+		srcRect = coverCrop(srcBounds, t.Width, t.Height)
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstW, dstH))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, srcRect, draw.Src, nil)
+	return dst
+}
+
+// containSize returns the largest size with the source aspect ratio fitting inside w x h.
+// A zero side is unconstrained. Images are never upscaled.
+func containSize(srcW, srcH, w, h int) (int, int) {
+	scale := 1.0
+	if w > 0 && w < srcW {
+		scale = float64(w) / float64(srcW)
+	}
+	if h > 0 && h < srcH {
+		scale = min(scale, float64(h)/float64(srcH))
+	}
+	return max(1, int(float64(srcW)*scale+0.5)), max(1, int(float64(srcH)*scale+0.5))
+}
+
+// coverCrop returns the centered region of src with the aspect ratio of w x h
+func coverCrop(src image.Rectangle, w, h int) image.Rectangle {
+	srcW, srcH := src.Dx(), src.Dy()
+	cropW, cropH := srcW, srcH
+	if srcW*h > srcH*w {
+		cropW = srcH * w / h
+	} else {
+		cropH = srcW * h / w
+	}
+	x0 := src.Min.X + (srcW-cropW)/2
+	y0 := src.Min.Y + (srcH-cropH)/2
+	return image.Rect(x0, y0, x0+cropW, y0+cropH)
+}
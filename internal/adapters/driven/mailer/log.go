@@ -0,0 +1,24 @@
+package mailer
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+var _ outports.Mailer = (*LogMailer)(nil)
+
+// LogMailer writes emails to the log instead of sending them, for development and memory mode
+type LogMailer struct{}
+
+func NewLogMailer() *LogMailer {
+	return &LogMailer{}
+}
+
+func (m *LogMailer) Send(ctx context.Context, email domain.Email) error {
+	log.Printf("Email to %s, reply to %q: %s\n%s", strings.Join(email.To, ", "), email.ReplyTo, email.Subject, email.Text)
+	return nil
+}
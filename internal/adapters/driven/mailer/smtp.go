@@ -0,0 +1,151 @@
+// Package mailer delivers the emails of the site
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+var _ outports.Mailer = (*SMTPMailer)(nil)
+
+// dialTimeout bounds connecting to the server when the context has no deadline
+const dialTimeout = 10 * time.Second
+
+// SMTPMailer sends through an SMTP server, upgrading to TLS with STARTTLS when offered
+type SMTPMailer struct {
+	addr     string
+	host     string
+	username string
+	password string
+	from     mail.Address
+}
+
+func NewSMTPMailer(host string, port int, username, password string, from mail.Address) *SMTPMailer {
+	return &SMTPMailer{
+		addr:     net.JoinHostPort(host, fmt.Sprint(port)),
+		host:     host,
+		username: username,
+		password: password,
+		from:     from,
+	}
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, email domain.Email) error {
+	msg, err := Message(m.from, email, time.Now())
+	if err != nil {
+		return err
+	}
+
+	dialer := net.Dialer{Timeout: dialTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", m.addr)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	c, err := smtp.NewClient(conn, m.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: m.host}); err != nil {
+			return err
+		}
+	}
+	if m.username != "" {
+		if err := c.Auth(smtp.PlainAuth("", m.username, m.password, m.host)); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(m.from.Address); err != nil {
+		return err
+	}
+	for _, to := range email.To {
+		if err := c.Rcpt(to); err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// Message encodes the email as an RFC 5322 message with a quoted-printable UTF-8 body.
+// Addresses are checked, so headers cannot be injected through them.
+func Message(from mail.Address, email domain.Email, now time.Time) ([]byte, error) {
+	if len(email.To) == 0 {
+		return nil, errors.New("email without recipients")
+	}
+	to := make([]string, len(email.To))
+	for i, addr := range email.To {
+		parsed, err := mail.ParseAddress(addr)
+		if err != nil {
+			return nil, fmt.Errorf("recipient %q: %w", addr, err)
+		}
+		to[i] = parsed.String()
+	}
+
+	var b bytes.Buffer
+	header := func(name, value string) { b.WriteString(name + ": " + value + "\r\n") }
+	header("From", from.String())
+	header("To", strings.Join(to, ", "))
+	if email.ReplyTo != "" {
+		replyTo, err := mail.ParseAddress(email.ReplyTo)
+		if err != nil {
+			return nil, fmt.Errorf("reply-to %q: %w", email.ReplyTo, err)
+		}
+		header("Reply-To", replyTo.String())
+	}
+	// Q-encoding also encodes line breaks, a subject cannot start a new header
+	header("Subject", mime.QEncoding.Encode("utf-8", email.Subject))
+	header("Date", now.Format(time.RFC1123Z))
+	header("Message-ID", "<"+uuid.NewString()+"@"+domainOf(from.Address)+">")
+	header("MIME-Version", "1.0")
+	header("Content-Type", "text/plain; charset=utf-8")
+	header("Content-Transfer-Encoding", "quoted-printable")
+	b.WriteString("\r\n")
+
+	// Outside of binary mode line breaks become CRLF
+	qp := quotedprintable.NewWriter(&b)
+	if _, err := qp.Write([]byte(email.Text)); err != nil {
+		return nil, err
+	}
+	if err := qp.Close(); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+func domainOf(address string) string {
+	_, host, _ := strings.Cut(address, "@")
+	if host == "" {
+		return "localhost"
+	}
+	return host
+}
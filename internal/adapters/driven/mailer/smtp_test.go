@@ -0,0 +1,39 @@
+package mailer_test
+
+import (
+	"mime"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/llascola/web-backend/internal/adapters/driven/mailer"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessage(t *testing.T) {
+	from := mail.Address{Name: "Blog", Address: "blog@example.com"}
+	msg, err := mailer.Message(from, domain.Email{
+		To:      []string{"author@example.com"},
+		ReplyTo: "ana@example.com",
+		Subject: "New comment on Días\r\nBcc: victim@example.com",
+		Text:    "Línea uno\nLine two",
+	}, time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	parsed, err := mail.ReadMessage(strings.NewReader(string(msg)))
+	require.NoError(t, err)
+	assert.Equal(t, `"Blog" <blog@example.com>`, parsed.Header.Get("From"))
+	assert.Equal(t, "<author@example.com>", parsed.Header.Get("To"))
+	assert.Equal(t, "<ana@example.com>", parsed.Header.Get("Reply-To"))
+	assert.Empty(t, parsed.Header.Get("Bcc"))
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "New comment on Días\r\nBcc: victim@example.com", subject)
+	assert.Contains(t, string(msg), "L=C3=ADnea uno\r\nLine two")
+
+	_, err = mailer.Message(from, domain.Email{To: []string{"not an address"}}, time.Now())
+	assert.Error(t, err)
+}
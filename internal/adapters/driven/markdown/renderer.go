@@ -0,0 +1,55 @@
+package markdown
+
+import (
+	"bytes"
+	"html"
+	"regexp"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	goldmarkhtml "github.com/yuin/goldmark/renderer/html"
+)
+
+var _ outports.MarkdownRenderer = (*Renderer)(nil)
+
+// Renderer turns GitHub flavored Markdown into HTML. Raw HTML in the source is kept and then
+// sanitized together with the rest, so authors can use e.g. <figure> but never <script>.
+type Renderer struct {
+	md     goldmark.Markdown
+	policy *bluemonday.Policy
+	// text drops every tag, leaving what a reader sees
+	text *bluemonday.Policy
+}
+
+func NewRenderer() *Renderer {
+	md := goldmark.New(
+		goldmark.WithExtensions(extension.GFM, extension.Footnote),
+		goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+		goldmark.WithRendererOptions(goldmarkhtml.WithUnsafe()),
+	)
+
+	policy := bluemonday.UGCPolicy()
+	// Posts are written by the site's authors, their links deserve to be followed
+	policy.RequireNoFollowOnLinks(false)
+	policy.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[\w+#-]+$`)).OnElements("code")
+	policy.AllowElements("figure", "figcaption")
+
+	return &Renderer{md: md, policy: policy, text: bluemonday.StrictPolicy()}
+}
+
+func (r *Renderer) Render(source string) (domain.RenderedMarkdown, error) {
+	var buf bytes.Buffer
+	if err := r.md.Convert([]byte(source), &buf); err != nil {
+		return domain.RenderedMarkdown{}, err
+	}
+	safe := r.policy.SanitizeBytes(buf.Bytes())
+	return domain.RenderedMarkdown{
+		HTML: string(safe),
+		// The strict policy escapes what it keeps, the text is not meant for HTML
+		Text: html.UnescapeString(r.text.Sanitize(string(safe))),
+	}, nil
+}
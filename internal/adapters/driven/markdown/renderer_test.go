@@ -0,0 +1,27 @@
+package markdown_test
+
+import (
+	"testing"
+
+	"github.com/llascola/web-backend/internal/adapters/driven/markdown"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	source := "# Title\n\nSome *text* & <script>alert(1)</script> [link](javascript:alert(1))\n\n" +
+		"<img src=\"/a.png\" onerror=\"alert(1)\">\n\n```go\nfmt.Println()\n```\n"
+	rendered, err := markdown.NewRenderer().Render(source)
+	require.NoError(t, err)
+
+	assert.Contains(t, rendered.HTML, `<h1 id="title">Title</h1>`)
+	assert.Contains(t, rendered.HTML, "<em>text</em> &amp;")
+	assert.Contains(t, rendered.HTML, `<code class="language-go">`)
+	assert.Contains(t, rendered.HTML, `<img src="/a.png">`)
+	assert.NotContains(t, rendered.HTML, "script")
+	assert.NotContains(t, rendered.HTML, "javascript")
+	assert.NotContains(t, rendered.HTML, "onerror")
+
+	assert.Contains(t, rendered.Text, "Some text & ")
+	assert.NotContains(t, rendered.Text, "<")
+}
@@ -0,0 +1,378 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/album"
+)
+
+// AlbumCreate is the builder for creating a Album entity.
+type AlbumCreate struct {
+	config
+	mutation *AlbumMutation
+	hooks    []Hook
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_c *AlbumCreate) SetOwnerID(v uuid.UUID) *AlbumCreate {
+	_c.mutation.SetOwnerID(v)
+	return _c
+}
+
+// SetSlug sets the "slug" field.
+func (_c *AlbumCreate) SetSlug(v string) *AlbumCreate {
+	_c.mutation.SetSlug(v)
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *AlbumCreate) SetTitle(v string) *AlbumCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *AlbumCreate) SetDescription(v string) *AlbumCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *AlbumCreate) SetNillableDescription(v *string) *AlbumCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetVisibility sets the "visibility" field.
+func (_c *AlbumCreate) SetVisibility(v string) *AlbumCreate {
+	_c.mutation.SetVisibility(v)
+	return _c
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_c *AlbumCreate) SetNillableVisibility(v *string) *AlbumCreate {
+	if v != nil {
+		_c.SetVisibility(*v)
+	}
+	return _c
+}
+
+// SetCoverImageID sets the "cover_image_id" field.
+func (_c *AlbumCreate) SetCoverImageID(v uuid.UUID) *AlbumCreate {
+	_c.mutation.SetCoverImageID(v)
+	return _c
+}
+
+// SetNillableCoverImageID sets the "cover_image_id" field if the given value is not nil.
+func (_c *AlbumCreate) SetNillableCoverImageID(v *uuid.UUID) *AlbumCreate {
+	if v != nil {
+		_c.SetCoverImageID(*v)
+	}
+	return _c
+}
+
+// SetImageIds sets the "image_ids" field.
+func (_c *AlbumCreate) SetImageIds(v []uuid.UUID) *AlbumCreate {
+	_c.mutation.SetImageIds(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AlbumCreate) SetCreatedAt(v time.Time) *AlbumCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AlbumCreate) SetNillableCreatedAt(v *time.Time) *AlbumCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AlbumCreate) SetUpdatedAt(v time.Time) *AlbumCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AlbumCreate) SetNillableUpdatedAt(v *time.Time) *AlbumCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AlbumCreate) SetID(v uuid.UUID) *AlbumCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *AlbumCreate) SetNillableID(v *uuid.UUID) *AlbumCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the AlbumMutation object of the builder.
+func (_c *AlbumCreate) Mutation() *AlbumMutation {
+	return _c.mutation
+}
+
+// Save creates the Album in the database.
+func (_c *AlbumCreate) Save(ctx context.Context) (*Album, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AlbumCreate) SaveX(ctx context.Context) *Album {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AlbumCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AlbumCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AlbumCreate) defaults() {
+	if _, ok := _c.mutation.Description(); !ok {
+		v := album.DefaultDescription
+		_c.mutation.SetDescription(v)
+	}
+	if _, ok := _c.mutation.Visibility(); !ok {
+		v := album.DefaultVisibility
+		_c.mutation.SetVisibility(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := album.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := album.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := album.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AlbumCreate) check() error {
+	if _, ok := _c.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner_id", err: errors.New(`ent: missing required field "Album.owner_id"`)}
+	}
+	if _, ok := _c.mutation.Slug(); !ok {
+		return &ValidationError{Name: "slug", err: errors.New(`ent: missing required field "Album.slug"`)}
+	}
+	if v, ok := _c.mutation.Slug(); ok {
+		if err := album.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Album.slug": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Album.title"`)}
+	}
+	if v, ok := _c.mutation.Title(); ok {
+		if err := album.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Album.title": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "Album.description"`)}
+	}
+	if _, ok := _c.mutation.Visibility(); !ok {
+		return &ValidationError{Name: "visibility", err: errors.New(`ent: missing required field "Album.visibility"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Album.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Album.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *AlbumCreate) sqlSave(ctx context.Context) (*Album, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AlbumCreate) createSpec() (*Album, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Album{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(album.Table, sqlgraph.NewFieldSpec(album.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.OwnerID(); ok {
+		_spec.SetField(album.FieldOwnerID, field.TypeUUID, value)
+		_node.OwnerID = value
+	}
+	if value, ok := _c.mutation.Slug(); ok {
+		_spec.SetField(album.FieldSlug, field.TypeString, value)
+		_node.Slug = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(album.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(album.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Visibility(); ok {
+		_spec.SetField(album.FieldVisibility, field.TypeString, value)
+		_node.Visibility = value
+	}
+	if value, ok := _c.mutation.CoverImageID(); ok {
+		_spec.SetField(album.FieldCoverImageID, field.TypeUUID, value)
+		_node.CoverImageID = value
+	}
+	if value, ok := _c.mutation.ImageIds(); ok {
+		_spec.SetField(album.FieldImageIds, field.TypeJSON, value)
+		_node.ImageIds = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(album.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(album.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// AlbumCreateBulk is the builder for creating many Album entities in bulk.
+type AlbumCreateBulk struct {
+	config
+	err      error
+	builders []*AlbumCreate
+}
+
+// Save creates the Album entities in the database.
+func (_c *AlbumCreateBulk) Save(ctx context.Context) ([]*Album, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Album, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AlbumMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AlbumCreateBulk) SaveX(ctx context.Context) []*Album {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AlbumCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AlbumCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,562 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/album"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/predicate"
+)
+
+// AlbumUpdate is the builder for updating Album entities.
+type AlbumUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AlbumMutation
+}
+
+// Where appends a list predicates to the AlbumUpdate builder.
+func (_u *AlbumUpdate) Where(ps ...predicate.Album) *AlbumUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *AlbumUpdate) SetOwnerID(v uuid.UUID) *AlbumUpdate {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *AlbumUpdate) SetNillableOwnerID(v *uuid.UUID) *AlbumUpdate {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// SetSlug sets the "slug" field.
+func (_u *AlbumUpdate) SetSlug(v string) *AlbumUpdate {
+	_u.mutation.SetSlug(v)
+	return _u
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_u *AlbumUpdate) SetNillableSlug(v *string) *AlbumUpdate {
+	if v != nil {
+		_u.SetSlug(*v)
+	}
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *AlbumUpdate) SetTitle(v string) *AlbumUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *AlbumUpdate) SetNillableTitle(v *string) *AlbumUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *AlbumUpdate) SetDescription(v string) *AlbumUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *AlbumUpdate) SetNillableDescription(v *string) *AlbumUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetVisibility sets the "visibility" field.
+func (_u *AlbumUpdate) SetVisibility(v string) *AlbumUpdate {
+	_u.mutation.SetVisibility(v)
+	return _u
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *AlbumUpdate) SetNillableVisibility(v *string) *AlbumUpdate {
+	if v != nil {
+		_u.SetVisibility(*v)
+	}
+	return _u
+}
+
+// SetCoverImageID sets the "cover_image_id" field.
+func (_u *AlbumUpdate) SetCoverImageID(v uuid.UUID) *AlbumUpdate {
+	_u.mutation.SetCoverImageID(v)
+	return _u
+}
+
+// SetNillableCoverImageID sets the "cover_image_id" field if the given value is not nil.
+func (_u *AlbumUpdate) SetNillableCoverImageID(v *uuid.UUID) *AlbumUpdate {
+	if v != nil {
+		_u.SetCoverImageID(*v)
+	}
+	return _u
+}
+
+// ClearCoverImageID clears the value of the "cover_image_id" field.
+func (_u *AlbumUpdate) ClearCoverImageID() *AlbumUpdate {
+	_u.mutation.ClearCoverImageID()
+	return _u
+}
+
+// SetImageIds sets the "image_ids" field.
+func (_u *AlbumUpdate) SetImageIds(v []uuid.UUID) *AlbumUpdate {
+	_u.mutation.SetImageIds(v)
+	return _u
+}
+
+// AppendImageIds appends value to the "image_ids" field.
+func (_u *AlbumUpdate) AppendImageIds(v []uuid.UUID) *AlbumUpdate {
+	_u.mutation.AppendImageIds(v)
+	return _u
+}
+
+// ClearImageIds clears the value of the "image_ids" field.
+func (_u *AlbumUpdate) ClearImageIds() *AlbumUpdate {
+	_u.mutation.ClearImageIds()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *AlbumUpdate) SetCreatedAt(v time.Time) *AlbumUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *AlbumUpdate) SetNillableCreatedAt(v *time.Time) *AlbumUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AlbumUpdate) SetUpdatedAt(v time.Time) *AlbumUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *AlbumUpdate) SetNillableUpdatedAt(v *time.Time) *AlbumUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AlbumMutation object of the builder.
+func (_u *AlbumUpdate) Mutation() *AlbumMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AlbumUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AlbumUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AlbumUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AlbumUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AlbumUpdate) check() error {
+	if v, ok := _u.mutation.Slug(); ok {
+		if err := album.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Album.slug": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Title(); ok {
+		if err := album.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Album.title": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AlbumUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(album.Table, album.Columns, sqlgraph.NewFieldSpec(album.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(album.FieldOwnerID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Slug(); ok {
+		_spec.SetField(album.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(album.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(album.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(album.FieldVisibility, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CoverImageID(); ok {
+		_spec.SetField(album.FieldCoverImageID, field.TypeUUID, value)
+	}
+	if _u.mutation.CoverImageIDCleared() {
+		_spec.ClearField(album.FieldCoverImageID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ImageIds(); ok {
+		_spec.SetField(album.FieldImageIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedImageIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, album.FieldImageIds, value)
+		})
+	}
+	if _u.mutation.ImageIdsCleared() {
+		_spec.ClearField(album.FieldImageIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(album.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(album.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{album.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AlbumUpdateOne is the builder for updating a single Album entity.
+type AlbumUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AlbumMutation
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *AlbumUpdateOne) SetOwnerID(v uuid.UUID) *AlbumUpdateOne {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *AlbumUpdateOne) SetNillableOwnerID(v *uuid.UUID) *AlbumUpdateOne {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// SetSlug sets the "slug" field.
+func (_u *AlbumUpdateOne) SetSlug(v string) *AlbumUpdateOne {
+	_u.mutation.SetSlug(v)
+	return _u
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_u *AlbumUpdateOne) SetNillableSlug(v *string) *AlbumUpdateOne {
+	if v != nil {
+		_u.SetSlug(*v)
+	}
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *AlbumUpdateOne) SetTitle(v string) *AlbumUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *AlbumUpdateOne) SetNillableTitle(v *string) *AlbumUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *AlbumUpdateOne) SetDescription(v string) *AlbumUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *AlbumUpdateOne) SetNillableDescription(v *string) *AlbumUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetVisibility sets the "visibility" field.
+func (_u *AlbumUpdateOne) SetVisibility(v string) *AlbumUpdateOne {
+	_u.mutation.SetVisibility(v)
+	return _u
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *AlbumUpdateOne) SetNillableVisibility(v *string) *AlbumUpdateOne {
+	if v != nil {
+		_u.SetVisibility(*v)
+	}
+	return _u
+}
+
+// SetCoverImageID sets the "cover_image_id" field.
+func (_u *AlbumUpdateOne) SetCoverImageID(v uuid.UUID) *AlbumUpdateOne {
+	_u.mutation.SetCoverImageID(v)
+	return _u
+}
+
+// SetNillableCoverImageID sets the "cover_image_id" field if the given value is not nil.
+func (_u *AlbumUpdateOne) SetNillableCoverImageID(v *uuid.UUID) *AlbumUpdateOne {
+	if v != nil {
+		_u.SetCoverImageID(*v)
+	}
+	return _u
+}
+
+// ClearCoverImageID clears the value of the "cover_image_id" field.
+func (_u *AlbumUpdateOne) ClearCoverImageID() *AlbumUpdateOne {
+	_u.mutation.ClearCoverImageID()
+	return _u
+}
+
+// SetImageIds sets the "image_ids" field.
+func (_u *AlbumUpdateOne) SetImageIds(v []uuid.UUID) *AlbumUpdateOne {
+	_u.mutation.SetImageIds(v)
+	return _u
+}
+
+// AppendImageIds appends value to the "image_ids" field.
+func (_u *AlbumUpdateOne) AppendImageIds(v []uuid.UUID) *AlbumUpdateOne {
+	_u.mutation.AppendImageIds(v)
+	return _u
+}
+
+// ClearImageIds clears the value of the "image_ids" field.
+func (_u *AlbumUpdateOne) ClearImageIds() *AlbumUpdateOne {
+	_u.mutation.ClearImageIds()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *AlbumUpdateOne) SetCreatedAt(v time.Time) *AlbumUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *AlbumUpdateOne) SetNillableCreatedAt(v *time.Time) *AlbumUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AlbumUpdateOne) SetUpdatedAt(v time.Time) *AlbumUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *AlbumUpdateOne) SetNillableUpdatedAt(v *time.Time) *AlbumUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AlbumMutation object of the builder.
+func (_u *AlbumUpdateOne) Mutation() *AlbumMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AlbumUpdate builder.
+func (_u *AlbumUpdateOne) Where(ps ...predicate.Album) *AlbumUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AlbumUpdateOne) Select(field string, fields ...string) *AlbumUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Album entity.
+func (_u *AlbumUpdateOne) Save(ctx context.Context) (*Album, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AlbumUpdateOne) SaveX(ctx context.Context) *Album {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AlbumUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AlbumUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AlbumUpdateOne) check() error {
+	if v, ok := _u.mutation.Slug(); ok {
+		if err := album.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Album.slug": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Title(); ok {
+		if err := album.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Album.title": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AlbumUpdateOne) sqlSave(ctx context.Context) (_node *Album, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(album.Table, album.Columns, sqlgraph.NewFieldSpec(album.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Album.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, album.FieldID)
+		for _, f := range fields {
+			if !album.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != album.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(album.FieldOwnerID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Slug(); ok {
+		_spec.SetField(album.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(album.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(album.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(album.FieldVisibility, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CoverImageID(); ok {
+		_spec.SetField(album.FieldCoverImageID, field.TypeUUID, value)
+	}
+	if _u.mutation.CoverImageIDCleared() {
+		_spec.ClearField(album.FieldCoverImageID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ImageIds(); ok {
+		_spec.SetField(album.FieldImageIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedImageIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, album.FieldImageIds, value)
+		})
+	}
+	if _u.mutation.ImageIdsCleared() {
+		_spec.ClearField(album.FieldImageIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(album.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(album.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Album{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{album.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
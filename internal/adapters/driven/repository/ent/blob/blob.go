@@ -0,0 +1,102 @@
+// Code generated by ent, DO NOT EDIT.
+
+package blob
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the blob type in the database.
+	Label = "blob"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldChecksum holds the string denoting the checksum field in the database.
+	FieldChecksum = "checksum"
+	// FieldContentType holds the string denoting the content_type field in the database.
+	FieldContentType = "content_type"
+	// FieldSize holds the string denoting the size field in the database.
+	FieldSize = "size"
+	// FieldURL holds the string denoting the url field in the database.
+	FieldURL = "url"
+	// FieldRefCount holds the string denoting the ref_count field in the database.
+	FieldRefCount = "ref_count"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the blob in the database.
+	Table = "blobs"
+)
+
+// Columns holds all SQL columns for blob fields.
+var Columns = []string{
+	FieldID,
+	FieldChecksum,
+	FieldContentType,
+	FieldSize,
+	FieldURL,
+	FieldRefCount,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ChecksumValidator is a validator for the "checksum" field. It is called by the builders before save.
+	ChecksumValidator func(string) error
+	// ContentTypeValidator is a validator for the "content_type" field. It is called by the builders before save.
+	ContentTypeValidator func(string) error
+	// RefCountValidator is a validator for the "ref_count" field. It is called by the builders before save.
+	RefCountValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the Blob queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByChecksum orders the results by the checksum field.
+func ByChecksum(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChecksum, opts...).ToFunc()
+}
+
+// ByContentType orders the results by the content_type field.
+func ByContentType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentType, opts...).ToFunc()
+}
+
+// BySize orders the results by the size field.
+func BySize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSize, opts...).ToFunc()
+}
+
+// ByURL orders the results by the url field.
+func ByURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldURL, opts...).ToFunc()
+}
+
+// ByRefCount orders the results by the ref_count field.
+func ByRefCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefCount, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
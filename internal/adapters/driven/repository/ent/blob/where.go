@@ -0,0 +1,425 @@
+// Code generated by ent, DO NOT EDIT.
+
+package blob
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Blob {
+	return predicate.Blob(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Blob {
+	return predicate.Blob(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Blob {
+	return predicate.Blob(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Blob {
+	return predicate.Blob(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Blob {
+	return predicate.Blob(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Blob {
+	return predicate.Blob(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Blob {
+	return predicate.Blob(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Blob {
+	return predicate.Blob(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Blob {
+	return predicate.Blob(sql.FieldContainsFold(FieldID, id))
+}
+
+// Checksum applies equality check predicate on the "checksum" field. It's identical to ChecksumEQ.
+func Checksum(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldChecksum, v))
+}
+
+// ContentType applies equality check predicate on the "content_type" field. It's identical to ContentTypeEQ.
+func ContentType(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldContentType, v))
+}
+
+// Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
+func Size(v int64) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldSize, v))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldURL, v))
+}
+
+// RefCount applies equality check predicate on the "ref_count" field. It's identical to RefCountEQ.
+func RefCount(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldRefCount, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ChecksumEQ applies the EQ predicate on the "checksum" field.
+func ChecksumEQ(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldChecksum, v))
+}
+
+// ChecksumNEQ applies the NEQ predicate on the "checksum" field.
+func ChecksumNEQ(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldNEQ(FieldChecksum, v))
+}
+
+// ChecksumIn applies the In predicate on the "checksum" field.
+func ChecksumIn(vs ...string) predicate.Blob {
+	return predicate.Blob(sql.FieldIn(FieldChecksum, vs...))
+}
+
+// ChecksumNotIn applies the NotIn predicate on the "checksum" field.
+func ChecksumNotIn(vs ...string) predicate.Blob {
+	return predicate.Blob(sql.FieldNotIn(FieldChecksum, vs...))
+}
+
+// ChecksumGT applies the GT predicate on the "checksum" field.
+func ChecksumGT(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldGT(FieldChecksum, v))
+}
+
+// ChecksumGTE applies the GTE predicate on the "checksum" field.
+func ChecksumGTE(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldGTE(FieldChecksum, v))
+}
+
+// ChecksumLT applies the LT predicate on the "checksum" field.
+func ChecksumLT(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldLT(FieldChecksum, v))
+}
+
+// ChecksumLTE applies the LTE predicate on the "checksum" field.
+func ChecksumLTE(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldLTE(FieldChecksum, v))
+}
+
+// ChecksumContains applies the Contains predicate on the "checksum" field.
+func ChecksumContains(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldContains(FieldChecksum, v))
+}
+
+// ChecksumHasPrefix applies the HasPrefix predicate on the "checksum" field.
+func ChecksumHasPrefix(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldHasPrefix(FieldChecksum, v))
+}
+
+// ChecksumHasSuffix applies the HasSuffix predicate on the "checksum" field.
+func ChecksumHasSuffix(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldHasSuffix(FieldChecksum, v))
+}
+
+// ChecksumEqualFold applies the EqualFold predicate on the "checksum" field.
+func ChecksumEqualFold(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldEqualFold(FieldChecksum, v))
+}
+
+// ChecksumContainsFold applies the ContainsFold predicate on the "checksum" field.
+func ChecksumContainsFold(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldContainsFold(FieldChecksum, v))
+}
+
+// ContentTypeEQ applies the EQ predicate on the "content_type" field.
+func ContentTypeEQ(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldContentType, v))
+}
+
+// ContentTypeNEQ applies the NEQ predicate on the "content_type" field.
+func ContentTypeNEQ(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldNEQ(FieldContentType, v))
+}
+
+// ContentTypeIn applies the In predicate on the "content_type" field.
+func ContentTypeIn(vs ...string) predicate.Blob {
+	return predicate.Blob(sql.FieldIn(FieldContentType, vs...))
+}
+
+// ContentTypeNotIn applies the NotIn predicate on the "content_type" field.
+func ContentTypeNotIn(vs ...string) predicate.Blob {
+	return predicate.Blob(sql.FieldNotIn(FieldContentType, vs...))
+}
+
+// ContentTypeGT applies the GT predicate on the "content_type" field.
+func ContentTypeGT(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldGT(FieldContentType, v))
+}
+
+// ContentTypeGTE applies the GTE predicate on the "content_type" field.
+func ContentTypeGTE(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldGTE(FieldContentType, v))
+}
+
+// ContentTypeLT applies the LT predicate on the "content_type" field.
+func ContentTypeLT(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldLT(FieldContentType, v))
+}
+
+// ContentTypeLTE applies the LTE predicate on the "content_type" field.
+func ContentTypeLTE(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldLTE(FieldContentType, v))
+}
+
+// ContentTypeContains applies the Contains predicate on the "content_type" field.
+func ContentTypeContains(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldContains(FieldContentType, v))
+}
+
+// ContentTypeHasPrefix applies the HasPrefix predicate on the "content_type" field.
+func ContentTypeHasPrefix(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldHasPrefix(FieldContentType, v))
+}
+
+// ContentTypeHasSuffix applies the HasSuffix predicate on the "content_type" field.
+func ContentTypeHasSuffix(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldHasSuffix(FieldContentType, v))
+}
+
+// ContentTypeEqualFold applies the EqualFold predicate on the "content_type" field.
+func ContentTypeEqualFold(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldEqualFold(FieldContentType, v))
+}
+
+// ContentTypeContainsFold applies the ContainsFold predicate on the "content_type" field.
+func ContentTypeContainsFold(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldContainsFold(FieldContentType, v))
+}
+
+// SizeEQ applies the EQ predicate on the "size" field.
+func SizeEQ(v int64) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldSize, v))
+}
+
+// SizeNEQ applies the NEQ predicate on the "size" field.
+func SizeNEQ(v int64) predicate.Blob {
+	return predicate.Blob(sql.FieldNEQ(FieldSize, v))
+}
+
+// SizeIn applies the In predicate on the "size" field.
+func SizeIn(vs ...int64) predicate.Blob {
+	return predicate.Blob(sql.FieldIn(FieldSize, vs...))
+}
+
+// SizeNotIn applies the NotIn predicate on the "size" field.
+func SizeNotIn(vs ...int64) predicate.Blob {
+	return predicate.Blob(sql.FieldNotIn(FieldSize, vs...))
+}
+
+// SizeGT applies the GT predicate on the "size" field.
+func SizeGT(v int64) predicate.Blob {
+	return predicate.Blob(sql.FieldGT(FieldSize, v))
+}
+
+// SizeGTE applies the GTE predicate on the "size" field.
+func SizeGTE(v int64) predicate.Blob {
+	return predicate.Blob(sql.FieldGTE(FieldSize, v))
+}
+
+// SizeLT applies the LT predicate on the "size" field.
+func SizeLT(v int64) predicate.Blob {
+	return predicate.Blob(sql.FieldLT(FieldSize, v))
+}
+
+// SizeLTE applies the LTE predicate on the "size" field.
+func SizeLTE(v int64) predicate.Blob {
+	return predicate.Blob(sql.FieldLTE(FieldSize, v))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.Blob {
+	return predicate.Blob(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.Blob {
+	return predicate.Blob(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldContainsFold(FieldURL, v))
+}
+
+// RefCountEQ applies the EQ predicate on the "ref_count" field.
+func RefCountEQ(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldRefCount, v))
+}
+
+// RefCountNEQ applies the NEQ predicate on the "ref_count" field.
+func RefCountNEQ(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldNEQ(FieldRefCount, v))
+}
+
+// RefCountIn applies the In predicate on the "ref_count" field.
+func RefCountIn(vs ...int) predicate.Blob {
+	return predicate.Blob(sql.FieldIn(FieldRefCount, vs...))
+}
+
+// RefCountNotIn applies the NotIn predicate on the "ref_count" field.
+func RefCountNotIn(vs ...int) predicate.Blob {
+	return predicate.Blob(sql.FieldNotIn(FieldRefCount, vs...))
+}
+
+// RefCountGT applies the GT predicate on the "ref_count" field.
+func RefCountGT(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldGT(FieldRefCount, v))
+}
+
+// RefCountGTE applies the GTE predicate on the "ref_count" field.
+func RefCountGTE(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldGTE(FieldRefCount, v))
+}
+
+// RefCountLT applies the LT predicate on the "ref_count" field.
+func RefCountLT(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldLT(FieldRefCount, v))
+}
+
+// RefCountLTE applies the LTE predicate on the "ref_count" field.
+func RefCountLTE(v int) predicate.Blob {
+	return predicate.Blob(sql.FieldLTE(FieldRefCount, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Blob {
+	return predicate.Blob(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Blob) predicate.Blob {
+	return predicate.Blob(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Blob) predicate.Blob {
+	return predicate.Blob(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Blob) predicate.Blob {
+	return predicate.Blob(sql.NotPredicates(p))
+}
@@ -0,0 +1,466 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/blob"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/predicate"
+)
+
+// BlobUpdate is the builder for updating Blob entities.
+type BlobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BlobMutation
+}
+
+// Where appends a list predicates to the BlobUpdate builder.
+func (_u *BlobUpdate) Where(ps ...predicate.Blob) *BlobUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetChecksum sets the "checksum" field.
+func (_u *BlobUpdate) SetChecksum(v string) *BlobUpdate {
+	_u.mutation.SetChecksum(v)
+	return _u
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (_u *BlobUpdate) SetNillableChecksum(v *string) *BlobUpdate {
+	if v != nil {
+		_u.SetChecksum(*v)
+	}
+	return _u
+}
+
+// SetContentType sets the "content_type" field.
+func (_u *BlobUpdate) SetContentType(v string) *BlobUpdate {
+	_u.mutation.SetContentType(v)
+	return _u
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (_u *BlobUpdate) SetNillableContentType(v *string) *BlobUpdate {
+	if v != nil {
+		_u.SetContentType(*v)
+	}
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *BlobUpdate) SetSize(v int64) *BlobUpdate {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *BlobUpdate) SetNillableSize(v *int64) *BlobUpdate {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *BlobUpdate) AddSize(v int64) *BlobUpdate {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *BlobUpdate) SetURL(v string) *BlobUpdate {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *BlobUpdate) SetNillableURL(v *string) *BlobUpdate {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetRefCount sets the "ref_count" field.
+func (_u *BlobUpdate) SetRefCount(v int) *BlobUpdate {
+	_u.mutation.ResetRefCount()
+	_u.mutation.SetRefCount(v)
+	return _u
+}
+
+// SetNillableRefCount sets the "ref_count" field if the given value is not nil.
+func (_u *BlobUpdate) SetNillableRefCount(v *int) *BlobUpdate {
+	if v != nil {
+		_u.SetRefCount(*v)
+	}
+	return _u
+}
+
+// AddRefCount adds value to the "ref_count" field.
+func (_u *BlobUpdate) AddRefCount(v int) *BlobUpdate {
+	_u.mutation.AddRefCount(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *BlobUpdate) SetCreatedAt(v time.Time) *BlobUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *BlobUpdate) SetNillableCreatedAt(v *time.Time) *BlobUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BlobMutation object of the builder.
+func (_u *BlobUpdate) Mutation() *BlobMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BlobUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BlobUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BlobUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BlobUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BlobUpdate) check() error {
+	if v, ok := _u.mutation.Checksum(); ok {
+		if err := blob.ChecksumValidator(v); err != nil {
+			return &ValidationError{Name: "checksum", err: fmt.Errorf(`ent: validator failed for field "Blob.checksum": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ContentType(); ok {
+		if err := blob.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`ent: validator failed for field "Blob.content_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RefCount(); ok {
+		if err := blob.RefCountValidator(v); err != nil {
+			return &ValidationError{Name: "ref_count", err: fmt.Errorf(`ent: validator failed for field "Blob.ref_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BlobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(blob.Table, blob.Columns, sqlgraph.NewFieldSpec(blob.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Checksum(); ok {
+		_spec.SetField(blob.FieldChecksum, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ContentType(); ok {
+		_spec.SetField(blob.FieldContentType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(blob.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(blob.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(blob.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RefCount(); ok {
+		_spec.SetField(blob.FieldRefCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRefCount(); ok {
+		_spec.AddField(blob.FieldRefCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(blob.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BlobUpdateOne is the builder for updating a single Blob entity.
+type BlobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BlobMutation
+}
+
+// SetChecksum sets the "checksum" field.
+func (_u *BlobUpdateOne) SetChecksum(v string) *BlobUpdateOne {
+	_u.mutation.SetChecksum(v)
+	return _u
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (_u *BlobUpdateOne) SetNillableChecksum(v *string) *BlobUpdateOne {
+	if v != nil {
+		_u.SetChecksum(*v)
+	}
+	return _u
+}
+
+// SetContentType sets the "content_type" field.
+func (_u *BlobUpdateOne) SetContentType(v string) *BlobUpdateOne {
+	_u.mutation.SetContentType(v)
+	return _u
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (_u *BlobUpdateOne) SetNillableContentType(v *string) *BlobUpdateOne {
+	if v != nil {
+		_u.SetContentType(*v)
+	}
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *BlobUpdateOne) SetSize(v int64) *BlobUpdateOne {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *BlobUpdateOne) SetNillableSize(v *int64) *BlobUpdateOne {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *BlobUpdateOne) AddSize(v int64) *BlobUpdateOne {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *BlobUpdateOne) SetURL(v string) *BlobUpdateOne {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *BlobUpdateOne) SetNillableURL(v *string) *BlobUpdateOne {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetRefCount sets the "ref_count" field.
+func (_u *BlobUpdateOne) SetRefCount(v int) *BlobUpdateOne {
+	_u.mutation.ResetRefCount()
+	_u.mutation.SetRefCount(v)
+	return _u
+}
+
+// SetNillableRefCount sets the "ref_count" field if the given value is not nil.
+func (_u *BlobUpdateOne) SetNillableRefCount(v *int) *BlobUpdateOne {
+	if v != nil {
+		_u.SetRefCount(*v)
+	}
+	return _u
+}
+
+// AddRefCount adds value to the "ref_count" field.
+func (_u *BlobUpdateOne) AddRefCount(v int) *BlobUpdateOne {
+	_u.mutation.AddRefCount(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *BlobUpdateOne) SetCreatedAt(v time.Time) *BlobUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *BlobUpdateOne) SetNillableCreatedAt(v *time.Time) *BlobUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BlobMutation object of the builder.
+func (_u *BlobUpdateOne) Mutation() *BlobMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BlobUpdate builder.
+func (_u *BlobUpdateOne) Where(ps ...predicate.Blob) *BlobUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BlobUpdateOne) Select(field string, fields ...string) *BlobUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Blob entity.
+func (_u *BlobUpdateOne) Save(ctx context.Context) (*Blob, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BlobUpdateOne) SaveX(ctx context.Context) *Blob {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BlobUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BlobUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BlobUpdateOne) check() error {
+	if v, ok := _u.mutation.Checksum(); ok {
+		if err := blob.ChecksumValidator(v); err != nil {
+			return &ValidationError{Name: "checksum", err: fmt.Errorf(`ent: validator failed for field "Blob.checksum": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ContentType(); ok {
+		if err := blob.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`ent: validator failed for field "Blob.content_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RefCount(); ok {
+		if err := blob.RefCountValidator(v); err != nil {
+			return &ValidationError{Name: "ref_count", err: fmt.Errorf(`ent: validator failed for field "Blob.ref_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BlobUpdateOne) sqlSave(ctx context.Context) (_node *Blob, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(blob.Table, blob.Columns, sqlgraph.NewFieldSpec(blob.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Blob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, blob.FieldID)
+		for _, f := range fields {
+			if !blob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != blob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Checksum(); ok {
+		_spec.SetField(blob.FieldChecksum, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ContentType(); ok {
+		_spec.SetField(blob.FieldContentType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(blob.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(blob.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(blob.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RefCount(); ok {
+		_spec.SetField(blob.FieldRefCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRefCount(); ok {
+		_spec.AddField(blob.FieldRefCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(blob.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &Blob{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
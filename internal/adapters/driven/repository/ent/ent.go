@@ -12,6 +12,18 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/album"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/blob"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/comment"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/contactmessage"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/image"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/post"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/postrevision"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/postslug"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/redirect"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/resumableupload"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/tag"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/upload"
 	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/user"
 )
 
@@ -73,7 +85,19 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			user.Table: user.ValidColumn,
+			album.Table:           album.ValidColumn,
+			blob.Table:            blob.ValidColumn,
+			comment.Table:         comment.ValidColumn,
+			contactmessage.Table:  contactmessage.ValidColumn,
+			image.Table:           image.ValidColumn,
+			post.Table:            post.ValidColumn,
+			postrevision.Table:    postrevision.ValidColumn,
+			postslug.Table:        postslug.ValidColumn,
+			redirect.Table:        redirect.ValidColumn,
+			resumableupload.Table: resumableupload.ValidColumn,
+			tag.Table:             tag.ValidColumn,
+			upload.Table:          upload.ValidColumn,
+			user.Table:            user.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
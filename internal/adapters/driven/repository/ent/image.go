@@ -0,0 +1,316 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/image"
+)
+
+// Image is the model entity for the Image schema.
+type Image struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID uuid.UUID `json:"owner_id,omitempty"`
+	// Visibility holds the value of the "visibility" field.
+	Visibility string `json:"visibility,omitempty"`
+	// OriginalName holds the value of the "original_name" field.
+	OriginalName string `json:"original_name,omitempty"`
+	// StoredName holds the value of the "stored_name" field.
+	StoredName string `json:"stored_name,omitempty"`
+	// ContentType holds the value of the "content_type" field.
+	ContentType string `json:"content_type,omitempty"`
+	// Size holds the value of the "size" field.
+	Size int64 `json:"size,omitempty"`
+	// Checksum holds the value of the "checksum" field.
+	Checksum string `json:"checksum,omitempty"`
+	// URL holds the value of the "url" field.
+	URL string `json:"url,omitempty"`
+	// Width holds the value of the "width" field.
+	Width int `json:"width,omitempty"`
+	// Height holds the value of the "height" field.
+	Height int `json:"height,omitempty"`
+	// BlurHash holds the value of the "blur_hash" field.
+	BlurHash string `json:"blur_hash,omitempty"`
+	// DominantColor holds the value of the "dominant_color" field.
+	DominantColor string `json:"dominant_color,omitempty"`
+	// Alt holds the value of the "alt" field.
+	Alt map[string]string `json:"alt,omitempty"`
+	// Caption holds the value of the "caption" field.
+	Caption string `json:"caption,omitempty"`
+	// Credit holds the value of the "credit" field.
+	Credit string `json:"credit,omitempty"`
+	// License holds the value of the "license" field.
+	License string `json:"license,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ImageQuery when eager-loading is set.
+	Edges        ImageEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ImageEdges holds the relations/edges for other nodes in the graph.
+type ImageEdges struct {
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e ImageEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[0] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Image) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case image.FieldAlt:
+			values[i] = new([]byte)
+		case image.FieldSize, image.FieldWidth, image.FieldHeight:
+			values[i] = new(sql.NullInt64)
+		case image.FieldVisibility, image.FieldOriginalName, image.FieldStoredName, image.FieldContentType, image.FieldChecksum, image.FieldURL, image.FieldBlurHash, image.FieldDominantColor, image.FieldCaption, image.FieldCredit, image.FieldLicense:
+			values[i] = new(sql.NullString)
+		case image.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case image.FieldID, image.FieldOwnerID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Image fields.
+func (_m *Image) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case image.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case image.FieldOwnerID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value != nil {
+				_m.OwnerID = *value
+			}
+		case image.FieldVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field visibility", values[i])
+			} else if value.Valid {
+				_m.Visibility = value.String
+			}
+		case image.FieldOriginalName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field original_name", values[i])
+			} else if value.Valid {
+				_m.OriginalName = value.String
+			}
+		case image.FieldStoredName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field stored_name", values[i])
+			} else if value.Valid {
+				_m.StoredName = value.String
+			}
+		case image.FieldContentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_type", values[i])
+			} else if value.Valid {
+				_m.ContentType = value.String
+			}
+		case image.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				_m.Size = value.Int64
+			}
+		case image.FieldChecksum:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field checksum", values[i])
+			} else if value.Valid {
+				_m.Checksum = value.String
+			}
+		case image.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				_m.URL = value.String
+			}
+		case image.FieldWidth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field width", values[i])
+			} else if value.Valid {
+				_m.Width = int(value.Int64)
+			}
+		case image.FieldHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field height", values[i])
+			} else if value.Valid {
+				_m.Height = int(value.Int64)
+			}
+		case image.FieldBlurHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field blur_hash", values[i])
+			} else if value.Valid {
+				_m.BlurHash = value.String
+			}
+		case image.FieldDominantColor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dominant_color", values[i])
+			} else if value.Valid {
+				_m.DominantColor = value.String
+			}
+		case image.FieldAlt:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field alt", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Alt); err != nil {
+					return fmt.Errorf("unmarshal field alt: %w", err)
+				}
+			}
+		case image.FieldCaption:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field caption", values[i])
+			} else if value.Valid {
+				_m.Caption = value.String
+			}
+		case image.FieldCredit:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field credit", values[i])
+			} else if value.Valid {
+				_m.Credit = value.String
+			}
+		case image.FieldLicense:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field license", values[i])
+			} else if value.Valid {
+				_m.License = value.String
+			}
+		case image.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Image.
+// This includes values selected through modifiers, order, etc.
+func (_m *Image) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTags queries the "tags" edge of the Image entity.
+func (_m *Image) QueryTags() *TagQuery {
+	return NewImageClient(_m.config).QueryTags(_m)
+}
+
+// Update returns a builder for updating this Image.
+// Note that you need to call Image.Unwrap() before calling this method if this Image
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Image) Update() *ImageUpdateOne {
+	return NewImageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Image entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Image) Unwrap() *Image {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Image is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Image) String() string {
+	var builder strings.Builder
+	builder.WriteString("Image(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("owner_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OwnerID))
+	builder.WriteString(", ")
+	builder.WriteString("visibility=")
+	builder.WriteString(_m.Visibility)
+	builder.WriteString(", ")
+	builder.WriteString("original_name=")
+	builder.WriteString(_m.OriginalName)
+	builder.WriteString(", ")
+	builder.WriteString("stored_name=")
+	builder.WriteString(_m.StoredName)
+	builder.WriteString(", ")
+	builder.WriteString("content_type=")
+	builder.WriteString(_m.ContentType)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Size))
+	builder.WriteString(", ")
+	builder.WriteString("checksum=")
+	builder.WriteString(_m.Checksum)
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(_m.URL)
+	builder.WriteString(", ")
+	builder.WriteString("width=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Width))
+	builder.WriteString(", ")
+	builder.WriteString("height=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Height))
+	builder.WriteString(", ")
+	builder.WriteString("blur_hash=")
+	builder.WriteString(_m.BlurHash)
+	builder.WriteString(", ")
+	builder.WriteString("dominant_color=")
+	builder.WriteString(_m.DominantColor)
+	builder.WriteString(", ")
+	builder.WriteString("alt=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Alt))
+	builder.WriteString(", ")
+	builder.WriteString("caption=")
+	builder.WriteString(_m.Caption)
+	builder.WriteString(", ")
+	builder.WriteString("credit=")
+	builder.WriteString(_m.Credit)
+	builder.WriteString(", ")
+	builder.WriteString("license=")
+	builder.WriteString(_m.License)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Images is a parsable slice of Image.
+type Images []*Image
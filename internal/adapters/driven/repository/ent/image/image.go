@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package image
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the image type in the database.
+	Label = "image"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOwnerID holds the string denoting the owner_id field in the database.
+	FieldOwnerID = "owner_id"
+	// FieldVisibility holds the string denoting the visibility field in the database.
+	FieldVisibility = "visibility"
+	// FieldOriginalName holds the string denoting the original_name field in the database.
+	FieldOriginalName = "original_name"
+	// FieldStoredName holds the string denoting the stored_name field in the database.
+	FieldStoredName = "stored_name"
+	// FieldContentType holds the string denoting the content_type field in the database.
+	FieldContentType = "content_type"
+	// FieldSize holds the string denoting the size field in the database.
+	FieldSize = "size"
+	// FieldChecksum holds the string denoting the checksum field in the database.
+	FieldChecksum = "checksum"
+	// FieldURL holds the string denoting the url field in the database.
+	FieldURL = "url"
+	// FieldWidth holds the string denoting the width field in the database.
+	FieldWidth = "width"
+	// FieldHeight holds the string denoting the height field in the database.
+	FieldHeight = "height"
+	// FieldBlurHash holds the string denoting the blur_hash field in the database.
+	FieldBlurHash = "blur_hash"
+	// FieldDominantColor holds the string denoting the dominant_color field in the database.
+	FieldDominantColor = "dominant_color"
+	// FieldAlt holds the string denoting the alt field in the database.
+	FieldAlt = "alt"
+	// FieldCaption holds the string denoting the caption field in the database.
+	FieldCaption = "caption"
+	// FieldCredit holds the string denoting the credit field in the database.
+	FieldCredit = "credit"
+	// FieldLicense holds the string denoting the license field in the database.
+	FieldLicense = "license"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeTags holds the string denoting the tags edge name in mutations.
+	EdgeTags = "tags"
+	// Table holds the table name of the image in the database.
+	Table = "images"
+	// TagsTable is the table that holds the tags relation/edge. The primary key declared below.
+	TagsTable = "tag_images"
+	// TagsInverseTable is the table name for the Tag entity.
+	// It exists in this package in order to avoid circular dependency with the "tag" package.
+	TagsInverseTable = "tags"
+)
+
+// Columns holds all SQL columns for image fields.
+var Columns = []string{
+	FieldID,
+	FieldOwnerID,
+	FieldVisibility,
+	FieldOriginalName,
+	FieldStoredName,
+	FieldContentType,
+	FieldSize,
+	FieldChecksum,
+	FieldURL,
+	FieldWidth,
+	FieldHeight,
+	FieldBlurHash,
+	FieldDominantColor,
+	FieldAlt,
+	FieldCaption,
+	FieldCredit,
+	FieldLicense,
+	FieldCreatedAt,
+}
+
+var (
+	// TagsPrimaryKey and TagsColumn2 are the table columns denoting the
+	// primary key for the tags relation (M2M).
+	TagsPrimaryKey = []string{"tag_id", "image_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultVisibility holds the default value on creation for the "visibility" field.
+	DefaultVisibility string
+	// StoredNameValidator is a validator for the "stored_name" field. It is called by the builders before save.
+	StoredNameValidator func(string) error
+	// ContentTypeValidator is a validator for the "content_type" field. It is called by the builders before save.
+	ContentTypeValidator func(string) error
+	// DefaultWidth holds the default value on creation for the "width" field.
+	DefaultWidth int
+	// DefaultHeight holds the default value on creation for the "height" field.
+	DefaultHeight int
+	// DefaultBlurHash holds the default value on creation for the "blur_hash" field.
+	DefaultBlurHash string
+	// DefaultDominantColor holds the default value on creation for the "dominant_color" field.
+	DefaultDominantColor string
+	// DefaultCaption holds the default value on creation for the "caption" field.
+	DefaultCaption string
+	// DefaultCredit holds the default value on creation for the "credit" field.
+	DefaultCredit string
+	// DefaultLicense holds the default value on creation for the "license" field.
+	DefaultLicense string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the Image queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByOwnerID orders the results by the owner_id field.
+func ByOwnerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwnerID, opts...).ToFunc()
+}
+
+// ByVisibility orders the results by the visibility field.
+func ByVisibility(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVisibility, opts...).ToFunc()
+}
+
+// ByOriginalName orders the results by the original_name field.
+func ByOriginalName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOriginalName, opts...).ToFunc()
+}
+
+// ByStoredName orders the results by the stored_name field.
+func ByStoredName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStoredName, opts...).ToFunc()
+}
+
+// ByContentType orders the results by the content_type field.
+func ByContentType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentType, opts...).ToFunc()
+}
+
+// BySize orders the results by the size field.
+func BySize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSize, opts...).ToFunc()
+}
+
+// ByChecksum orders the results by the checksum field.
+func ByChecksum(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChecksum, opts...).ToFunc()
+}
+
+// ByURL orders the results by the url field.
+func ByURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldURL, opts...).ToFunc()
+}
+
+// ByWidth orders the results by the width field.
+func ByWidth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWidth, opts...).ToFunc()
+}
+
+// ByHeight orders the results by the height field.
+func ByHeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeight, opts...).ToFunc()
+}
+
+// ByBlurHash orders the results by the blur_hash field.
+func ByBlurHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlurHash, opts...).ToFunc()
+}
+
+// ByDominantColor orders the results by the dominant_color field.
+func ByDominantColor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDominantColor, opts...).ToFunc()
+}
+
+// ByCaption orders the results by the caption field.
+func ByCaption(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCaption, opts...).ToFunc()
+}
+
+// ByCredit orders the results by the credit field.
+func ByCredit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCredit, opts...).ToFunc()
+}
+
+// ByLicense orders the results by the license field.
+func ByLicense(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLicense, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByTagsCount orders the results by tags count.
+func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTagsStep(), opts...)
+	}
+}
+
+// ByTags orders the results by tags terms.
+func ByTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, TagsTable, TagsPrimaryKey...),
+	)
+}
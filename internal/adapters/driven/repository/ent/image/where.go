@@ -0,0 +1,1120 @@
+// Code generated by ent, DO NOT EDIT.
+
+package image
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Image {
+	return predicate.Image(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Image {
+	return predicate.Image(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Image {
+	return predicate.Image(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Image {
+	return predicate.Image(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Image {
+	return predicate.Image(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Image {
+	return predicate.Image(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Image {
+	return predicate.Image(sql.FieldLTE(FieldID, id))
+}
+
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v uuid.UUID) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// Visibility applies equality check predicate on the "visibility" field. It's identical to VisibilityEQ.
+func Visibility(v string) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldVisibility, v))
+}
+
+// OriginalName applies equality check predicate on the "original_name" field. It's identical to OriginalNameEQ.
+func OriginalName(v string) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldOriginalName, v))
+}
+
+// StoredName applies equality check predicate on the "stored_name" field. It's identical to StoredNameEQ.
+func StoredName(v string) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldStoredName, v))
+}
+
+// ContentType applies equality check predicate on the "content_type" field. It's identical to ContentTypeEQ.
+func ContentType(v string) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldContentType, v))
+}
+
+// Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
+func Size(v int64) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldSize, v))
+}
+
+// Checksum applies equality check predicate on the "checksum" field. It's identical to ChecksumEQ.
+func Checksum(v string) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldChecksum, v))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldURL, v))
+}
+
+// Width applies equality check predicate on the "width" field. It's identical to WidthEQ.
+func Width(v int) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldWidth, v))
+}
+
+// Height applies equality check predicate on the "height" field. It's identical to HeightEQ.
+func Height(v int) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldHeight, v))
+}
+
+// BlurHash applies equality check predicate on the "blur_hash" field. It's identical to BlurHashEQ.
+func BlurHash(v string) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldBlurHash, v))
+}
+
+// DominantColor applies equality check predicate on the "dominant_color" field. It's identical to DominantColorEQ.
+func DominantColor(v string) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldDominantColor, v))
+}
+
+// Caption applies equality check predicate on the "caption" field. It's identical to CaptionEQ.
+func Caption(v string) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldCaption, v))
+}
+
+// Credit applies equality check predicate on the "credit" field. It's identical to CreditEQ.
+func Credit(v string) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldCredit, v))
+}
+
+// License applies equality check predicate on the "license" field. It's identical to LicenseEQ.
+func License(v string) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldLicense, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v uuid.UUID) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v uuid.UUID) predicate.Image {
+	return predicate.Image(sql.FieldNEQ(FieldOwnerID, v))
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...uuid.UUID) predicate.Image {
+	return predicate.Image(sql.FieldIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...uuid.UUID) predicate.Image {
+	return predicate.Image(sql.FieldNotIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDGT applies the GT predicate on the "owner_id" field.
+func OwnerIDGT(v uuid.UUID) predicate.Image {
+	return predicate.Image(sql.FieldGT(FieldOwnerID, v))
+}
+
+// OwnerIDGTE applies the GTE predicate on the "owner_id" field.
+func OwnerIDGTE(v uuid.UUID) predicate.Image {
+	return predicate.Image(sql.FieldGTE(FieldOwnerID, v))
+}
+
+// OwnerIDLT applies the LT predicate on the "owner_id" field.
+func OwnerIDLT(v uuid.UUID) predicate.Image {
+	return predicate.Image(sql.FieldLT(FieldOwnerID, v))
+}
+
+// OwnerIDLTE applies the LTE predicate on the "owner_id" field.
+func OwnerIDLTE(v uuid.UUID) predicate.Image {
+	return predicate.Image(sql.FieldLTE(FieldOwnerID, v))
+}
+
+// OwnerIDIsNil applies the IsNil predicate on the "owner_id" field.
+func OwnerIDIsNil() predicate.Image {
+	return predicate.Image(sql.FieldIsNull(FieldOwnerID))
+}
+
+// OwnerIDNotNil applies the NotNil predicate on the "owner_id" field.
+func OwnerIDNotNil() predicate.Image {
+	return predicate.Image(sql.FieldNotNull(FieldOwnerID))
+}
+
+// VisibilityEQ applies the EQ predicate on the "visibility" field.
+func VisibilityEQ(v string) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldVisibility, v))
+}
+
+// VisibilityNEQ applies the NEQ predicate on the "visibility" field.
+func VisibilityNEQ(v string) predicate.Image {
+	return predicate.Image(sql.FieldNEQ(FieldVisibility, v))
+}
+
+// VisibilityIn applies the In predicate on the "visibility" field.
+func VisibilityIn(vs ...string) predicate.Image {
+	return predicate.Image(sql.FieldIn(FieldVisibility, vs...))
+}
+
+// VisibilityNotIn applies the NotIn predicate on the "visibility" field.
+func VisibilityNotIn(vs ...string) predicate.Image {
+	return predicate.Image(sql.FieldNotIn(FieldVisibility, vs...))
+}
+
+// VisibilityGT applies the GT predicate on the "visibility" field.
+func VisibilityGT(v string) predicate.Image {
+	return predicate.Image(sql.FieldGT(FieldVisibility, v))
+}
+
+// VisibilityGTE applies the GTE predicate on the "visibility" field.
+func VisibilityGTE(v string) predicate.Image {
+	return predicate.Image(sql.FieldGTE(FieldVisibility, v))
+}
+
+// VisibilityLT applies the LT predicate on the "visibility" field.
+func VisibilityLT(v string) predicate.Image {
+	return predicate.Image(sql.FieldLT(FieldVisibility, v))
+}
+
+// VisibilityLTE applies the LTE predicate on the "visibility" field.
+func VisibilityLTE(v string) predicate.Image {
+	return predicate.Image(sql.FieldLTE(FieldVisibility, v))
+}
+
+// VisibilityContains applies the Contains predicate on the "visibility" field.
+func VisibilityContains(v string) predicate.Image {
+	return predicate.Image(sql.FieldContains(FieldVisibility, v))
+}
+
+// VisibilityHasPrefix applies the HasPrefix predicate on the "visibility" field.
+func VisibilityHasPrefix(v string) predicate.Image {
+	return predicate.Image(sql.FieldHasPrefix(FieldVisibility, v))
+}
+
+// VisibilityHasSuffix applies the HasSuffix predicate on the "visibility" field.
+func VisibilityHasSuffix(v string) predicate.Image {
+	return predicate.Image(sql.FieldHasSuffix(FieldVisibility, v))
+}
+
+// VisibilityEqualFold applies the EqualFold predicate on the "visibility" field.
+func VisibilityEqualFold(v string) predicate.Image {
+	return predicate.Image(sql.FieldEqualFold(FieldVisibility, v))
+}
+
+// VisibilityContainsFold applies the ContainsFold predicate on the "visibility" field.
+func VisibilityContainsFold(v string) predicate.Image {
+	return predicate.Image(sql.FieldContainsFold(FieldVisibility, v))
+}
+
+// OriginalNameEQ applies the EQ predicate on the "original_name" field.
+func OriginalNameEQ(v string) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldOriginalName, v))
+}
+
+// OriginalNameNEQ applies the NEQ predicate on the "original_name" field.
+func OriginalNameNEQ(v string) predicate.Image {
+	return predicate.Image(sql.FieldNEQ(FieldOriginalName, v))
+}
+
+// OriginalNameIn applies the In predicate on the "original_name" field.
+func OriginalNameIn(vs ...string) predicate.Image {
+	return predicate.Image(sql.FieldIn(FieldOriginalName, vs...))
+}
+
+// OriginalNameNotIn applies the NotIn predicate on the "original_name" field.
+func OriginalNameNotIn(vs ...string) predicate.Image {
+	return predicate.Image(sql.FieldNotIn(FieldOriginalName, vs...))
+}
+
+// OriginalNameGT applies the GT predicate on the "original_name" field.
+func OriginalNameGT(v string) predicate.Image {
+	return predicate.Image(sql.FieldGT(FieldOriginalName, v))
+}
+
+// OriginalNameGTE applies the GTE predicate on the "original_name" field.
+func OriginalNameGTE(v string) predicate.Image {
+	return predicate.Image(sql.FieldGTE(FieldOriginalName, v))
+}
+
+// OriginalNameLT applies the LT predicate on the "original_name" field.
+func OriginalNameLT(v string) predicate.Image {
+	return predicate.Image(sql.FieldLT(FieldOriginalName, v))
+}
+
+// OriginalNameLTE applies the LTE predicate on the "original_name" field.
+func OriginalNameLTE(v string) predicate.Image {
+	return predicate.Image(sql.FieldLTE(FieldOriginalName, v))
+}
+
+// OriginalNameContains applies the Contains predicate on the "original_name" field.
+func OriginalNameContains(v string) predicate.Image {
+	return predicate.Image(sql.FieldContains(FieldOriginalName, v))
+}
+
+// OriginalNameHasPrefix applies the HasPrefix predicate on the "original_name" field.
+func OriginalNameHasPrefix(v string) predicate.Image {
+	return predicate.Image(sql.FieldHasPrefix(FieldOriginalName, v))
+}
+
+// OriginalNameHasSuffix applies the HasSuffix predicate on the "original_name" field.
+func OriginalNameHasSuffix(v string) predicate.Image {
+	return predicate.Image(sql.FieldHasSuffix(FieldOriginalName, v))
+}
+
+// OriginalNameEqualFold applies the EqualFold predicate on the "original_name" field.
+func OriginalNameEqualFold(v string) predicate.Image {
+	return predicate.Image(sql.FieldEqualFold(FieldOriginalName, v))
+}
+
+// OriginalNameContainsFold applies the ContainsFold predicate on the "original_name" field.
+func OriginalNameContainsFold(v string) predicate.Image {
+	return predicate.Image(sql.FieldContainsFold(FieldOriginalName, v))
+}
+
+// StoredNameEQ applies the EQ predicate on the "stored_name" field.
+func StoredNameEQ(v string) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldStoredName, v))
+}
+
+// StoredNameNEQ applies the NEQ predicate on the "stored_name" field.
+func StoredNameNEQ(v string) predicate.Image {
+	return predicate.Image(sql.FieldNEQ(FieldStoredName, v))
+}
+
+// StoredNameIn applies the In predicate on the "stored_name" field.
+func StoredNameIn(vs ...string) predicate.Image {
+	return predicate.Image(sql.FieldIn(FieldStoredName, vs...))
+}
+
+// StoredNameNotIn applies the NotIn predicate on the "stored_name" field.
+func StoredNameNotIn(vs ...string) predicate.Image {
+	return predicate.Image(sql.FieldNotIn(FieldStoredName, vs...))
+}
+
+// StoredNameGT applies the GT predicate on the "stored_name" field.
+func StoredNameGT(v string) predicate.Image {
+	return predicate.Image(sql.FieldGT(FieldStoredName, v))
+}
+
+// StoredNameGTE applies the GTE predicate on the "stored_name" field.
+func StoredNameGTE(v string) predicate.Image {
+	return predicate.Image(sql.FieldGTE(FieldStoredName, v))
+}
+
+// StoredNameLT applies the LT predicate on the "stored_name" field.
+func StoredNameLT(v string) predicate.Image {
+	return predicate.Image(sql.FieldLT(FieldStoredName, v))
+}
+
+// StoredNameLTE applies the LTE predicate on the "stored_name" field.
+func StoredNameLTE(v string) predicate.Image {
+	return predicate.Image(sql.FieldLTE(FieldStoredName, v))
+}
+
+// StoredNameContains applies the Contains predicate on the "stored_name" field.
+func StoredNameContains(v string) predicate.Image {
+	return predicate.Image(sql.FieldContains(FieldStoredName, v))
+}
+
+// StoredNameHasPrefix applies the HasPrefix predicate on the "stored_name" field.
+func StoredNameHasPrefix(v string) predicate.Image {
+	return predicate.Image(sql.FieldHasPrefix(FieldStoredName, v))
+}
+
+// StoredNameHasSuffix applies the HasSuffix predicate on the "stored_name" field.
+func StoredNameHasSuffix(v string) predicate.Image {
+	return predicate.Image(sql.FieldHasSuffix(FieldStoredName, v))
+}
+
+// StoredNameEqualFold applies the EqualFold predicate on the "stored_name" field.
+func StoredNameEqualFold(v string) predicate.Image {
+	return predicate.Image(sql.FieldEqualFold(FieldStoredName, v))
+}
+
+// StoredNameContainsFold applies the ContainsFold predicate on the "stored_name" field.
+func StoredNameContainsFold(v string) predicate.Image {
+	return predicate.Image(sql.FieldContainsFold(FieldStoredName, v))
+}
+
+// ContentTypeEQ applies the EQ predicate on the "content_type" field.
+func ContentTypeEQ(v string) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldContentType, v))
+}
+
+// ContentTypeNEQ applies the NEQ predicate on the "content_type" field.
+func ContentTypeNEQ(v string) predicate.Image {
+	return predicate.Image(sql.FieldNEQ(FieldContentType, v))
+}
+
+// ContentTypeIn applies the In predicate on the "content_type" field.
+func ContentTypeIn(vs ...string) predicate.Image {
+	return predicate.Image(sql.FieldIn(FieldContentType, vs...))
+}
+
+// ContentTypeNotIn applies the NotIn predicate on the "content_type" field.
+func ContentTypeNotIn(vs ...string) predicate.Image {
+	return predicate.Image(sql.FieldNotIn(FieldContentType, vs...))
+}
+
+// ContentTypeGT applies the GT predicate on the "content_type" field.
+func ContentTypeGT(v string) predicate.Image {
+	return predicate.Image(sql.FieldGT(FieldContentType, v))
+}
+
+// ContentTypeGTE applies the GTE predicate on the "content_type" field.
+func ContentTypeGTE(v string) predicate.Image {
+	return predicate.Image(sql.FieldGTE(FieldContentType, v))
+}
+
+// ContentTypeLT applies the LT predicate on the "content_type" field.
+func ContentTypeLT(v string) predicate.Image {
+	return predicate.Image(sql.FieldLT(FieldContentType, v))
+}
+
+// ContentTypeLTE applies the LTE predicate on the "content_type" field.
+func ContentTypeLTE(v string) predicate.Image {
+	return predicate.Image(sql.FieldLTE(FieldContentType, v))
+}
+
+// ContentTypeContains applies the Contains predicate on the "content_type" field.
+func ContentTypeContains(v string) predicate.Image {
+	return predicate.Image(sql.FieldContains(FieldContentType, v))
+}
+
+// ContentTypeHasPrefix applies the HasPrefix predicate on the "content_type" field.
+func ContentTypeHasPrefix(v string) predicate.Image {
+	return predicate.Image(sql.FieldHasPrefix(FieldContentType, v))
+}
+
+// ContentTypeHasSuffix applies the HasSuffix predicate on the "content_type" field.
+func ContentTypeHasSuffix(v string) predicate.Image {
+	return predicate.Image(sql.FieldHasSuffix(FieldContentType, v))
+}
+
+// ContentTypeEqualFold applies the EqualFold predicate on the "content_type" field.
+func ContentTypeEqualFold(v string) predicate.Image {
+	return predicate.Image(sql.FieldEqualFold(FieldContentType, v))
+}
+
+// ContentTypeContainsFold applies the ContainsFold predicate on the "content_type" field.
+func ContentTypeContainsFold(v string) predicate.Image {
+	return predicate.Image(sql.FieldContainsFold(FieldContentType, v))
+}
+
+// SizeEQ applies the EQ predicate on the "size" field.
+func SizeEQ(v int64) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldSize, v))
+}
+
+// SizeNEQ applies the NEQ predicate on the "size" field.
+func SizeNEQ(v int64) predicate.Image {
+	return predicate.Image(sql.FieldNEQ(FieldSize, v))
+}
+
+// SizeIn applies the In predicate on the "size" field.
+func SizeIn(vs ...int64) predicate.Image {
+	return predicate.Image(sql.FieldIn(FieldSize, vs...))
+}
+
+// SizeNotIn applies the NotIn predicate on the "size" field.
+func SizeNotIn(vs ...int64) predicate.Image {
+	return predicate.Image(sql.FieldNotIn(FieldSize, vs...))
+}
+
+// SizeGT applies the GT predicate on the "size" field.
+func SizeGT(v int64) predicate.Image {
+	return predicate.Image(sql.FieldGT(FieldSize, v))
+}
+
+// SizeGTE applies the GTE predicate on the "size" field.
+func SizeGTE(v int64) predicate.Image {
+	return predicate.Image(sql.FieldGTE(FieldSize, v))
+}
+
+// SizeLT applies the LT predicate on the "size" field.
+func SizeLT(v int64) predicate.Image {
+	return predicate.Image(sql.FieldLT(FieldSize, v))
+}
+
+// SizeLTE applies the LTE predicate on the "size" field.
+func SizeLTE(v int64) predicate.Image {
+	return predicate.Image(sql.FieldLTE(FieldSize, v))
+}
+
+// ChecksumEQ applies the EQ predicate on the "checksum" field.
+func ChecksumEQ(v string) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldChecksum, v))
+}
+
+// ChecksumNEQ applies the NEQ predicate on the "checksum" field.
+func ChecksumNEQ(v string) predicate.Image {
+	return predicate.Image(sql.FieldNEQ(FieldChecksum, v))
+}
+
+// ChecksumIn applies the In predicate on the "checksum" field.
+func ChecksumIn(vs ...string) predicate.Image {
+	return predicate.Image(sql.FieldIn(FieldChecksum, vs...))
+}
+
+// ChecksumNotIn applies the NotIn predicate on the "checksum" field.
+func ChecksumNotIn(vs ...string) predicate.Image {
+	return predicate.Image(sql.FieldNotIn(FieldChecksum, vs...))
+}
+
+// ChecksumGT applies the GT predicate on the "checksum" field.
+func ChecksumGT(v string) predicate.Image {
+	return predicate.Image(sql.FieldGT(FieldChecksum, v))
+}
+
+// ChecksumGTE applies the GTE predicate on the "checksum" field.
+func ChecksumGTE(v string) predicate.Image {
+	return predicate.Image(sql.FieldGTE(FieldChecksum, v))
+}
+
+// ChecksumLT applies the LT predicate on the "checksum" field.
+func ChecksumLT(v string) predicate.Image {
+	return predicate.Image(sql.FieldLT(FieldChecksum, v))
+}
+
+// ChecksumLTE applies the LTE predicate on the "checksum" field.
+func ChecksumLTE(v string) predicate.Image {
+	return predicate.Image(sql.FieldLTE(FieldChecksum, v))
+}
+
+// ChecksumContains applies the Contains predicate on the "checksum" field.
+func ChecksumContains(v string) predicate.Image {
+	return predicate.Image(sql.FieldContains(FieldChecksum, v))
+}
+
+// ChecksumHasPrefix applies the HasPrefix predicate on the "checksum" field.
+func ChecksumHasPrefix(v string) predicate.Image {
+	return predicate.Image(sql.FieldHasPrefix(FieldChecksum, v))
+}
+
+// ChecksumHasSuffix applies the HasSuffix predicate on the "checksum" field.
+func ChecksumHasSuffix(v string) predicate.Image {
+	return predicate.Image(sql.FieldHasSuffix(FieldChecksum, v))
+}
+
+// ChecksumIsNil applies the IsNil predicate on the "checksum" field.
+func ChecksumIsNil() predicate.Image {
+	return predicate.Image(sql.FieldIsNull(FieldChecksum))
+}
+
+// ChecksumNotNil applies the NotNil predicate on the "checksum" field.
+func ChecksumNotNil() predicate.Image {
+	return predicate.Image(sql.FieldNotNull(FieldChecksum))
+}
+
+// ChecksumEqualFold applies the EqualFold predicate on the "checksum" field.
+func ChecksumEqualFold(v string) predicate.Image {
+	return predicate.Image(sql.FieldEqualFold(FieldChecksum, v))
+}
+
+// ChecksumContainsFold applies the ContainsFold predicate on the "checksum" field.
+func ChecksumContainsFold(v string) predicate.Image {
+	return predicate.Image(sql.FieldContainsFold(FieldChecksum, v))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.Image {
+	return predicate.Image(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.Image {
+	return predicate.Image(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.Image {
+	return predicate.Image(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.Image {
+	return predicate.Image(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.Image {
+	return predicate.Image(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.Image {
+	return predicate.Image(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.Image {
+	return predicate.Image(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.Image {
+	return predicate.Image(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.Image {
+	return predicate.Image(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.Image {
+	return predicate.Image(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.Image {
+	return predicate.Image(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.Image {
+	return predicate.Image(sql.FieldContainsFold(FieldURL, v))
+}
+
+// WidthEQ applies the EQ predicate on the "width" field.
+func WidthEQ(v int) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldWidth, v))
+}
+
+// WidthNEQ applies the NEQ predicate on the "width" field.
+func WidthNEQ(v int) predicate.Image {
+	return predicate.Image(sql.FieldNEQ(FieldWidth, v))
+}
+
+// WidthIn applies the In predicate on the "width" field.
+func WidthIn(vs ...int) predicate.Image {
+	return predicate.Image(sql.FieldIn(FieldWidth, vs...))
+}
+
+// WidthNotIn applies the NotIn predicate on the "width" field.
+func WidthNotIn(vs ...int) predicate.Image {
+	return predicate.Image(sql.FieldNotIn(FieldWidth, vs...))
+}
+
+// WidthGT applies the GT predicate on the "width" field.
+func WidthGT(v int) predicate.Image {
+	return predicate.Image(sql.FieldGT(FieldWidth, v))
+}
+
+// WidthGTE applies the GTE predicate on the "width" field.
+func WidthGTE(v int) predicate.Image {
+	return predicate.Image(sql.FieldGTE(FieldWidth, v))
+}
+
+// WidthLT applies the LT predicate on the "width" field.
+func WidthLT(v int) predicate.Image {
+	return predicate.Image(sql.FieldLT(FieldWidth, v))
+}
+
+// WidthLTE applies the LTE predicate on the "width" field.
+func WidthLTE(v int) predicate.Image {
+	return predicate.Image(sql.FieldLTE(FieldWidth, v))
+}
+
+// HeightEQ applies the EQ predicate on the "height" field.
+func HeightEQ(v int) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldHeight, v))
+}
+
+// HeightNEQ applies the NEQ predicate on the "height" field.
+func HeightNEQ(v int) predicate.Image {
+	return predicate.Image(sql.FieldNEQ(FieldHeight, v))
+}
+
+// HeightIn applies the In predicate on the "height" field.
+func HeightIn(vs ...int) predicate.Image {
+	return predicate.Image(sql.FieldIn(FieldHeight, vs...))
+}
+
+// HeightNotIn applies the NotIn predicate on the "height" field.
+func HeightNotIn(vs ...int) predicate.Image {
+	return predicate.Image(sql.FieldNotIn(FieldHeight, vs...))
+}
+
+// HeightGT applies the GT predicate on the "height" field.
+func HeightGT(v int) predicate.Image {
+	return predicate.Image(sql.FieldGT(FieldHeight, v))
+}
+
+// HeightGTE applies the GTE predicate on the "height" field.
+func HeightGTE(v int) predicate.Image {
+	return predicate.Image(sql.FieldGTE(FieldHeight, v))
+}
+
+// HeightLT applies the LT predicate on the "height" field.
+func HeightLT(v int) predicate.Image {
+	return predicate.Image(sql.FieldLT(FieldHeight, v))
+}
+
+// HeightLTE applies the LTE predicate on the "height" field.
+func HeightLTE(v int) predicate.Image {
+	return predicate.Image(sql.FieldLTE(FieldHeight, v))
+}
+
+// BlurHashEQ applies the EQ predicate on the "blur_hash" field.
+func BlurHashEQ(v string) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldBlurHash, v))
+}
+
+// BlurHashNEQ applies the NEQ predicate on the "blur_hash" field.
+func BlurHashNEQ(v string) predicate.Image {
+	return predicate.Image(sql.FieldNEQ(FieldBlurHash, v))
+}
+
+// BlurHashIn applies the In predicate on the "blur_hash" field.
+func BlurHashIn(vs ...string) predicate.Image {
+	return predicate.Image(sql.FieldIn(FieldBlurHash, vs...))
+}
+
+// BlurHashNotIn applies the NotIn predicate on the "blur_hash" field.
+func BlurHashNotIn(vs ...string) predicate.Image {
+	return predicate.Image(sql.FieldNotIn(FieldBlurHash, vs...))
+}
+
+// BlurHashGT applies the GT predicate on the "blur_hash" field.
+func BlurHashGT(v string) predicate.Image {
+	return predicate.Image(sql.FieldGT(FieldBlurHash, v))
+}
+
+// BlurHashGTE applies the GTE predicate on the "blur_hash" field.
+func BlurHashGTE(v string) predicate.Image {
+	return predicate.Image(sql.FieldGTE(FieldBlurHash, v))
+}
+
+// BlurHashLT applies the LT predicate on the "blur_hash" field.
+func BlurHashLT(v string) predicate.Image {
+	return predicate.Image(sql.FieldLT(FieldBlurHash, v))
+}
+
+// BlurHashLTE applies the LTE predicate on the "blur_hash" field.
+func BlurHashLTE(v string) predicate.Image {
+	return predicate.Image(sql.FieldLTE(FieldBlurHash, v))
+}
+
+// BlurHashContains applies the Contains predicate on the "blur_hash" field.
+func BlurHashContains(v string) predicate.Image {
+	return predicate.Image(sql.FieldContains(FieldBlurHash, v))
+}
+
+// BlurHashHasPrefix applies the HasPrefix predicate on the "blur_hash" field.
+func BlurHashHasPrefix(v string) predicate.Image {
+	return predicate.Image(sql.FieldHasPrefix(FieldBlurHash, v))
+}
+
+// BlurHashHasSuffix applies the HasSuffix predicate on the "blur_hash" field.
+func BlurHashHasSuffix(v string) predicate.Image {
+	return predicate.Image(sql.FieldHasSuffix(FieldBlurHash, v))
+}
+
+// BlurHashEqualFold applies the EqualFold predicate on the "blur_hash" field.
+func BlurHashEqualFold(v string) predicate.Image {
+	return predicate.Image(sql.FieldEqualFold(FieldBlurHash, v))
+}
+
+// BlurHashContainsFold applies the ContainsFold predicate on the "blur_hash" field.
+func BlurHashContainsFold(v string) predicate.Image {
+	return predicate.Image(sql.FieldContainsFold(FieldBlurHash, v))
+}
+
+// DominantColorEQ applies the EQ predicate on the "dominant_color" field.
+func DominantColorEQ(v string) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldDominantColor, v))
+}
+
+// DominantColorNEQ applies the NEQ predicate on the "dominant_color" field.
+func DominantColorNEQ(v string) predicate.Image {
+	return predicate.Image(sql.FieldNEQ(FieldDominantColor, v))
+}
+
+// DominantColorIn applies the In predicate on the "dominant_color" field.
+func DominantColorIn(vs ...string) predicate.Image {
+	return predicate.Image(sql.FieldIn(FieldDominantColor, vs...))
+}
+
+// DominantColorNotIn applies the NotIn predicate on the "dominant_color" field.
+func DominantColorNotIn(vs ...string) predicate.Image {
+	return predicate.Image(sql.FieldNotIn(FieldDominantColor, vs...))
+}
+
+// DominantColorGT applies the GT predicate on the "dominant_color" field.
+func DominantColorGT(v string) predicate.Image {
+	return predicate.Image(sql.FieldGT(FieldDominantColor, v))
+}
+
+// DominantColorGTE applies the GTE predicate on the "dominant_color" field.
+func DominantColorGTE(v string) predicate.Image {
+	return predicate.Image(sql.FieldGTE(FieldDominantColor, v))
+}
+
+// DominantColorLT applies the LT predicate on the "dominant_color" field.
+func DominantColorLT(v string) predicate.Image {
+	return predicate.Image(sql.FieldLT(FieldDominantColor, v))
+}
+
+// DominantColorLTE applies the LTE predicate on the "dominant_color" field.
+func DominantColorLTE(v string) predicate.Image {
+	return predicate.Image(sql.FieldLTE(FieldDominantColor, v))
+}
+
+// DominantColorContains applies the Contains predicate on the "dominant_color" field.
+func DominantColorContains(v string) predicate.Image {
+	return predicate.Image(sql.FieldContains(FieldDominantColor, v))
+}
+
+// DominantColorHasPrefix applies the HasPrefix predicate on the "dominant_color" field.
+func DominantColorHasPrefix(v string) predicate.Image {
+	return predicate.Image(sql.FieldHasPrefix(FieldDominantColor, v))
+}
+
+// DominantColorHasSuffix applies the HasSuffix predicate on the "dominant_color" field.
+func DominantColorHasSuffix(v string) predicate.Image {
+	return predicate.Image(sql.FieldHasSuffix(FieldDominantColor, v))
+}
+
+// DominantColorEqualFold applies the EqualFold predicate on the "dominant_color" field.
+func DominantColorEqualFold(v string) predicate.Image {
+	return predicate.Image(sql.FieldEqualFold(FieldDominantColor, v))
+}
+
+// DominantColorContainsFold applies the ContainsFold predicate on the "dominant_color" field.
+func DominantColorContainsFold(v string) predicate.Image {
+	return predicate.Image(sql.FieldContainsFold(FieldDominantColor, v))
+}
+
+// AltIsNil applies the IsNil predicate on the "alt" field.
+func AltIsNil() predicate.Image {
+	return predicate.Image(sql.FieldIsNull(FieldAlt))
+}
+
+// AltNotNil applies the NotNil predicate on the "alt" field.
+func AltNotNil() predicate.Image {
+	return predicate.Image(sql.FieldNotNull(FieldAlt))
+}
+
+// CaptionEQ applies the EQ predicate on the "caption" field.
+func CaptionEQ(v string) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldCaption, v))
+}
+
+// CaptionNEQ applies the NEQ predicate on the "caption" field.
+func CaptionNEQ(v string) predicate.Image {
+	return predicate.Image(sql.FieldNEQ(FieldCaption, v))
+}
+
+// CaptionIn applies the In predicate on the "caption" field.
+func CaptionIn(vs ...string) predicate.Image {
+	return predicate.Image(sql.FieldIn(FieldCaption, vs...))
+}
+
+// CaptionNotIn applies the NotIn predicate on the "caption" field.
+func CaptionNotIn(vs ...string) predicate.Image {
+	return predicate.Image(sql.FieldNotIn(FieldCaption, vs...))
+}
+
+// CaptionGT applies the GT predicate on the "caption" field.
+func CaptionGT(v string) predicate.Image {
+	return predicate.Image(sql.FieldGT(FieldCaption, v))
+}
+
+// CaptionGTE applies the GTE predicate on the "caption" field.
+func CaptionGTE(v string) predicate.Image {
+	return predicate.Image(sql.FieldGTE(FieldCaption, v))
+}
+
+// CaptionLT applies the LT predicate on the "caption" field.
+func CaptionLT(v string) predicate.Image {
+	return predicate.Image(sql.FieldLT(FieldCaption, v))
+}
+
+// CaptionLTE applies the LTE predicate on the "caption" field.
+func CaptionLTE(v string) predicate.Image {
+	return predicate.Image(sql.FieldLTE(FieldCaption, v))
+}
+
+// CaptionContains applies the Contains predicate on the "caption" field.
+func CaptionContains(v string) predicate.Image {
+	return predicate.Image(sql.FieldContains(FieldCaption, v))
+}
+
+// CaptionHasPrefix applies the HasPrefix predicate on the "caption" field.
+func CaptionHasPrefix(v string) predicate.Image {
+	return predicate.Image(sql.FieldHasPrefix(FieldCaption, v))
+}
+
+// CaptionHasSuffix applies the HasSuffix predicate on the "caption" field.
+func CaptionHasSuffix(v string) predicate.Image {
+	return predicate.Image(sql.FieldHasSuffix(FieldCaption, v))
+}
+
+// CaptionEqualFold applies the EqualFold predicate on the "caption" field.
+func CaptionEqualFold(v string) predicate.Image {
+	return predicate.Image(sql.FieldEqualFold(FieldCaption, v))
+}
+
+// CaptionContainsFold applies the ContainsFold predicate on the "caption" field.
+func CaptionContainsFold(v string) predicate.Image {
+	return predicate.Image(sql.FieldContainsFold(FieldCaption, v))
+}
+
+// CreditEQ applies the EQ predicate on the "credit" field.
+func CreditEQ(v string) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldCredit, v))
+}
+
+// CreditNEQ applies the NEQ predicate on the "credit" field.
+func CreditNEQ(v string) predicate.Image {
+	return predicate.Image(sql.FieldNEQ(FieldCredit, v))
+}
+
+// CreditIn applies the In predicate on the "credit" field.
+func CreditIn(vs ...string) predicate.Image {
+	return predicate.Image(sql.FieldIn(FieldCredit, vs...))
+}
+
+// CreditNotIn applies the NotIn predicate on the "credit" field.
+func CreditNotIn(vs ...string) predicate.Image {
+	return predicate.Image(sql.FieldNotIn(FieldCredit, vs...))
+}
+
+// CreditGT applies the GT predicate on the "credit" field.
+func CreditGT(v string) predicate.Image {
+	return predicate.Image(sql.FieldGT(FieldCredit, v))
+}
+
+// CreditGTE applies the GTE predicate on the "credit" field.
+func CreditGTE(v string) predicate.Image {
+	return predicate.Image(sql.FieldGTE(FieldCredit, v))
+}
+
+// CreditLT applies the LT predicate on the "credit" field.
+func CreditLT(v string) predicate.Image {
+	return predicate.Image(sql.FieldLT(FieldCredit, v))
+}
+
+// CreditLTE applies the LTE predicate on the "credit" field.
+func CreditLTE(v string) predicate.Image {
+	return predicate.Image(sql.FieldLTE(FieldCredit, v))
+}
+
+// CreditContains applies the Contains predicate on the "credit" field.
+func CreditContains(v string) predicate.Image {
+	return predicate.Image(sql.FieldContains(FieldCredit, v))
+}
+
+// CreditHasPrefix applies the HasPrefix predicate on the "credit" field.
+func CreditHasPrefix(v string) predicate.Image {
+	return predicate.Image(sql.FieldHasPrefix(FieldCredit, v))
+}
+
+// CreditHasSuffix applies the HasSuffix predicate on the "credit" field.
+func CreditHasSuffix(v string) predicate.Image {
+	return predicate.Image(sql.FieldHasSuffix(FieldCredit, v))
+}
+
+// CreditEqualFold applies the EqualFold predicate on the "credit" field.
+func CreditEqualFold(v string) predicate.Image {
+	return predicate.Image(sql.FieldEqualFold(FieldCredit, v))
+}
+
+// CreditContainsFold applies the ContainsFold predicate on the "credit" field.
+func CreditContainsFold(v string) predicate.Image {
+	return predicate.Image(sql.FieldContainsFold(FieldCredit, v))
+}
+
+// LicenseEQ applies the EQ predicate on the "license" field.
+func LicenseEQ(v string) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldLicense, v))
+}
+
+// LicenseNEQ applies the NEQ predicate on the "license" field.
+func LicenseNEQ(v string) predicate.Image {
+	return predicate.Image(sql.FieldNEQ(FieldLicense, v))
+}
+
+// LicenseIn applies the In predicate on the "license" field.
+func LicenseIn(vs ...string) predicate.Image {
+	return predicate.Image(sql.FieldIn(FieldLicense, vs...))
+}
+
+// LicenseNotIn applies the NotIn predicate on the "license" field.
+func LicenseNotIn(vs ...string) predicate.Image {
+	return predicate.Image(sql.FieldNotIn(FieldLicense, vs...))
+}
+
+// LicenseGT applies the GT predicate on the "license" field.
+func LicenseGT(v string) predicate.Image {
+	return predicate.Image(sql.FieldGT(FieldLicense, v))
+}
+
+// LicenseGTE applies the GTE predicate on the "license" field.
+func LicenseGTE(v string) predicate.Image {
+	return predicate.Image(sql.FieldGTE(FieldLicense, v))
+}
+
+// LicenseLT applies the LT predicate on the "license" field.
+func LicenseLT(v string) predicate.Image {
+	return predicate.Image(sql.FieldLT(FieldLicense, v))
+}
+
+// LicenseLTE applies the LTE predicate on the "license" field.
+func LicenseLTE(v string) predicate.Image {
+	return predicate.Image(sql.FieldLTE(FieldLicense, v))
+}
+
+// LicenseContains applies the Contains predicate on the "license" field.
+func LicenseContains(v string) predicate.Image {
+	return predicate.Image(sql.FieldContains(FieldLicense, v))
+}
+
+// LicenseHasPrefix applies the HasPrefix predicate on the "license" field.
+func LicenseHasPrefix(v string) predicate.Image {
+	return predicate.Image(sql.FieldHasPrefix(FieldLicense, v))
+}
+
+// LicenseHasSuffix applies the HasSuffix predicate on the "license" field.
+func LicenseHasSuffix(v string) predicate.Image {
+	return predicate.Image(sql.FieldHasSuffix(FieldLicense, v))
+}
+
+// LicenseEqualFold applies the EqualFold predicate on the "license" field.
+func LicenseEqualFold(v string) predicate.Image {
+	return predicate.Image(sql.FieldEqualFold(FieldLicense, v))
+}
+
+// LicenseContainsFold applies the ContainsFold predicate on the "license" field.
+func LicenseContainsFold(v string) predicate.Image {
+	return predicate.Image(sql.FieldContainsFold(FieldLicense, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Image {
+	return predicate.Image(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Image {
+	return predicate.Image(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Image {
+	return predicate.Image(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Image {
+	return predicate.Image(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Image {
+	return predicate.Image(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Image {
+	return predicate.Image(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Image {
+	return predicate.Image(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasTags applies the HasEdge predicate on the "tags" edge.
+func HasTags() predicate.Image {
+	return predicate.Image(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, TagsTable, TagsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagsWith applies the HasEdge predicate on the "tags" edge with a given conditions (other predicates).
+func HasTagsWith(preds ...predicate.Tag) predicate.Image {
+	return predicate.Image(func(s *sql.Selector) {
+		step := newTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Image) predicate.Image {
+	return predicate.Image(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Image) predicate.Image {
+	return predicate.Image(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Image) predicate.Image {
+	return predicate.Image(sql.NotPredicates(p))
+}
@@ -0,0 +1,579 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/image"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/tag"
+)
+
+// ImageCreate is the builder for creating a Image entity.
+type ImageCreate struct {
+	config
+	mutation *ImageMutation
+	hooks    []Hook
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_c *ImageCreate) SetOwnerID(v uuid.UUID) *ImageCreate {
+	_c.mutation.SetOwnerID(v)
+	return _c
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_c *ImageCreate) SetNillableOwnerID(v *uuid.UUID) *ImageCreate {
+	if v != nil {
+		_c.SetOwnerID(*v)
+	}
+	return _c
+}
+
+// SetVisibility sets the "visibility" field.
+func (_c *ImageCreate) SetVisibility(v string) *ImageCreate {
+	_c.mutation.SetVisibility(v)
+	return _c
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_c *ImageCreate) SetNillableVisibility(v *string) *ImageCreate {
+	if v != nil {
+		_c.SetVisibility(*v)
+	}
+	return _c
+}
+
+// SetOriginalName sets the "original_name" field.
+func (_c *ImageCreate) SetOriginalName(v string) *ImageCreate {
+	_c.mutation.SetOriginalName(v)
+	return _c
+}
+
+// SetStoredName sets the "stored_name" field.
+func (_c *ImageCreate) SetStoredName(v string) *ImageCreate {
+	_c.mutation.SetStoredName(v)
+	return _c
+}
+
+// SetContentType sets the "content_type" field.
+func (_c *ImageCreate) SetContentType(v string) *ImageCreate {
+	_c.mutation.SetContentType(v)
+	return _c
+}
+
+// SetSize sets the "size" field.
+func (_c *ImageCreate) SetSize(v int64) *ImageCreate {
+	_c.mutation.SetSize(v)
+	return _c
+}
+
+// SetChecksum sets the "checksum" field.
+func (_c *ImageCreate) SetChecksum(v string) *ImageCreate {
+	_c.mutation.SetChecksum(v)
+	return _c
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (_c *ImageCreate) SetNillableChecksum(v *string) *ImageCreate {
+	if v != nil {
+		_c.SetChecksum(*v)
+	}
+	return _c
+}
+
+// SetURL sets the "url" field.
+func (_c *ImageCreate) SetURL(v string) *ImageCreate {
+	_c.mutation.SetURL(v)
+	return _c
+}
+
+// SetWidth sets the "width" field.
+func (_c *ImageCreate) SetWidth(v int) *ImageCreate {
+	_c.mutation.SetWidth(v)
+	return _c
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (_c *ImageCreate) SetNillableWidth(v *int) *ImageCreate {
+	if v != nil {
+		_c.SetWidth(*v)
+	}
+	return _c
+}
+
+// SetHeight sets the "height" field.
+func (_c *ImageCreate) SetHeight(v int) *ImageCreate {
+	_c.mutation.SetHeight(v)
+	return _c
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (_c *ImageCreate) SetNillableHeight(v *int) *ImageCreate {
+	if v != nil {
+		_c.SetHeight(*v)
+	}
+	return _c
+}
+
+// SetBlurHash sets the "blur_hash" field.
+func (_c *ImageCreate) SetBlurHash(v string) *ImageCreate {
+	_c.mutation.SetBlurHash(v)
+	return _c
+}
+
+// SetNillableBlurHash sets the "blur_hash" field if the given value is not nil.
+func (_c *ImageCreate) SetNillableBlurHash(v *string) *ImageCreate {
+	if v != nil {
+		_c.SetBlurHash(*v)
+	}
+	return _c
+}
+
+// SetDominantColor sets the "dominant_color" field.
+func (_c *ImageCreate) SetDominantColor(v string) *ImageCreate {
+	_c.mutation.SetDominantColor(v)
+	return _c
+}
+
+// SetNillableDominantColor sets the "dominant_color" field if the given value is not nil.
+func (_c *ImageCreate) SetNillableDominantColor(v *string) *ImageCreate {
+	if v != nil {
+		_c.SetDominantColor(*v)
+	}
+	return _c
+}
+
+// SetAlt sets the "alt" field.
+func (_c *ImageCreate) SetAlt(v map[string]string) *ImageCreate {
+	_c.mutation.SetAlt(v)
+	return _c
+}
+
+// SetCaption sets the "caption" field.
+func (_c *ImageCreate) SetCaption(v string) *ImageCreate {
+	_c.mutation.SetCaption(v)
+	return _c
+}
+
+// SetNillableCaption sets the "caption" field if the given value is not nil.
+func (_c *ImageCreate) SetNillableCaption(v *string) *ImageCreate {
+	if v != nil {
+		_c.SetCaption(*v)
+	}
+	return _c
+}
+
+// SetCredit sets the "credit" field.
+func (_c *ImageCreate) SetCredit(v string) *ImageCreate {
+	_c.mutation.SetCredit(v)
+	return _c
+}
+
+// SetNillableCredit sets the "credit" field if the given value is not nil.
+func (_c *ImageCreate) SetNillableCredit(v *string) *ImageCreate {
+	if v != nil {
+		_c.SetCredit(*v)
+	}
+	return _c
+}
+
+// SetLicense sets the "license" field.
+func (_c *ImageCreate) SetLicense(v string) *ImageCreate {
+	_c.mutation.SetLicense(v)
+	return _c
+}
+
+// SetNillableLicense sets the "license" field if the given value is not nil.
+func (_c *ImageCreate) SetNillableLicense(v *string) *ImageCreate {
+	if v != nil {
+		_c.SetLicense(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ImageCreate) SetCreatedAt(v time.Time) *ImageCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ImageCreate) SetNillableCreatedAt(v *time.Time) *ImageCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ImageCreate) SetID(v uuid.UUID) *ImageCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ImageCreate) SetNillableID(v *uuid.UUID) *ImageCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_c *ImageCreate) AddTagIDs(ids ...uuid.UUID) *ImageCreate {
+	_c.mutation.AddTagIDs(ids...)
+	return _c
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_c *ImageCreate) AddTags(v ...*Tag) *ImageCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTagIDs(ids...)
+}
+
+// Mutation returns the ImageMutation object of the builder.
+func (_c *ImageCreate) Mutation() *ImageMutation {
+	return _c.mutation
+}
+
+// Save creates the Image in the database.
+func (_c *ImageCreate) Save(ctx context.Context) (*Image, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ImageCreate) SaveX(ctx context.Context) *Image {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ImageCreate) defaults() {
+	if _, ok := _c.mutation.Visibility(); !ok {
+		v := image.DefaultVisibility
+		_c.mutation.SetVisibility(v)
+	}
+	if _, ok := _c.mutation.Width(); !ok {
+		v := image.DefaultWidth
+		_c.mutation.SetWidth(v)
+	}
+	if _, ok := _c.mutation.Height(); !ok {
+		v := image.DefaultHeight
+		_c.mutation.SetHeight(v)
+	}
+	if _, ok := _c.mutation.BlurHash(); !ok {
+		v := image.DefaultBlurHash
+		_c.mutation.SetBlurHash(v)
+	}
+	if _, ok := _c.mutation.DominantColor(); !ok {
+		v := image.DefaultDominantColor
+		_c.mutation.SetDominantColor(v)
+	}
+	if _, ok := _c.mutation.Caption(); !ok {
+		v := image.DefaultCaption
+		_c.mutation.SetCaption(v)
+	}
+	if _, ok := _c.mutation.Credit(); !ok {
+		v := image.DefaultCredit
+		_c.mutation.SetCredit(v)
+	}
+	if _, ok := _c.mutation.License(); !ok {
+		v := image.DefaultLicense
+		_c.mutation.SetLicense(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := image.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := image.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ImageCreate) check() error {
+	if _, ok := _c.mutation.Visibility(); !ok {
+		return &ValidationError{Name: "visibility", err: errors.New(`ent: missing required field "Image.visibility"`)}
+	}
+	if _, ok := _c.mutation.OriginalName(); !ok {
+		return &ValidationError{Name: "original_name", err: errors.New(`ent: missing required field "Image.original_name"`)}
+	}
+	if _, ok := _c.mutation.StoredName(); !ok {
+		return &ValidationError{Name: "stored_name", err: errors.New(`ent: missing required field "Image.stored_name"`)}
+	}
+	if v, ok := _c.mutation.StoredName(); ok {
+		if err := image.StoredNameValidator(v); err != nil {
+			return &ValidationError{Name: "stored_name", err: fmt.Errorf(`ent: validator failed for field "Image.stored_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ContentType(); !ok {
+		return &ValidationError{Name: "content_type", err: errors.New(`ent: missing required field "Image.content_type"`)}
+	}
+	if v, ok := _c.mutation.ContentType(); ok {
+		if err := image.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`ent: validator failed for field "Image.content_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "Image.size"`)}
+	}
+	if _, ok := _c.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "Image.url"`)}
+	}
+	if _, ok := _c.mutation.Width(); !ok {
+		return &ValidationError{Name: "width", err: errors.New(`ent: missing required field "Image.width"`)}
+	}
+	if _, ok := _c.mutation.Height(); !ok {
+		return &ValidationError{Name: "height", err: errors.New(`ent: missing required field "Image.height"`)}
+	}
+	if _, ok := _c.mutation.BlurHash(); !ok {
+		return &ValidationError{Name: "blur_hash", err: errors.New(`ent: missing required field "Image.blur_hash"`)}
+	}
+	if _, ok := _c.mutation.DominantColor(); !ok {
+		return &ValidationError{Name: "dominant_color", err: errors.New(`ent: missing required field "Image.dominant_color"`)}
+	}
+	if _, ok := _c.mutation.Caption(); !ok {
+		return &ValidationError{Name: "caption", err: errors.New(`ent: missing required field "Image.caption"`)}
+	}
+	if _, ok := _c.mutation.Credit(); !ok {
+		return &ValidationError{Name: "credit", err: errors.New(`ent: missing required field "Image.credit"`)}
+	}
+	if _, ok := _c.mutation.License(); !ok {
+		return &ValidationError{Name: "license", err: errors.New(`ent: missing required field "Image.license"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Image.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *ImageCreate) sqlSave(ctx context.Context) (*Image, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ImageCreate) createSpec() (*Image, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Image{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(image.Table, sqlgraph.NewFieldSpec(image.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.OwnerID(); ok {
+		_spec.SetField(image.FieldOwnerID, field.TypeUUID, value)
+		_node.OwnerID = value
+	}
+	if value, ok := _c.mutation.Visibility(); ok {
+		_spec.SetField(image.FieldVisibility, field.TypeString, value)
+		_node.Visibility = value
+	}
+	if value, ok := _c.mutation.OriginalName(); ok {
+		_spec.SetField(image.FieldOriginalName, field.TypeString, value)
+		_node.OriginalName = value
+	}
+	if value, ok := _c.mutation.StoredName(); ok {
+		_spec.SetField(image.FieldStoredName, field.TypeString, value)
+		_node.StoredName = value
+	}
+	if value, ok := _c.mutation.ContentType(); ok {
+		_spec.SetField(image.FieldContentType, field.TypeString, value)
+		_node.ContentType = value
+	}
+	if value, ok := _c.mutation.Size(); ok {
+		_spec.SetField(image.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := _c.mutation.Checksum(); ok {
+		_spec.SetField(image.FieldChecksum, field.TypeString, value)
+		_node.Checksum = value
+	}
+	if value, ok := _c.mutation.URL(); ok {
+		_spec.SetField(image.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := _c.mutation.Width(); ok {
+		_spec.SetField(image.FieldWidth, field.TypeInt, value)
+		_node.Width = value
+	}
+	if value, ok := _c.mutation.Height(); ok {
+		_spec.SetField(image.FieldHeight, field.TypeInt, value)
+		_node.Height = value
+	}
+	if value, ok := _c.mutation.BlurHash(); ok {
+		_spec.SetField(image.FieldBlurHash, field.TypeString, value)
+		_node.BlurHash = value
+	}
+	if value, ok := _c.mutation.DominantColor(); ok {
+		_spec.SetField(image.FieldDominantColor, field.TypeString, value)
+		_node.DominantColor = value
+	}
+	if value, ok := _c.mutation.Alt(); ok {
+		_spec.SetField(image.FieldAlt, field.TypeJSON, value)
+		_node.Alt = value
+	}
+	if value, ok := _c.mutation.Caption(); ok {
+		_spec.SetField(image.FieldCaption, field.TypeString, value)
+		_node.Caption = value
+	}
+	if value, ok := _c.mutation.Credit(); ok {
+		_spec.SetField(image.FieldCredit, field.TypeString, value)
+		_node.Credit = value
+	}
+	if value, ok := _c.mutation.License(); ok {
+		_spec.SetField(image.FieldLicense, field.TypeString, value)
+		_node.License = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(image.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   image.TagsTable,
+			Columns: image.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ImageCreateBulk is the builder for creating many Image entities in bulk.
+type ImageCreateBulk struct {
+	config
+	err      error
+	builders []*ImageCreate
+}
+
+// Save creates the Image entities in the database.
+func (_c *ImageCreateBulk) Save(ctx context.Context) ([]*Image, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Image, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ImageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ImageCreateBulk) SaveX(ctx context.Context) []*Image {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1052 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/image"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/predicate"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/tag"
+)
+
+// ImageUpdate is the builder for updating Image entities.
+type ImageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ImageMutation
+}
+
+// Where appends a list predicates to the ImageUpdate builder.
+func (_u *ImageUpdate) Where(ps ...predicate.Image) *ImageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *ImageUpdate) SetOwnerID(v uuid.UUID) *ImageUpdate {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *ImageUpdate) SetNillableOwnerID(v *uuid.UUID) *ImageUpdate {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *ImageUpdate) ClearOwnerID() *ImageUpdate {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetVisibility sets the "visibility" field.
+func (_u *ImageUpdate) SetVisibility(v string) *ImageUpdate {
+	_u.mutation.SetVisibility(v)
+	return _u
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *ImageUpdate) SetNillableVisibility(v *string) *ImageUpdate {
+	if v != nil {
+		_u.SetVisibility(*v)
+	}
+	return _u
+}
+
+// SetOriginalName sets the "original_name" field.
+func (_u *ImageUpdate) SetOriginalName(v string) *ImageUpdate {
+	_u.mutation.SetOriginalName(v)
+	return _u
+}
+
+// SetNillableOriginalName sets the "original_name" field if the given value is not nil.
+func (_u *ImageUpdate) SetNillableOriginalName(v *string) *ImageUpdate {
+	if v != nil {
+		_u.SetOriginalName(*v)
+	}
+	return _u
+}
+
+// SetStoredName sets the "stored_name" field.
+func (_u *ImageUpdate) SetStoredName(v string) *ImageUpdate {
+	_u.mutation.SetStoredName(v)
+	return _u
+}
+
+// SetNillableStoredName sets the "stored_name" field if the given value is not nil.
+func (_u *ImageUpdate) SetNillableStoredName(v *string) *ImageUpdate {
+	if v != nil {
+		_u.SetStoredName(*v)
+	}
+	return _u
+}
+
+// SetContentType sets the "content_type" field.
+func (_u *ImageUpdate) SetContentType(v string) *ImageUpdate {
+	_u.mutation.SetContentType(v)
+	return _u
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (_u *ImageUpdate) SetNillableContentType(v *string) *ImageUpdate {
+	if v != nil {
+		_u.SetContentType(*v)
+	}
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *ImageUpdate) SetSize(v int64) *ImageUpdate {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *ImageUpdate) SetNillableSize(v *int64) *ImageUpdate {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *ImageUpdate) AddSize(v int64) *ImageUpdate {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetChecksum sets the "checksum" field.
+func (_u *ImageUpdate) SetChecksum(v string) *ImageUpdate {
+	_u.mutation.SetChecksum(v)
+	return _u
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (_u *ImageUpdate) SetNillableChecksum(v *string) *ImageUpdate {
+	if v != nil {
+		_u.SetChecksum(*v)
+	}
+	return _u
+}
+
+// ClearChecksum clears the value of the "checksum" field.
+func (_u *ImageUpdate) ClearChecksum() *ImageUpdate {
+	_u.mutation.ClearChecksum()
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *ImageUpdate) SetURL(v string) *ImageUpdate {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *ImageUpdate) SetNillableURL(v *string) *ImageUpdate {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetWidth sets the "width" field.
+func (_u *ImageUpdate) SetWidth(v int) *ImageUpdate {
+	_u.mutation.ResetWidth()
+	_u.mutation.SetWidth(v)
+	return _u
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (_u *ImageUpdate) SetNillableWidth(v *int) *ImageUpdate {
+	if v != nil {
+		_u.SetWidth(*v)
+	}
+	return _u
+}
+
+// AddWidth adds value to the "width" field.
+func (_u *ImageUpdate) AddWidth(v int) *ImageUpdate {
+	_u.mutation.AddWidth(v)
+	return _u
+}
+
+// SetHeight sets the "height" field.
+func (_u *ImageUpdate) SetHeight(v int) *ImageUpdate {
+	_u.mutation.ResetHeight()
+	_u.mutation.SetHeight(v)
+	return _u
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (_u *ImageUpdate) SetNillableHeight(v *int) *ImageUpdate {
+	if v != nil {
+		_u.SetHeight(*v)
+	}
+	return _u
+}
+
+// AddHeight adds value to the "height" field.
+func (_u *ImageUpdate) AddHeight(v int) *ImageUpdate {
+	_u.mutation.AddHeight(v)
+	return _u
+}
+
+// SetBlurHash sets the "blur_hash" field.
+func (_u *ImageUpdate) SetBlurHash(v string) *ImageUpdate {
+	_u.mutation.SetBlurHash(v)
+	return _u
+}
+
+// SetNillableBlurHash sets the "blur_hash" field if the given value is not nil.
+func (_u *ImageUpdate) SetNillableBlurHash(v *string) *ImageUpdate {
+	if v != nil {
+		_u.SetBlurHash(*v)
+	}
+	return _u
+}
+
+// SetDominantColor sets the "dominant_color" field.
+func (_u *ImageUpdate) SetDominantColor(v string) *ImageUpdate {
+	_u.mutation.SetDominantColor(v)
+	return _u
+}
+
+// SetNillableDominantColor sets the "dominant_color" field if the given value is not nil.
+func (_u *ImageUpdate) SetNillableDominantColor(v *string) *ImageUpdate {
+	if v != nil {
+		_u.SetDominantColor(*v)
+	}
+	return _u
+}
+
+// SetAlt sets the "alt" field.
+func (_u *ImageUpdate) SetAlt(v map[string]string) *ImageUpdate {
+	_u.mutation.SetAlt(v)
+	return _u
+}
+
+// ClearAlt clears the value of the "alt" field.
+func (_u *ImageUpdate) ClearAlt() *ImageUpdate {
+	_u.mutation.ClearAlt()
+	return _u
+}
+
+// SetCaption sets the "caption" field.
+func (_u *ImageUpdate) SetCaption(v string) *ImageUpdate {
+	_u.mutation.SetCaption(v)
+	return _u
+}
+
+// SetNillableCaption sets the "caption" field if the given value is not nil.
+func (_u *ImageUpdate) SetNillableCaption(v *string) *ImageUpdate {
+	if v != nil {
+		_u.SetCaption(*v)
+	}
+	return _u
+}
+
+// SetCredit sets the "credit" field.
+func (_u *ImageUpdate) SetCredit(v string) *ImageUpdate {
+	_u.mutation.SetCredit(v)
+	return _u
+}
+
+// SetNillableCredit sets the "credit" field if the given value is not nil.
+func (_u *ImageUpdate) SetNillableCredit(v *string) *ImageUpdate {
+	if v != nil {
+		_u.SetCredit(*v)
+	}
+	return _u
+}
+
+// SetLicense sets the "license" field.
+func (_u *ImageUpdate) SetLicense(v string) *ImageUpdate {
+	_u.mutation.SetLicense(v)
+	return _u
+}
+
+// SetNillableLicense sets the "license" field if the given value is not nil.
+func (_u *ImageUpdate) SetNillableLicense(v *string) *ImageUpdate {
+	if v != nil {
+		_u.SetLicense(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ImageUpdate) SetCreatedAt(v time.Time) *ImageUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ImageUpdate) SetNillableCreatedAt(v *time.Time) *ImageUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_u *ImageUpdate) AddTagIDs(ids ...uuid.UUID) *ImageUpdate {
+	_u.mutation.AddTagIDs(ids...)
+	return _u
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_u *ImageUpdate) AddTags(v ...*Tag) *ImageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagIDs(ids...)
+}
+
+// Mutation returns the ImageMutation object of the builder.
+func (_u *ImageUpdate) Mutation() *ImageMutation {
+	return _u.mutation
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (_u *ImageUpdate) ClearTags() *ImageUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (_u *ImageUpdate) RemoveTagIDs(ids ...uuid.UUID) *ImageUpdate {
+	_u.mutation.RemoveTagIDs(ids...)
+	return _u
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (_u *ImageUpdate) RemoveTags(v ...*Tag) *ImageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTagIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ImageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ImageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImageUpdate) check() error {
+	if v, ok := _u.mutation.StoredName(); ok {
+		if err := image.StoredNameValidator(v); err != nil {
+			return &ValidationError{Name: "stored_name", err: fmt.Errorf(`ent: validator failed for field "Image.stored_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ContentType(); ok {
+		if err := image.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`ent: validator failed for field "Image.content_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ImageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(image.Table, image.Columns, sqlgraph.NewFieldSpec(image.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(image.FieldOwnerID, field.TypeUUID, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(image.FieldOwnerID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(image.FieldVisibility, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OriginalName(); ok {
+		_spec.SetField(image.FieldOriginalName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StoredName(); ok {
+		_spec.SetField(image.FieldStoredName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ContentType(); ok {
+		_spec.SetField(image.FieldContentType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(image.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(image.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Checksum(); ok {
+		_spec.SetField(image.FieldChecksum, field.TypeString, value)
+	}
+	if _u.mutation.ChecksumCleared() {
+		_spec.ClearField(image.FieldChecksum, field.TypeString)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(image.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Width(); ok {
+		_spec.SetField(image.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWidth(); ok {
+		_spec.AddField(image.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Height(); ok {
+		_spec.SetField(image.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHeight(); ok {
+		_spec.AddField(image.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BlurHash(); ok {
+		_spec.SetField(image.FieldBlurHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DominantColor(); ok {
+		_spec.SetField(image.FieldDominantColor, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Alt(); ok {
+		_spec.SetField(image.FieldAlt, field.TypeJSON, value)
+	}
+	if _u.mutation.AltCleared() {
+		_spec.ClearField(image.FieldAlt, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Caption(); ok {
+		_spec.SetField(image.FieldCaption, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Credit(); ok {
+		_spec.SetField(image.FieldCredit, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.License(); ok {
+		_spec.SetField(image.FieldLicense, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(image.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   image.TagsTable,
+			Columns: image.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   image.TagsTable,
+			Columns: image.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   image.TagsTable,
+			Columns: image.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{image.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ImageUpdateOne is the builder for updating a single Image entity.
+type ImageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ImageMutation
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *ImageUpdateOne) SetOwnerID(v uuid.UUID) *ImageUpdateOne {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *ImageUpdateOne) SetNillableOwnerID(v *uuid.UUID) *ImageUpdateOne {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *ImageUpdateOne) ClearOwnerID() *ImageUpdateOne {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetVisibility sets the "visibility" field.
+func (_u *ImageUpdateOne) SetVisibility(v string) *ImageUpdateOne {
+	_u.mutation.SetVisibility(v)
+	return _u
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *ImageUpdateOne) SetNillableVisibility(v *string) *ImageUpdateOne {
+	if v != nil {
+		_u.SetVisibility(*v)
+	}
+	return _u
+}
+
+// SetOriginalName sets the "original_name" field.
+func (_u *ImageUpdateOne) SetOriginalName(v string) *ImageUpdateOne {
+	_u.mutation.SetOriginalName(v)
+	return _u
+}
+
+// SetNillableOriginalName sets the "original_name" field if the given value is not nil.
+func (_u *ImageUpdateOne) SetNillableOriginalName(v *string) *ImageUpdateOne {
+	if v != nil {
+		_u.SetOriginalName(*v)
+	}
+	return _u
+}
+
+// SetStoredName sets the "stored_name" field.
+func (_u *ImageUpdateOne) SetStoredName(v string) *ImageUpdateOne {
+	_u.mutation.SetStoredName(v)
+	return _u
+}
+
+// SetNillableStoredName sets the "stored_name" field if the given value is not nil.
+func (_u *ImageUpdateOne) SetNillableStoredName(v *string) *ImageUpdateOne {
+	if v != nil {
+		_u.SetStoredName(*v)
+	}
+	return _u
+}
+
+// SetContentType sets the "content_type" field.
+func (_u *ImageUpdateOne) SetContentType(v string) *ImageUpdateOne {
+	_u.mutation.SetContentType(v)
+	return _u
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (_u *ImageUpdateOne) SetNillableContentType(v *string) *ImageUpdateOne {
+	if v != nil {
+		_u.SetContentType(*v)
+	}
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *ImageUpdateOne) SetSize(v int64) *ImageUpdateOne {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *ImageUpdateOne) SetNillableSize(v *int64) *ImageUpdateOne {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *ImageUpdateOne) AddSize(v int64) *ImageUpdateOne {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetChecksum sets the "checksum" field.
+func (_u *ImageUpdateOne) SetChecksum(v string) *ImageUpdateOne {
+	_u.mutation.SetChecksum(v)
+	return _u
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (_u *ImageUpdateOne) SetNillableChecksum(v *string) *ImageUpdateOne {
+	if v != nil {
+		_u.SetChecksum(*v)
+	}
+	return _u
+}
+
+// ClearChecksum clears the value of the "checksum" field.
+func (_u *ImageUpdateOne) ClearChecksum() *ImageUpdateOne {
+	_u.mutation.ClearChecksum()
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *ImageUpdateOne) SetURL(v string) *ImageUpdateOne {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *ImageUpdateOne) SetNillableURL(v *string) *ImageUpdateOne {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetWidth sets the "width" field.
+func (_u *ImageUpdateOne) SetWidth(v int) *ImageUpdateOne {
+	_u.mutation.ResetWidth()
+	_u.mutation.SetWidth(v)
+	return _u
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (_u *ImageUpdateOne) SetNillableWidth(v *int) *ImageUpdateOne {
+	if v != nil {
+		_u.SetWidth(*v)
+	}
+	return _u
+}
+
+// AddWidth adds value to the "width" field.
+func (_u *ImageUpdateOne) AddWidth(v int) *ImageUpdateOne {
+	_u.mutation.AddWidth(v)
+	return _u
+}
+
+// SetHeight sets the "height" field.
+func (_u *ImageUpdateOne) SetHeight(v int) *ImageUpdateOne {
+	_u.mutation.ResetHeight()
+	_u.mutation.SetHeight(v)
+	return _u
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (_u *ImageUpdateOne) SetNillableHeight(v *int) *ImageUpdateOne {
+	if v != nil {
+		_u.SetHeight(*v)
+	}
+	return _u
+}
+
+// AddHeight adds value to the "height" field.
+func (_u *ImageUpdateOne) AddHeight(v int) *ImageUpdateOne {
+	_u.mutation.AddHeight(v)
+	return _u
+}
+
+// SetBlurHash sets the "blur_hash" field.
+func (_u *ImageUpdateOne) SetBlurHash(v string) *ImageUpdateOne {
+	_u.mutation.SetBlurHash(v)
+	return _u
+}
+
+// SetNillableBlurHash sets the "blur_hash" field if the given value is not nil.
+func (_u *ImageUpdateOne) SetNillableBlurHash(v *string) *ImageUpdateOne {
+	if v != nil {
+		_u.SetBlurHash(*v)
+	}
+	return _u
+}
+
+// SetDominantColor sets the "dominant_color" field.
+func (_u *ImageUpdateOne) SetDominantColor(v string) *ImageUpdateOne {
+	_u.mutation.SetDominantColor(v)
+	return _u
+}
+
+// SetNillableDominantColor sets the "dominant_color" field if the given value is not nil.
+func (_u *ImageUpdateOne) SetNillableDominantColor(v *string) *ImageUpdateOne {
+	if v != nil {
+		_u.SetDominantColor(*v)
+	}
+	return _u
+}
+
+// SetAlt sets the "alt" field.
+func (_u *ImageUpdateOne) SetAlt(v map[string]string) *ImageUpdateOne {
+	_u.mutation.SetAlt(v)
+	return _u
+}
+
+// ClearAlt clears the value of the "alt" field.
+func (_u *ImageUpdateOne) ClearAlt() *ImageUpdateOne {
+	_u.mutation.ClearAlt()
+	return _u
+}
+
+// SetCaption sets the "caption" field.
+func (_u *ImageUpdateOne) SetCaption(v string) *ImageUpdateOne {
+	_u.mutation.SetCaption(v)
+	return _u
+}
+
+// SetNillableCaption sets the "caption" field if the given value is not nil.
+func (_u *ImageUpdateOne) SetNillableCaption(v *string) *ImageUpdateOne {
+	if v != nil {
+		_u.SetCaption(*v)
+	}
+	return _u
+}
+
+// SetCredit sets the "credit" field.
+func (_u *ImageUpdateOne) SetCredit(v string) *ImageUpdateOne {
+	_u.mutation.SetCredit(v)
+	return _u
+}
+
+// SetNillableCredit sets the "credit" field if the given value is not nil.
+func (_u *ImageUpdateOne) SetNillableCredit(v *string) *ImageUpdateOne {
+	if v != nil {
+		_u.SetCredit(*v)
+	}
+	return _u
+}
+
+// SetLicense sets the "license" field.
+func (_u *ImageUpdateOne) SetLicense(v string) *ImageUpdateOne {
+	_u.mutation.SetLicense(v)
+	return _u
+}
+
+// SetNillableLicense sets the "license" field if the given value is not nil.
+func (_u *ImageUpdateOne) SetNillableLicense(v *string) *ImageUpdateOne {
+	if v != nil {
+		_u.SetLicense(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ImageUpdateOne) SetCreatedAt(v time.Time) *ImageUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ImageUpdateOne) SetNillableCreatedAt(v *time.Time) *ImageUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_u *ImageUpdateOne) AddTagIDs(ids ...uuid.UUID) *ImageUpdateOne {
+	_u.mutation.AddTagIDs(ids...)
+	return _u
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_u *ImageUpdateOne) AddTags(v ...*Tag) *ImageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagIDs(ids...)
+}
+
+// Mutation returns the ImageMutation object of the builder.
+func (_u *ImageUpdateOne) Mutation() *ImageMutation {
+	return _u.mutation
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (_u *ImageUpdateOne) ClearTags() *ImageUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (_u *ImageUpdateOne) RemoveTagIDs(ids ...uuid.UUID) *ImageUpdateOne {
+	_u.mutation.RemoveTagIDs(ids...)
+	return _u
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (_u *ImageUpdateOne) RemoveTags(v ...*Tag) *ImageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTagIDs(ids...)
+}
+
+// Where appends a list predicates to the ImageUpdate builder.
+func (_u *ImageUpdateOne) Where(ps ...predicate.Image) *ImageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ImageUpdateOne) Select(field string, fields ...string) *ImageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Image entity.
+func (_u *ImageUpdateOne) Save(ctx context.Context) (*Image, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImageUpdateOne) SaveX(ctx context.Context) *Image {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ImageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImageUpdateOne) check() error {
+	if v, ok := _u.mutation.StoredName(); ok {
+		if err := image.StoredNameValidator(v); err != nil {
+			return &ValidationError{Name: "stored_name", err: fmt.Errorf(`ent: validator failed for field "Image.stored_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ContentType(); ok {
+		if err := image.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`ent: validator failed for field "Image.content_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ImageUpdateOne) sqlSave(ctx context.Context) (_node *Image, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(image.Table, image.Columns, sqlgraph.NewFieldSpec(image.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Image.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, image.FieldID)
+		for _, f := range fields {
+			if !image.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != image.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(image.FieldOwnerID, field.TypeUUID, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(image.FieldOwnerID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(image.FieldVisibility, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OriginalName(); ok {
+		_spec.SetField(image.FieldOriginalName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StoredName(); ok {
+		_spec.SetField(image.FieldStoredName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ContentType(); ok {
+		_spec.SetField(image.FieldContentType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(image.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(image.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Checksum(); ok {
+		_spec.SetField(image.FieldChecksum, field.TypeString, value)
+	}
+	if _u.mutation.ChecksumCleared() {
+		_spec.ClearField(image.FieldChecksum, field.TypeString)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(image.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Width(); ok {
+		_spec.SetField(image.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWidth(); ok {
+		_spec.AddField(image.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Height(); ok {
+		_spec.SetField(image.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHeight(); ok {
+		_spec.AddField(image.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BlurHash(); ok {
+		_spec.SetField(image.FieldBlurHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DominantColor(); ok {
+		_spec.SetField(image.FieldDominantColor, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Alt(); ok {
+		_spec.SetField(image.FieldAlt, field.TypeJSON, value)
+	}
+	if _u.mutation.AltCleared() {
+		_spec.ClearField(image.FieldAlt, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Caption(); ok {
+		_spec.SetField(image.FieldCaption, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Credit(); ok {
+		_spec.SetField(image.FieldCredit, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.License(); ok {
+		_spec.SetField(image.FieldLicense, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(image.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   image.TagsTable,
+			Columns: image.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   image.TagsTable,
+			Columns: image.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   image.TagsTable,
+			Columns: image.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Image{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{image.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -8,12 +8,323 @@ import (
 )
 
 var (
+	// AlbumsColumns holds the columns for the "albums" table.
+	AlbumsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "owner_id", Type: field.TypeUUID},
+		{Name: "slug", Type: field.TypeString, Unique: true},
+		{Name: "title", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "visibility", Type: field.TypeString, Default: "public"},
+		{Name: "cover_image_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "image_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// AlbumsTable holds the schema information for the "albums" table.
+	AlbumsTable = &schema.Table{
+		Name:       "albums",
+		Columns:    AlbumsColumns,
+		PrimaryKey: []*schema.Column{AlbumsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "album_owner_id",
+				Unique:  false,
+				Columns: []*schema.Column{AlbumsColumns[1]},
+			},
+		},
+	}
+	// BlobsColumns holds the columns for the "blobs" table.
+	BlobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "checksum", Type: field.TypeString},
+		{Name: "content_type", Type: field.TypeString},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "url", Type: field.TypeString},
+		{Name: "ref_count", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// BlobsTable holds the schema information for the "blobs" table.
+	BlobsTable = &schema.Table{
+		Name:       "blobs",
+		Columns:    BlobsColumns,
+		PrimaryKey: []*schema.Column{BlobsColumns[0]},
+	}
+	// CommentsColumns holds the columns for the "comments" table.
+	CommentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "parent_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "author_name", Type: field.TypeString},
+		{Name: "author_email", Type: field.TypeString, Default: ""},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "post_id", Type: field.TypeUUID},
+		{Name: "author_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// CommentsTable holds the schema information for the "comments" table.
+	CommentsTable = &schema.Table{
+		Name:       "comments",
+		Columns:    CommentsColumns,
+		PrimaryKey: []*schema.Column{CommentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "comments_posts_comments",
+				Columns:    []*schema.Column{CommentsColumns[8]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "comments_users_comments",
+				Columns:    []*schema.Column{CommentsColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "comment_post_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{CommentsColumns[8], CommentsColumns[6]},
+			},
+			{
+				Name:    "comment_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{CommentsColumns[5], CommentsColumns[6]},
+			},
+		},
+	}
+	// ContactMessagesColumns holds the columns for the "contact_messages" table.
+	ContactMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "email", Type: field.TypeString},
+		{Name: "subject", Type: field.TypeString, Default: ""},
+		{Name: "message", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ContactMessagesTable holds the schema information for the "contact_messages" table.
+	ContactMessagesTable = &schema.Table{
+		Name:       "contact_messages",
+		Columns:    ContactMessagesColumns,
+		PrimaryKey: []*schema.Column{ContactMessagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "contactmessage_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ContactMessagesColumns[5]},
+			},
+		},
+	}
+	// ImagesColumns holds the columns for the "images" table.
+	ImagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "owner_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "visibility", Type: field.TypeString, Default: "public"},
+		{Name: "original_name", Type: field.TypeString},
+		{Name: "stored_name", Type: field.TypeString},
+		{Name: "content_type", Type: field.TypeString},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "checksum", Type: field.TypeString, Nullable: true},
+		{Name: "url", Type: field.TypeString},
+		{Name: "width", Type: field.TypeInt, Default: 0},
+		{Name: "height", Type: field.TypeInt, Default: 0},
+		{Name: "blur_hash", Type: field.TypeString, Default: ""},
+		{Name: "dominant_color", Type: field.TypeString, Default: ""},
+		{Name: "alt", Type: field.TypeJSON, Nullable: true},
+		{Name: "caption", Type: field.TypeString, Default: ""},
+		{Name: "credit", Type: field.TypeString, Default: ""},
+		{Name: "license", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ImagesTable holds the schema information for the "images" table.
+	ImagesTable = &schema.Table{
+		Name:       "images",
+		Columns:    ImagesColumns,
+		PrimaryKey: []*schema.Column{ImagesColumns[0]},
+	}
+	// PostsColumns holds the columns for the "posts" table.
+	PostsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "slug", Type: field.TypeString, Unique: true},
+		{Name: "title", Type: field.TypeString},
+		{Name: "body", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "html", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "text", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "excerpt", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "summary", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "status", Type: field.TypeString, Default: "draft"},
+		{Name: "publish_at", Type: field.TypeTime, Nullable: true},
+		{Name: "published_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "author_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// PostsTable holds the schema information for the "posts" table.
+	PostsTable = &schema.Table{
+		Name:       "posts",
+		Columns:    PostsColumns,
+		PrimaryKey: []*schema.Column{PostsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "posts_users_posts",
+				Columns:    []*schema.Column{PostsColumns[13]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "post_status_published_at",
+				Unique:  false,
+				Columns: []*schema.Column{PostsColumns[8], PostsColumns[10]},
+			},
+			{
+				Name:    "post_status_publish_at",
+				Unique:  false,
+				Columns: []*schema.Column{PostsColumns[8], PostsColumns[9]},
+			},
+		},
+	}
+	// PostRevisionsColumns holds the columns for the "post_revisions" table.
+	PostRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "number", Type: field.TypeInt},
+		{Name: "title", Type: field.TypeString},
+		{Name: "slug", Type: field.TypeString},
+		{Name: "body", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "excerpt", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "status", Type: field.TypeString},
+		{Name: "restored_from", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "post_id", Type: field.TypeUUID},
+		{Name: "editor_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// PostRevisionsTable holds the schema information for the "post_revisions" table.
+	PostRevisionsTable = &schema.Table{
+		Name:       "post_revisions",
+		Columns:    PostRevisionsColumns,
+		PrimaryKey: []*schema.Column{PostRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "post_revisions_posts_revisions",
+				Columns:    []*schema.Column{PostRevisionsColumns[10]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "post_revisions_users_revisions",
+				Columns:    []*schema.Column{PostRevisionsColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "postrevision_post_id_number",
+				Unique:  true,
+				Columns: []*schema.Column{PostRevisionsColumns[10], PostRevisionsColumns[1]},
+			},
+		},
+	}
+	// PostSlugsColumns holds the columns for the "post_slugs" table.
+	PostSlugsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "slug", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "post_id", Type: field.TypeUUID},
+	}
+	// PostSlugsTable holds the schema information for the "post_slugs" table.
+	PostSlugsTable = &schema.Table{
+		Name:       "post_slugs",
+		Columns:    PostSlugsColumns,
+		PrimaryKey: []*schema.Column{PostSlugsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "post_slugs_posts_old_slugs",
+				Columns:    []*schema.Column{PostSlugsColumns[3]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// RedirectsColumns holds the columns for the "redirects" table.
+	RedirectsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "path", Type: field.TypeString, Unique: true},
+		{Name: "target", Type: field.TypeString},
+		{Name: "code", Type: field.TypeInt, Default: 301},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// RedirectsTable holds the schema information for the "redirects" table.
+	RedirectsTable = &schema.Table{
+		Name:       "redirects",
+		Columns:    RedirectsColumns,
+		PrimaryKey: []*schema.Column{RedirectsColumns[0]},
+	}
+	// ResumableUploadsColumns holds the columns for the "resumable_uploads" table.
+	ResumableUploadsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "owner_id", Type: field.TypeUUID},
+		{Name: "length", Type: field.TypeInt64},
+		{Name: "offset", Type: field.TypeInt64, Default: 0},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "object_name", Type: field.TypeString, Unique: true},
+		{Name: "multipart_id", Type: field.TypeString, Nullable: true},
+		{Name: "parts", Type: field.TypeJSON, Nullable: true},
+		{Name: "image_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ResumableUploadsTable holds the schema information for the "resumable_uploads" table.
+	ResumableUploadsTable = &schema.Table{
+		Name:       "resumable_uploads",
+		Columns:    ResumableUploadsColumns,
+		PrimaryKey: []*schema.Column{ResumableUploadsColumns[0]},
+	}
+	// TagsColumns holds the columns for the "tags" table.
+	TagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "slug", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// TagsTable holds the schema information for the "tags" table.
+	TagsTable = &schema.Table{
+		Name:       "tags",
+		Columns:    TagsColumns,
+		PrimaryKey: []*schema.Column{TagsColumns[0]},
+	}
+	// UploadsColumns holds the columns for the "uploads" table.
+	UploadsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "owner_id", Type: field.TypeUUID},
+		{Name: "original_name", Type: field.TypeString},
+		{Name: "object_name", Type: field.TypeString, Unique: true},
+		{Name: "content_type", Type: field.TypeString},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "checksum", Type: field.TypeString},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// UploadsTable holds the schema information for the "uploads" table.
+	UploadsTable = &schema.Table{
+		Name:       "uploads",
+		Columns:    UploadsColumns,
+		PrimaryKey: []*schema.Column{UploadsColumns[0]},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "email", Type: field.TypeString, Unique: true},
 		{Name: "password_hash", Type: field.TypeString},
 		{Name: "role", Type: field.TypeString},
+		{Name: "max_file_size", Type: field.TypeInt64, Default: 0},
+		{Name: "max_total_bytes", Type: field.TypeInt64, Default: 0},
+		{Name: "max_files", Type: field.TypeInt, Default: 0},
+		{Name: "allowed_types", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 	}
 	// UsersTable holds the schema information for the "users" table.
@@ -22,11 +333,85 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// TagPostsColumns holds the columns for the "tag_posts" table.
+	TagPostsColumns = []*schema.Column{
+		{Name: "tag_id", Type: field.TypeUUID},
+		{Name: "post_id", Type: field.TypeUUID},
+	}
+	// TagPostsTable holds the schema information for the "tag_posts" table.
+	TagPostsTable = &schema.Table{
+		Name:       "tag_posts",
+		Columns:    TagPostsColumns,
+		PrimaryKey: []*schema.Column{TagPostsColumns[0], TagPostsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tag_posts_tag_id",
+				Columns:    []*schema.Column{TagPostsColumns[0]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "tag_posts_post_id",
+				Columns:    []*schema.Column{TagPostsColumns[1]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// TagImagesColumns holds the columns for the "tag_images" table.
+	TagImagesColumns = []*schema.Column{
+		{Name: "tag_id", Type: field.TypeUUID},
+		{Name: "image_id", Type: field.TypeUUID},
+	}
+	// TagImagesTable holds the schema information for the "tag_images" table.
+	TagImagesTable = &schema.Table{
+		Name:       "tag_images",
+		Columns:    TagImagesColumns,
+		PrimaryKey: []*schema.Column{TagImagesColumns[0], TagImagesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tag_images_tag_id",
+				Columns:    []*schema.Column{TagImagesColumns[0]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "tag_images_image_id",
+				Columns:    []*schema.Column{TagImagesColumns[1]},
+				RefColumns: []*schema.Column{ImagesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AlbumsTable,
+		BlobsTable,
+		CommentsTable,
+		ContactMessagesTable,
+		ImagesTable,
+		PostsTable,
+		PostRevisionsTable,
+		PostSlugsTable,
+		RedirectsTable,
+		ResumableUploadsTable,
+		TagsTable,
+		UploadsTable,
 		UsersTable,
+		TagPostsTable,
+		TagImagesTable,
 	}
 )
 
 func init() {
+	CommentsTable.ForeignKeys[0].RefTable = PostsTable
+	CommentsTable.ForeignKeys[1].RefTable = UsersTable
+	PostsTable.ForeignKeys[0].RefTable = UsersTable
+	PostRevisionsTable.ForeignKeys[0].RefTable = PostsTable
+	PostRevisionsTable.ForeignKeys[1].RefTable = UsersTable
+	PostSlugsTable.ForeignKeys[0].RefTable = PostsTable
+	TagPostsTable.ForeignKeys[0].RefTable = TagsTable
+	TagPostsTable.ForeignKeys[1].RefTable = PostsTable
+	TagImagesTable.ForeignKeys[0].RefTable = TagsTable
+	TagImagesTable.ForeignKeys[1].RefTable = ImagesTable
 }
@@ -0,0 +1,673 @@
+// Code generated by ent, DO NOT EDIT.
+
+package postrevision
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLTE(FieldID, id))
+}
+
+// PostID applies equality check predicate on the "post_id" field. It's identical to PostIDEQ.
+func PostID(v uuid.UUID) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldPostID, v))
+}
+
+// Number applies equality check predicate on the "number" field. It's identical to NumberEQ.
+func Number(v int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldNumber, v))
+}
+
+// EditorID applies equality check predicate on the "editor_id" field. It's identical to EditorIDEQ.
+func EditorID(v uuid.UUID) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldEditorID, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldTitle, v))
+}
+
+// Slug applies equality check predicate on the "slug" field. It's identical to SlugEQ.
+func Slug(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldSlug, v))
+}
+
+// Body applies equality check predicate on the "body" field. It's identical to BodyEQ.
+func Body(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldBody, v))
+}
+
+// Excerpt applies equality check predicate on the "excerpt" field. It's identical to ExcerptEQ.
+func Excerpt(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldExcerpt, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldStatus, v))
+}
+
+// RestoredFrom applies equality check predicate on the "restored_from" field. It's identical to RestoredFromEQ.
+func RestoredFrom(v int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldRestoredFrom, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// PostIDEQ applies the EQ predicate on the "post_id" field.
+func PostIDEQ(v uuid.UUID) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldPostID, v))
+}
+
+// PostIDNEQ applies the NEQ predicate on the "post_id" field.
+func PostIDNEQ(v uuid.UUID) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNEQ(FieldPostID, v))
+}
+
+// PostIDIn applies the In predicate on the "post_id" field.
+func PostIDIn(vs ...uuid.UUID) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIn(FieldPostID, vs...))
+}
+
+// PostIDNotIn applies the NotIn predicate on the "post_id" field.
+func PostIDNotIn(vs ...uuid.UUID) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotIn(FieldPostID, vs...))
+}
+
+// NumberEQ applies the EQ predicate on the "number" field.
+func NumberEQ(v int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldNumber, v))
+}
+
+// NumberNEQ applies the NEQ predicate on the "number" field.
+func NumberNEQ(v int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNEQ(FieldNumber, v))
+}
+
+// NumberIn applies the In predicate on the "number" field.
+func NumberIn(vs ...int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIn(FieldNumber, vs...))
+}
+
+// NumberNotIn applies the NotIn predicate on the "number" field.
+func NumberNotIn(vs ...int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotIn(FieldNumber, vs...))
+}
+
+// NumberGT applies the GT predicate on the "number" field.
+func NumberGT(v int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGT(FieldNumber, v))
+}
+
+// NumberGTE applies the GTE predicate on the "number" field.
+func NumberGTE(v int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGTE(FieldNumber, v))
+}
+
+// NumberLT applies the LT predicate on the "number" field.
+func NumberLT(v int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLT(FieldNumber, v))
+}
+
+// NumberLTE applies the LTE predicate on the "number" field.
+func NumberLTE(v int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLTE(FieldNumber, v))
+}
+
+// EditorIDEQ applies the EQ predicate on the "editor_id" field.
+func EditorIDEQ(v uuid.UUID) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldEditorID, v))
+}
+
+// EditorIDNEQ applies the NEQ predicate on the "editor_id" field.
+func EditorIDNEQ(v uuid.UUID) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNEQ(FieldEditorID, v))
+}
+
+// EditorIDIn applies the In predicate on the "editor_id" field.
+func EditorIDIn(vs ...uuid.UUID) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIn(FieldEditorID, vs...))
+}
+
+// EditorIDNotIn applies the NotIn predicate on the "editor_id" field.
+func EditorIDNotIn(vs ...uuid.UUID) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotIn(FieldEditorID, vs...))
+}
+
+// EditorIDIsNil applies the IsNil predicate on the "editor_id" field.
+func EditorIDIsNil() predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIsNull(FieldEditorID))
+}
+
+// EditorIDNotNil applies the NotNil predicate on the "editor_id" field.
+func EditorIDNotNil() predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotNull(FieldEditorID))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// SlugEQ applies the EQ predicate on the "slug" field.
+func SlugEQ(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldSlug, v))
+}
+
+// SlugNEQ applies the NEQ predicate on the "slug" field.
+func SlugNEQ(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNEQ(FieldSlug, v))
+}
+
+// SlugIn applies the In predicate on the "slug" field.
+func SlugIn(vs ...string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIn(FieldSlug, vs...))
+}
+
+// SlugNotIn applies the NotIn predicate on the "slug" field.
+func SlugNotIn(vs ...string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotIn(FieldSlug, vs...))
+}
+
+// SlugGT applies the GT predicate on the "slug" field.
+func SlugGT(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGT(FieldSlug, v))
+}
+
+// SlugGTE applies the GTE predicate on the "slug" field.
+func SlugGTE(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGTE(FieldSlug, v))
+}
+
+// SlugLT applies the LT predicate on the "slug" field.
+func SlugLT(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLT(FieldSlug, v))
+}
+
+// SlugLTE applies the LTE predicate on the "slug" field.
+func SlugLTE(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLTE(FieldSlug, v))
+}
+
+// SlugContains applies the Contains predicate on the "slug" field.
+func SlugContains(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldContains(FieldSlug, v))
+}
+
+// SlugHasPrefix applies the HasPrefix predicate on the "slug" field.
+func SlugHasPrefix(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldHasPrefix(FieldSlug, v))
+}
+
+// SlugHasSuffix applies the HasSuffix predicate on the "slug" field.
+func SlugHasSuffix(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldHasSuffix(FieldSlug, v))
+}
+
+// SlugEqualFold applies the EqualFold predicate on the "slug" field.
+func SlugEqualFold(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEqualFold(FieldSlug, v))
+}
+
+// SlugContainsFold applies the ContainsFold predicate on the "slug" field.
+func SlugContainsFold(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldContainsFold(FieldSlug, v))
+}
+
+// BodyEQ applies the EQ predicate on the "body" field.
+func BodyEQ(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldBody, v))
+}
+
+// BodyNEQ applies the NEQ predicate on the "body" field.
+func BodyNEQ(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNEQ(FieldBody, v))
+}
+
+// BodyIn applies the In predicate on the "body" field.
+func BodyIn(vs ...string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIn(FieldBody, vs...))
+}
+
+// BodyNotIn applies the NotIn predicate on the "body" field.
+func BodyNotIn(vs ...string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotIn(FieldBody, vs...))
+}
+
+// BodyGT applies the GT predicate on the "body" field.
+func BodyGT(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGT(FieldBody, v))
+}
+
+// BodyGTE applies the GTE predicate on the "body" field.
+func BodyGTE(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGTE(FieldBody, v))
+}
+
+// BodyLT applies the LT predicate on the "body" field.
+func BodyLT(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLT(FieldBody, v))
+}
+
+// BodyLTE applies the LTE predicate on the "body" field.
+func BodyLTE(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLTE(FieldBody, v))
+}
+
+// BodyContains applies the Contains predicate on the "body" field.
+func BodyContains(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldContains(FieldBody, v))
+}
+
+// BodyHasPrefix applies the HasPrefix predicate on the "body" field.
+func BodyHasPrefix(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldHasPrefix(FieldBody, v))
+}
+
+// BodyHasSuffix applies the HasSuffix predicate on the "body" field.
+func BodyHasSuffix(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldHasSuffix(FieldBody, v))
+}
+
+// BodyEqualFold applies the EqualFold predicate on the "body" field.
+func BodyEqualFold(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEqualFold(FieldBody, v))
+}
+
+// BodyContainsFold applies the ContainsFold predicate on the "body" field.
+func BodyContainsFold(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldContainsFold(FieldBody, v))
+}
+
+// ExcerptEQ applies the EQ predicate on the "excerpt" field.
+func ExcerptEQ(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldExcerpt, v))
+}
+
+// ExcerptNEQ applies the NEQ predicate on the "excerpt" field.
+func ExcerptNEQ(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNEQ(FieldExcerpt, v))
+}
+
+// ExcerptIn applies the In predicate on the "excerpt" field.
+func ExcerptIn(vs ...string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIn(FieldExcerpt, vs...))
+}
+
+// ExcerptNotIn applies the NotIn predicate on the "excerpt" field.
+func ExcerptNotIn(vs ...string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotIn(FieldExcerpt, vs...))
+}
+
+// ExcerptGT applies the GT predicate on the "excerpt" field.
+func ExcerptGT(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGT(FieldExcerpt, v))
+}
+
+// ExcerptGTE applies the GTE predicate on the "excerpt" field.
+func ExcerptGTE(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGTE(FieldExcerpt, v))
+}
+
+// ExcerptLT applies the LT predicate on the "excerpt" field.
+func ExcerptLT(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLT(FieldExcerpt, v))
+}
+
+// ExcerptLTE applies the LTE predicate on the "excerpt" field.
+func ExcerptLTE(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLTE(FieldExcerpt, v))
+}
+
+// ExcerptContains applies the Contains predicate on the "excerpt" field.
+func ExcerptContains(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldContains(FieldExcerpt, v))
+}
+
+// ExcerptHasPrefix applies the HasPrefix predicate on the "excerpt" field.
+func ExcerptHasPrefix(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldHasPrefix(FieldExcerpt, v))
+}
+
+// ExcerptHasSuffix applies the HasSuffix predicate on the "excerpt" field.
+func ExcerptHasSuffix(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldHasSuffix(FieldExcerpt, v))
+}
+
+// ExcerptEqualFold applies the EqualFold predicate on the "excerpt" field.
+func ExcerptEqualFold(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEqualFold(FieldExcerpt, v))
+}
+
+// ExcerptContainsFold applies the ContainsFold predicate on the "excerpt" field.
+func ExcerptContainsFold(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldContainsFold(FieldExcerpt, v))
+}
+
+// TagsIsNil applies the IsNil predicate on the "tags" field.
+func TagsIsNil() predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIsNull(FieldTags))
+}
+
+// TagsNotNil applies the NotNil predicate on the "tags" field.
+func TagsNotNil() predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotNull(FieldTags))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// RestoredFromEQ applies the EQ predicate on the "restored_from" field.
+func RestoredFromEQ(v int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldRestoredFrom, v))
+}
+
+// RestoredFromNEQ applies the NEQ predicate on the "restored_from" field.
+func RestoredFromNEQ(v int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNEQ(FieldRestoredFrom, v))
+}
+
+// RestoredFromIn applies the In predicate on the "restored_from" field.
+func RestoredFromIn(vs ...int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIn(FieldRestoredFrom, vs...))
+}
+
+// RestoredFromNotIn applies the NotIn predicate on the "restored_from" field.
+func RestoredFromNotIn(vs ...int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotIn(FieldRestoredFrom, vs...))
+}
+
+// RestoredFromGT applies the GT predicate on the "restored_from" field.
+func RestoredFromGT(v int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGT(FieldRestoredFrom, v))
+}
+
+// RestoredFromGTE applies the GTE predicate on the "restored_from" field.
+func RestoredFromGTE(v int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGTE(FieldRestoredFrom, v))
+}
+
+// RestoredFromLT applies the LT predicate on the "restored_from" field.
+func RestoredFromLT(v int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLT(FieldRestoredFrom, v))
+}
+
+// RestoredFromLTE applies the LTE predicate on the "restored_from" field.
+func RestoredFromLTE(v int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLTE(FieldRestoredFrom, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasPost applies the HasEdge predicate on the "post" edge.
+func HasPost() predicate.PostRevision {
+	return predicate.PostRevision(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PostTable, PostColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPostWith applies the HasEdge predicate on the "post" edge with a given conditions (other predicates).
+func HasPostWith(preds ...predicate.Post) predicate.PostRevision {
+	return predicate.PostRevision(func(s *sql.Selector) {
+		step := newPostStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEditor applies the HasEdge predicate on the "editor" edge.
+func HasEditor() predicate.PostRevision {
+	return predicate.PostRevision(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EditorTable, EditorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEditorWith applies the HasEdge predicate on the "editor" edge with a given conditions (other predicates).
+func HasEditorWith(preds ...predicate.User) predicate.PostRevision {
+	return predicate.PostRevision(func(s *sql.Selector) {
+		step := newEditorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PostRevision) predicate.PostRevision {
+	return predicate.PostRevision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PostRevision) predicate.PostRevision {
+	return predicate.PostRevision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PostRevision) predicate.PostRevision {
+	return predicate.PostRevision(sql.NotPredicates(p))
+}
@@ -0,0 +1,450 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/post"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/postrevision"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/user"
+)
+
+// PostRevisionCreate is the builder for creating a PostRevision entity.
+type PostRevisionCreate struct {
+	config
+	mutation *PostRevisionMutation
+	hooks    []Hook
+}
+
+// SetPostID sets the "post_id" field.
+func (_c *PostRevisionCreate) SetPostID(v uuid.UUID) *PostRevisionCreate {
+	_c.mutation.SetPostID(v)
+	return _c
+}
+
+// SetNumber sets the "number" field.
+func (_c *PostRevisionCreate) SetNumber(v int) *PostRevisionCreate {
+	_c.mutation.SetNumber(v)
+	return _c
+}
+
+// SetEditorID sets the "editor_id" field.
+func (_c *PostRevisionCreate) SetEditorID(v uuid.UUID) *PostRevisionCreate {
+	_c.mutation.SetEditorID(v)
+	return _c
+}
+
+// SetNillableEditorID sets the "editor_id" field if the given value is not nil.
+func (_c *PostRevisionCreate) SetNillableEditorID(v *uuid.UUID) *PostRevisionCreate {
+	if v != nil {
+		_c.SetEditorID(*v)
+	}
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *PostRevisionCreate) SetTitle(v string) *PostRevisionCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetSlug sets the "slug" field.
+func (_c *PostRevisionCreate) SetSlug(v string) *PostRevisionCreate {
+	_c.mutation.SetSlug(v)
+	return _c
+}
+
+// SetBody sets the "body" field.
+func (_c *PostRevisionCreate) SetBody(v string) *PostRevisionCreate {
+	_c.mutation.SetBody(v)
+	return _c
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_c *PostRevisionCreate) SetNillableBody(v *string) *PostRevisionCreate {
+	if v != nil {
+		_c.SetBody(*v)
+	}
+	return _c
+}
+
+// SetExcerpt sets the "excerpt" field.
+func (_c *PostRevisionCreate) SetExcerpt(v string) *PostRevisionCreate {
+	_c.mutation.SetExcerpt(v)
+	return _c
+}
+
+// SetNillableExcerpt sets the "excerpt" field if the given value is not nil.
+func (_c *PostRevisionCreate) SetNillableExcerpt(v *string) *PostRevisionCreate {
+	if v != nil {
+		_c.SetExcerpt(*v)
+	}
+	return _c
+}
+
+// SetTags sets the "tags" field.
+func (_c *PostRevisionCreate) SetTags(v []string) *PostRevisionCreate {
+	_c.mutation.SetTags(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *PostRevisionCreate) SetStatus(v string) *PostRevisionCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetRestoredFrom sets the "restored_from" field.
+func (_c *PostRevisionCreate) SetRestoredFrom(v int) *PostRevisionCreate {
+	_c.mutation.SetRestoredFrom(v)
+	return _c
+}
+
+// SetNillableRestoredFrom sets the "restored_from" field if the given value is not nil.
+func (_c *PostRevisionCreate) SetNillableRestoredFrom(v *int) *PostRevisionCreate {
+	if v != nil {
+		_c.SetRestoredFrom(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PostRevisionCreate) SetCreatedAt(v time.Time) *PostRevisionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PostRevisionCreate) SetNillableCreatedAt(v *time.Time) *PostRevisionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PostRevisionCreate) SetID(v uuid.UUID) *PostRevisionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PostRevisionCreate) SetNillableID(v *uuid.UUID) *PostRevisionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (_c *PostRevisionCreate) SetPost(v *Post) *PostRevisionCreate {
+	return _c.SetPostID(v.ID)
+}
+
+// SetEditor sets the "editor" edge to the User entity.
+func (_c *PostRevisionCreate) SetEditor(v *User) *PostRevisionCreate {
+	return _c.SetEditorID(v.ID)
+}
+
+// Mutation returns the PostRevisionMutation object of the builder.
+func (_c *PostRevisionCreate) Mutation() *PostRevisionMutation {
+	return _c.mutation
+}
+
+// Save creates the PostRevision in the database.
+func (_c *PostRevisionCreate) Save(ctx context.Context) (*PostRevision, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PostRevisionCreate) SaveX(ctx context.Context) *PostRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PostRevisionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PostRevisionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PostRevisionCreate) defaults() {
+	if _, ok := _c.mutation.Body(); !ok {
+		v := postrevision.DefaultBody
+		_c.mutation.SetBody(v)
+	}
+	if _, ok := _c.mutation.Excerpt(); !ok {
+		v := postrevision.DefaultExcerpt
+		_c.mutation.SetExcerpt(v)
+	}
+	if _, ok := _c.mutation.RestoredFrom(); !ok {
+		v := postrevision.DefaultRestoredFrom
+		_c.mutation.SetRestoredFrom(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := postrevision.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := postrevision.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PostRevisionCreate) check() error {
+	if _, ok := _c.mutation.PostID(); !ok {
+		return &ValidationError{Name: "post_id", err: errors.New(`ent: missing required field "PostRevision.post_id"`)}
+	}
+	if _, ok := _c.mutation.Number(); !ok {
+		return &ValidationError{Name: "number", err: errors.New(`ent: missing required field "PostRevision.number"`)}
+	}
+	if v, ok := _c.mutation.Number(); ok {
+		if err := postrevision.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "PostRevision.number": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "PostRevision.title"`)}
+	}
+	if v, ok := _c.mutation.Title(); ok {
+		if err := postrevision.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "PostRevision.title": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Slug(); !ok {
+		return &ValidationError{Name: "slug", err: errors.New(`ent: missing required field "PostRevision.slug"`)}
+	}
+	if v, ok := _c.mutation.Slug(); ok {
+		if err := postrevision.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "PostRevision.slug": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`ent: missing required field "PostRevision.body"`)}
+	}
+	if _, ok := _c.mutation.Excerpt(); !ok {
+		return &ValidationError{Name: "excerpt", err: errors.New(`ent: missing required field "PostRevision.excerpt"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PostRevision.status"`)}
+	}
+	if _, ok := _c.mutation.RestoredFrom(); !ok {
+		return &ValidationError{Name: "restored_from", err: errors.New(`ent: missing required field "PostRevision.restored_from"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PostRevision.created_at"`)}
+	}
+	if len(_c.mutation.PostIDs()) == 0 {
+		return &ValidationError{Name: "post", err: errors.New(`ent: missing required edge "PostRevision.post"`)}
+	}
+	return nil
+}
+
+func (_c *PostRevisionCreate) sqlSave(ctx context.Context) (*PostRevision, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PostRevisionCreate) createSpec() (*PostRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PostRevision{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(postrevision.Table, sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Number(); ok {
+		_spec.SetField(postrevision.FieldNumber, field.TypeInt, value)
+		_node.Number = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(postrevision.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Slug(); ok {
+		_spec.SetField(postrevision.FieldSlug, field.TypeString, value)
+		_node.Slug = value
+	}
+	if value, ok := _c.mutation.Body(); ok {
+		_spec.SetField(postrevision.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := _c.mutation.Excerpt(); ok {
+		_spec.SetField(postrevision.FieldExcerpt, field.TypeString, value)
+		_node.Excerpt = value
+	}
+	if value, ok := _c.mutation.Tags(); ok {
+		_spec.SetField(postrevision.FieldTags, field.TypeJSON, value)
+		_node.Tags = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(postrevision.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.RestoredFrom(); ok {
+		_spec.SetField(postrevision.FieldRestoredFrom, field.TypeInt, value)
+		_node.RestoredFrom = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(postrevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postrevision.PostTable,
+			Columns: []string{postrevision.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PostID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EditorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postrevision.EditorTable,
+			Columns: []string{postrevision.EditorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EditorID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PostRevisionCreateBulk is the builder for creating many PostRevision entities in bulk.
+type PostRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*PostRevisionCreate
+}
+
+// Save creates the PostRevision entities in the database.
+func (_c *PostRevisionCreateBulk) Save(ctx context.Context) ([]*PostRevision, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PostRevision, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PostRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PostRevisionCreateBulk) SaveX(ctx context.Context) []*PostRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PostRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PostRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,830 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/post"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/postrevision"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/predicate"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/user"
+)
+
+// PostRevisionUpdate is the builder for updating PostRevision entities.
+type PostRevisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PostRevisionMutation
+}
+
+// Where appends a list predicates to the PostRevisionUpdate builder.
+func (_u *PostRevisionUpdate) Where(ps ...predicate.PostRevision) *PostRevisionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPostID sets the "post_id" field.
+func (_u *PostRevisionUpdate) SetPostID(v uuid.UUID) *PostRevisionUpdate {
+	_u.mutation.SetPostID(v)
+	return _u
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (_u *PostRevisionUpdate) SetNillablePostID(v *uuid.UUID) *PostRevisionUpdate {
+	if v != nil {
+		_u.SetPostID(*v)
+	}
+	return _u
+}
+
+// SetNumber sets the "number" field.
+func (_u *PostRevisionUpdate) SetNumber(v int) *PostRevisionUpdate {
+	_u.mutation.ResetNumber()
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *PostRevisionUpdate) SetNillableNumber(v *int) *PostRevisionUpdate {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// AddNumber adds value to the "number" field.
+func (_u *PostRevisionUpdate) AddNumber(v int) *PostRevisionUpdate {
+	_u.mutation.AddNumber(v)
+	return _u
+}
+
+// SetEditorID sets the "editor_id" field.
+func (_u *PostRevisionUpdate) SetEditorID(v uuid.UUID) *PostRevisionUpdate {
+	_u.mutation.SetEditorID(v)
+	return _u
+}
+
+// SetNillableEditorID sets the "editor_id" field if the given value is not nil.
+func (_u *PostRevisionUpdate) SetNillableEditorID(v *uuid.UUID) *PostRevisionUpdate {
+	if v != nil {
+		_u.SetEditorID(*v)
+	}
+	return _u
+}
+
+// ClearEditorID clears the value of the "editor_id" field.
+func (_u *PostRevisionUpdate) ClearEditorID() *PostRevisionUpdate {
+	_u.mutation.ClearEditorID()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *PostRevisionUpdate) SetTitle(v string) *PostRevisionUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *PostRevisionUpdate) SetNillableTitle(v *string) *PostRevisionUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetSlug sets the "slug" field.
+func (_u *PostRevisionUpdate) SetSlug(v string) *PostRevisionUpdate {
+	_u.mutation.SetSlug(v)
+	return _u
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_u *PostRevisionUpdate) SetNillableSlug(v *string) *PostRevisionUpdate {
+	if v != nil {
+		_u.SetSlug(*v)
+	}
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *PostRevisionUpdate) SetBody(v string) *PostRevisionUpdate {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *PostRevisionUpdate) SetNillableBody(v *string) *PostRevisionUpdate {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// SetExcerpt sets the "excerpt" field.
+func (_u *PostRevisionUpdate) SetExcerpt(v string) *PostRevisionUpdate {
+	_u.mutation.SetExcerpt(v)
+	return _u
+}
+
+// SetNillableExcerpt sets the "excerpt" field if the given value is not nil.
+func (_u *PostRevisionUpdate) SetNillableExcerpt(v *string) *PostRevisionUpdate {
+	if v != nil {
+		_u.SetExcerpt(*v)
+	}
+	return _u
+}
+
+// SetTags sets the "tags" field.
+func (_u *PostRevisionUpdate) SetTags(v []string) *PostRevisionUpdate {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// AppendTags appends value to the "tags" field.
+func (_u *PostRevisionUpdate) AppendTags(v []string) *PostRevisionUpdate {
+	_u.mutation.AppendTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *PostRevisionUpdate) ClearTags() *PostRevisionUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PostRevisionUpdate) SetStatus(v string) *PostRevisionUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PostRevisionUpdate) SetNillableStatus(v *string) *PostRevisionUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetRestoredFrom sets the "restored_from" field.
+func (_u *PostRevisionUpdate) SetRestoredFrom(v int) *PostRevisionUpdate {
+	_u.mutation.ResetRestoredFrom()
+	_u.mutation.SetRestoredFrom(v)
+	return _u
+}
+
+// SetNillableRestoredFrom sets the "restored_from" field if the given value is not nil.
+func (_u *PostRevisionUpdate) SetNillableRestoredFrom(v *int) *PostRevisionUpdate {
+	if v != nil {
+		_u.SetRestoredFrom(*v)
+	}
+	return _u
+}
+
+// AddRestoredFrom adds value to the "restored_from" field.
+func (_u *PostRevisionUpdate) AddRestoredFrom(v int) *PostRevisionUpdate {
+	_u.mutation.AddRestoredFrom(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PostRevisionUpdate) SetCreatedAt(v time.Time) *PostRevisionUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PostRevisionUpdate) SetNillableCreatedAt(v *time.Time) *PostRevisionUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (_u *PostRevisionUpdate) SetPost(v *Post) *PostRevisionUpdate {
+	return _u.SetPostID(v.ID)
+}
+
+// SetEditor sets the "editor" edge to the User entity.
+func (_u *PostRevisionUpdate) SetEditor(v *User) *PostRevisionUpdate {
+	return _u.SetEditorID(v.ID)
+}
+
+// Mutation returns the PostRevisionMutation object of the builder.
+func (_u *PostRevisionUpdate) Mutation() *PostRevisionMutation {
+	return _u.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (_u *PostRevisionUpdate) ClearPost() *PostRevisionUpdate {
+	_u.mutation.ClearPost()
+	return _u
+}
+
+// ClearEditor clears the "editor" edge to the User entity.
+func (_u *PostRevisionUpdate) ClearEditor() *PostRevisionUpdate {
+	_u.mutation.ClearEditor()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PostRevisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PostRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PostRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PostRevisionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PostRevisionUpdate) check() error {
+	if v, ok := _u.mutation.Number(); ok {
+		if err := postrevision.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "PostRevision.number": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Title(); ok {
+		if err := postrevision.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "PostRevision.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Slug(); ok {
+		if err := postrevision.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "PostRevision.slug": %w`, err)}
+		}
+	}
+	if _u.mutation.PostCleared() && len(_u.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PostRevision.post"`)
+	}
+	return nil
+}
+
+func (_u *PostRevisionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(postrevision.Table, postrevision.Columns, sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(postrevision.FieldNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNumber(); ok {
+		_spec.AddField(postrevision.FieldNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(postrevision.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Slug(); ok {
+		_spec.SetField(postrevision.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(postrevision.FieldBody, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Excerpt(); ok {
+		_spec.SetField(postrevision.FieldExcerpt, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(postrevision.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, postrevision.FieldTags, value)
+		})
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(postrevision.FieldTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(postrevision.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RestoredFrom(); ok {
+		_spec.SetField(postrevision.FieldRestoredFrom, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRestoredFrom(); ok {
+		_spec.AddField(postrevision.FieldRestoredFrom, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(postrevision.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postrevision.PostTable,
+			Columns: []string{postrevision.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postrevision.PostTable,
+			Columns: []string{postrevision.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EditorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postrevision.EditorTable,
+			Columns: []string{postrevision.EditorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EditorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postrevision.EditorTable,
+			Columns: []string{postrevision.EditorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{postrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PostRevisionUpdateOne is the builder for updating a single PostRevision entity.
+type PostRevisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PostRevisionMutation
+}
+
+// SetPostID sets the "post_id" field.
+func (_u *PostRevisionUpdateOne) SetPostID(v uuid.UUID) *PostRevisionUpdateOne {
+	_u.mutation.SetPostID(v)
+	return _u
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (_u *PostRevisionUpdateOne) SetNillablePostID(v *uuid.UUID) *PostRevisionUpdateOne {
+	if v != nil {
+		_u.SetPostID(*v)
+	}
+	return _u
+}
+
+// SetNumber sets the "number" field.
+func (_u *PostRevisionUpdateOne) SetNumber(v int) *PostRevisionUpdateOne {
+	_u.mutation.ResetNumber()
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *PostRevisionUpdateOne) SetNillableNumber(v *int) *PostRevisionUpdateOne {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// AddNumber adds value to the "number" field.
+func (_u *PostRevisionUpdateOne) AddNumber(v int) *PostRevisionUpdateOne {
+	_u.mutation.AddNumber(v)
+	return _u
+}
+
+// SetEditorID sets the "editor_id" field.
+func (_u *PostRevisionUpdateOne) SetEditorID(v uuid.UUID) *PostRevisionUpdateOne {
+	_u.mutation.SetEditorID(v)
+	return _u
+}
+
+// SetNillableEditorID sets the "editor_id" field if the given value is not nil.
+func (_u *PostRevisionUpdateOne) SetNillableEditorID(v *uuid.UUID) *PostRevisionUpdateOne {
+	if v != nil {
+		_u.SetEditorID(*v)
+	}
+	return _u
+}
+
+// ClearEditorID clears the value of the "editor_id" field.
+func (_u *PostRevisionUpdateOne) ClearEditorID() *PostRevisionUpdateOne {
+	_u.mutation.ClearEditorID()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *PostRevisionUpdateOne) SetTitle(v string) *PostRevisionUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *PostRevisionUpdateOne) SetNillableTitle(v *string) *PostRevisionUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetSlug sets the "slug" field.
+func (_u *PostRevisionUpdateOne) SetSlug(v string) *PostRevisionUpdateOne {
+	_u.mutation.SetSlug(v)
+	return _u
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_u *PostRevisionUpdateOne) SetNillableSlug(v *string) *PostRevisionUpdateOne {
+	if v != nil {
+		_u.SetSlug(*v)
+	}
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *PostRevisionUpdateOne) SetBody(v string) *PostRevisionUpdateOne {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *PostRevisionUpdateOne) SetNillableBody(v *string) *PostRevisionUpdateOne {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// SetExcerpt sets the "excerpt" field.
+func (_u *PostRevisionUpdateOne) SetExcerpt(v string) *PostRevisionUpdateOne {
+	_u.mutation.SetExcerpt(v)
+	return _u
+}
+
+// SetNillableExcerpt sets the "excerpt" field if the given value is not nil.
+func (_u *PostRevisionUpdateOne) SetNillableExcerpt(v *string) *PostRevisionUpdateOne {
+	if v != nil {
+		_u.SetExcerpt(*v)
+	}
+	return _u
+}
+
+// SetTags sets the "tags" field.
+func (_u *PostRevisionUpdateOne) SetTags(v []string) *PostRevisionUpdateOne {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// AppendTags appends value to the "tags" field.
+func (_u *PostRevisionUpdateOne) AppendTags(v []string) *PostRevisionUpdateOne {
+	_u.mutation.AppendTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *PostRevisionUpdateOne) ClearTags() *PostRevisionUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PostRevisionUpdateOne) SetStatus(v string) *PostRevisionUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PostRevisionUpdateOne) SetNillableStatus(v *string) *PostRevisionUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetRestoredFrom sets the "restored_from" field.
+func (_u *PostRevisionUpdateOne) SetRestoredFrom(v int) *PostRevisionUpdateOne {
+	_u.mutation.ResetRestoredFrom()
+	_u.mutation.SetRestoredFrom(v)
+	return _u
+}
+
+// SetNillableRestoredFrom sets the "restored_from" field if the given value is not nil.
+func (_u *PostRevisionUpdateOne) SetNillableRestoredFrom(v *int) *PostRevisionUpdateOne {
+	if v != nil {
+		_u.SetRestoredFrom(*v)
+	}
+	return _u
+}
+
+// AddRestoredFrom adds value to the "restored_from" field.
+func (_u *PostRevisionUpdateOne) AddRestoredFrom(v int) *PostRevisionUpdateOne {
+	_u.mutation.AddRestoredFrom(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PostRevisionUpdateOne) SetCreatedAt(v time.Time) *PostRevisionUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PostRevisionUpdateOne) SetNillableCreatedAt(v *time.Time) *PostRevisionUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (_u *PostRevisionUpdateOne) SetPost(v *Post) *PostRevisionUpdateOne {
+	return _u.SetPostID(v.ID)
+}
+
+// SetEditor sets the "editor" edge to the User entity.
+func (_u *PostRevisionUpdateOne) SetEditor(v *User) *PostRevisionUpdateOne {
+	return _u.SetEditorID(v.ID)
+}
+
+// Mutation returns the PostRevisionMutation object of the builder.
+func (_u *PostRevisionUpdateOne) Mutation() *PostRevisionMutation {
+	return _u.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (_u *PostRevisionUpdateOne) ClearPost() *PostRevisionUpdateOne {
+	_u.mutation.ClearPost()
+	return _u
+}
+
+// ClearEditor clears the "editor" edge to the User entity.
+func (_u *PostRevisionUpdateOne) ClearEditor() *PostRevisionUpdateOne {
+	_u.mutation.ClearEditor()
+	return _u
+}
+
+// Where appends a list predicates to the PostRevisionUpdate builder.
+func (_u *PostRevisionUpdateOne) Where(ps ...predicate.PostRevision) *PostRevisionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PostRevisionUpdateOne) Select(field string, fields ...string) *PostRevisionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PostRevision entity.
+func (_u *PostRevisionUpdateOne) Save(ctx context.Context) (*PostRevision, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PostRevisionUpdateOne) SaveX(ctx context.Context) *PostRevision {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PostRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PostRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PostRevisionUpdateOne) check() error {
+	if v, ok := _u.mutation.Number(); ok {
+		if err := postrevision.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "PostRevision.number": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Title(); ok {
+		if err := postrevision.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "PostRevision.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Slug(); ok {
+		if err := postrevision.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "PostRevision.slug": %w`, err)}
+		}
+	}
+	if _u.mutation.PostCleared() && len(_u.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PostRevision.post"`)
+	}
+	return nil
+}
+
+func (_u *PostRevisionUpdateOne) sqlSave(ctx context.Context) (_node *PostRevision, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(postrevision.Table, postrevision.Columns, sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PostRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, postrevision.FieldID)
+		for _, f := range fields {
+			if !postrevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != postrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(postrevision.FieldNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNumber(); ok {
+		_spec.AddField(postrevision.FieldNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(postrevision.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Slug(); ok {
+		_spec.SetField(postrevision.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(postrevision.FieldBody, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Excerpt(); ok {
+		_spec.SetField(postrevision.FieldExcerpt, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(postrevision.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, postrevision.FieldTags, value)
+		})
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(postrevision.FieldTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(postrevision.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RestoredFrom(); ok {
+		_spec.SetField(postrevision.FieldRestoredFrom, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRestoredFrom(); ok {
+		_spec.AddField(postrevision.FieldRestoredFrom, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(postrevision.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postrevision.PostTable,
+			Columns: []string{postrevision.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postrevision.PostTable,
+			Columns: []string{postrevision.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EditorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postrevision.EditorTable,
+			Columns: []string{postrevision.EditorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EditorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postrevision.EditorTable,
+			Columns: []string{postrevision.EditorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PostRevision{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{postrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,158 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/post"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/postslug"
+)
+
+// PostSlug is the model entity for the PostSlug schema.
+type PostSlug struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Slug holds the value of the "slug" field.
+	Slug string `json:"slug,omitempty"`
+	// PostID holds the value of the "post_id" field.
+	PostID uuid.UUID `json:"post_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PostSlugQuery when eager-loading is set.
+	Edges        PostSlugEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PostSlugEdges holds the relations/edges for other nodes in the graph.
+type PostSlugEdges struct {
+	// Post holds the value of the post edge.
+	Post *Post `json:"post,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PostOrErr returns the Post value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PostSlugEdges) PostOrErr() (*Post, error) {
+	if e.Post != nil {
+		return e.Post, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: post.Label}
+	}
+	return nil, &NotLoadedError{edge: "post"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PostSlug) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case postslug.FieldSlug:
+			values[i] = new(sql.NullString)
+		case postslug.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case postslug.FieldID, postslug.FieldPostID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PostSlug fields.
+func (_m *PostSlug) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case postslug.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case postslug.FieldSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slug", values[i])
+			} else if value.Valid {
+				_m.Slug = value.String
+			}
+		case postslug.FieldPostID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field post_id", values[i])
+			} else if value != nil {
+				_m.PostID = *value
+			}
+		case postslug.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PostSlug.
+// This includes values selected through modifiers, order, etc.
+func (_m *PostSlug) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPost queries the "post" edge of the PostSlug entity.
+func (_m *PostSlug) QueryPost() *PostQuery {
+	return NewPostSlugClient(_m.config).QueryPost(_m)
+}
+
+// Update returns a builder for updating this PostSlug.
+// Note that you need to call PostSlug.Unwrap() before calling this method if this PostSlug
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PostSlug) Update() *PostSlugUpdateOne {
+	return NewPostSlugClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PostSlug entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PostSlug) Unwrap() *PostSlug {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PostSlug is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PostSlug) String() string {
+	var builder strings.Builder
+	builder.WriteString("PostSlug(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("slug=")
+	builder.WriteString(_m.Slug)
+	builder.WriteString(", ")
+	builder.WriteString("post_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PostID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PostSlugs is a parsable slice of PostSlug.
+type PostSlugs []*PostSlug
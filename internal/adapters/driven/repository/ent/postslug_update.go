@@ -0,0 +1,386 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/post"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/postslug"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/predicate"
+)
+
+// PostSlugUpdate is the builder for updating PostSlug entities.
+type PostSlugUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PostSlugMutation
+}
+
+// Where appends a list predicates to the PostSlugUpdate builder.
+func (_u *PostSlugUpdate) Where(ps ...predicate.PostSlug) *PostSlugUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetSlug sets the "slug" field.
+func (_u *PostSlugUpdate) SetSlug(v string) *PostSlugUpdate {
+	_u.mutation.SetSlug(v)
+	return _u
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_u *PostSlugUpdate) SetNillableSlug(v *string) *PostSlugUpdate {
+	if v != nil {
+		_u.SetSlug(*v)
+	}
+	return _u
+}
+
+// SetPostID sets the "post_id" field.
+func (_u *PostSlugUpdate) SetPostID(v uuid.UUID) *PostSlugUpdate {
+	_u.mutation.SetPostID(v)
+	return _u
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (_u *PostSlugUpdate) SetNillablePostID(v *uuid.UUID) *PostSlugUpdate {
+	if v != nil {
+		_u.SetPostID(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PostSlugUpdate) SetCreatedAt(v time.Time) *PostSlugUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PostSlugUpdate) SetNillableCreatedAt(v *time.Time) *PostSlugUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (_u *PostSlugUpdate) SetPost(v *Post) *PostSlugUpdate {
+	return _u.SetPostID(v.ID)
+}
+
+// Mutation returns the PostSlugMutation object of the builder.
+func (_u *PostSlugUpdate) Mutation() *PostSlugMutation {
+	return _u.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (_u *PostSlugUpdate) ClearPost() *PostSlugUpdate {
+	_u.mutation.ClearPost()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PostSlugUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PostSlugUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PostSlugUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PostSlugUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PostSlugUpdate) check() error {
+	if v, ok := _u.mutation.Slug(); ok {
+		if err := postslug.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "PostSlug.slug": %w`, err)}
+		}
+	}
+	if _u.mutation.PostCleared() && len(_u.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PostSlug.post"`)
+	}
+	return nil
+}
+
+func (_u *PostSlugUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(postslug.Table, postslug.Columns, sqlgraph.NewFieldSpec(postslug.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Slug(); ok {
+		_spec.SetField(postslug.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(postslug.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postslug.PostTable,
+			Columns: []string{postslug.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postslug.PostTable,
+			Columns: []string{postslug.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{postslug.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PostSlugUpdateOne is the builder for updating a single PostSlug entity.
+type PostSlugUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PostSlugMutation
+}
+
+// SetSlug sets the "slug" field.
+func (_u *PostSlugUpdateOne) SetSlug(v string) *PostSlugUpdateOne {
+	_u.mutation.SetSlug(v)
+	return _u
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_u *PostSlugUpdateOne) SetNillableSlug(v *string) *PostSlugUpdateOne {
+	if v != nil {
+		_u.SetSlug(*v)
+	}
+	return _u
+}
+
+// SetPostID sets the "post_id" field.
+func (_u *PostSlugUpdateOne) SetPostID(v uuid.UUID) *PostSlugUpdateOne {
+	_u.mutation.SetPostID(v)
+	return _u
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (_u *PostSlugUpdateOne) SetNillablePostID(v *uuid.UUID) *PostSlugUpdateOne {
+	if v != nil {
+		_u.SetPostID(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PostSlugUpdateOne) SetCreatedAt(v time.Time) *PostSlugUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PostSlugUpdateOne) SetNillableCreatedAt(v *time.Time) *PostSlugUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (_u *PostSlugUpdateOne) SetPost(v *Post) *PostSlugUpdateOne {
+	return _u.SetPostID(v.ID)
+}
+
+// Mutation returns the PostSlugMutation object of the builder.
+func (_u *PostSlugUpdateOne) Mutation() *PostSlugMutation {
+	return _u.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (_u *PostSlugUpdateOne) ClearPost() *PostSlugUpdateOne {
+	_u.mutation.ClearPost()
+	return _u
+}
+
+// Where appends a list predicates to the PostSlugUpdate builder.
+func (_u *PostSlugUpdateOne) Where(ps ...predicate.PostSlug) *PostSlugUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PostSlugUpdateOne) Select(field string, fields ...string) *PostSlugUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PostSlug entity.
+func (_u *PostSlugUpdateOne) Save(ctx context.Context) (*PostSlug, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PostSlugUpdateOne) SaveX(ctx context.Context) *PostSlug {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PostSlugUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PostSlugUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PostSlugUpdateOne) check() error {
+	if v, ok := _u.mutation.Slug(); ok {
+		if err := postslug.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "PostSlug.slug": %w`, err)}
+		}
+	}
+	if _u.mutation.PostCleared() && len(_u.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PostSlug.post"`)
+	}
+	return nil
+}
+
+func (_u *PostSlugUpdateOne) sqlSave(ctx context.Context) (_node *PostSlug, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(postslug.Table, postslug.Columns, sqlgraph.NewFieldSpec(postslug.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PostSlug.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, postslug.FieldID)
+		for _, f := range fields {
+			if !postslug.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != postslug.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Slug(); ok {
+		_spec.SetField(postslug.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(postslug.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postslug.PostTable,
+			Columns: []string{postslug.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postslug.PostTable,
+			Columns: []string{postslug.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PostSlug{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{postslug.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
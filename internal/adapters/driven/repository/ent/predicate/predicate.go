@@ -6,5 +6,41 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Album is the predicate function for album builders.
+type Album func(*sql.Selector)
+
+// Blob is the predicate function for blob builders.
+type Blob func(*sql.Selector)
+
+// Comment is the predicate function for comment builders.
+type Comment func(*sql.Selector)
+
+// ContactMessage is the predicate function for contactmessage builders.
+type ContactMessage func(*sql.Selector)
+
+// Image is the predicate function for image builders.
+type Image func(*sql.Selector)
+
+// Post is the predicate function for post builders.
+type Post func(*sql.Selector)
+
+// PostRevision is the predicate function for postrevision builders.
+type PostRevision func(*sql.Selector)
+
+// PostSlug is the predicate function for postslug builders.
+type PostSlug func(*sql.Selector)
+
+// Redirect is the predicate function for redirect builders.
+type Redirect func(*sql.Selector)
+
+// ResumableUpload is the predicate function for resumableupload builders.
+type ResumableUpload func(*sql.Selector)
+
+// Tag is the predicate function for tag builders.
+type Tag func(*sql.Selector)
+
+// Upload is the predicate function for upload builders.
+type Upload func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
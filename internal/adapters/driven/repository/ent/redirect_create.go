@@ -0,0 +1,287 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/redirect"
+)
+
+// RedirectCreate is the builder for creating a Redirect entity.
+type RedirectCreate struct {
+	config
+	mutation *RedirectMutation
+	hooks    []Hook
+}
+
+// SetPath sets the "path" field.
+func (_c *RedirectCreate) SetPath(v string) *RedirectCreate {
+	_c.mutation.SetPath(v)
+	return _c
+}
+
+// SetTarget sets the "target" field.
+func (_c *RedirectCreate) SetTarget(v string) *RedirectCreate {
+	_c.mutation.SetTarget(v)
+	return _c
+}
+
+// SetCode sets the "code" field.
+func (_c *RedirectCreate) SetCode(v int) *RedirectCreate {
+	_c.mutation.SetCode(v)
+	return _c
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_c *RedirectCreate) SetNillableCode(v *int) *RedirectCreate {
+	if v != nil {
+		_c.SetCode(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RedirectCreate) SetCreatedAt(v time.Time) *RedirectCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RedirectCreate) SetNillableCreatedAt(v *time.Time) *RedirectCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RedirectCreate) SetID(v uuid.UUID) *RedirectCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *RedirectCreate) SetNillableID(v *uuid.UUID) *RedirectCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the RedirectMutation object of the builder.
+func (_c *RedirectCreate) Mutation() *RedirectMutation {
+	return _c.mutation
+}
+
+// Save creates the Redirect in the database.
+func (_c *RedirectCreate) Save(ctx context.Context) (*Redirect, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RedirectCreate) SaveX(ctx context.Context) *Redirect {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RedirectCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RedirectCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RedirectCreate) defaults() {
+	if _, ok := _c.mutation.Code(); !ok {
+		v := redirect.DefaultCode
+		_c.mutation.SetCode(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := redirect.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := redirect.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RedirectCreate) check() error {
+	if _, ok := _c.mutation.Path(); !ok {
+		return &ValidationError{Name: "path", err: errors.New(`ent: missing required field "Redirect.path"`)}
+	}
+	if v, ok := _c.mutation.Path(); ok {
+		if err := redirect.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "Redirect.path": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Target(); !ok {
+		return &ValidationError{Name: "target", err: errors.New(`ent: missing required field "Redirect.target"`)}
+	}
+	if v, ok := _c.mutation.Target(); ok {
+		if err := redirect.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "Redirect.target": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "Redirect.code"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Redirect.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *RedirectCreate) sqlSave(ctx context.Context) (*Redirect, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RedirectCreate) createSpec() (*Redirect, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Redirect{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(redirect.Table, sqlgraph.NewFieldSpec(redirect.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Path(); ok {
+		_spec.SetField(redirect.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := _c.mutation.Target(); ok {
+		_spec.SetField(redirect.FieldTarget, field.TypeString, value)
+		_node.Target = value
+	}
+	if value, ok := _c.mutation.Code(); ok {
+		_spec.SetField(redirect.FieldCode, field.TypeInt, value)
+		_node.Code = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(redirect.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// RedirectCreateBulk is the builder for creating many Redirect entities in bulk.
+type RedirectCreateBulk struct {
+	config
+	err      error
+	builders []*RedirectCreate
+}
+
+// Save creates the Redirect entities in the database.
+func (_c *RedirectCreateBulk) Save(ctx context.Context) ([]*Redirect, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Redirect, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RedirectMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RedirectCreateBulk) SaveX(ctx context.Context) []*Redirect {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RedirectCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RedirectCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,368 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/predicate"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/redirect"
+)
+
+// RedirectUpdate is the builder for updating Redirect entities.
+type RedirectUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RedirectMutation
+}
+
+// Where appends a list predicates to the RedirectUpdate builder.
+func (_u *RedirectUpdate) Where(ps ...predicate.Redirect) *RedirectUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *RedirectUpdate) SetPath(v string) *RedirectUpdate {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *RedirectUpdate) SetNillablePath(v *string) *RedirectUpdate {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// SetTarget sets the "target" field.
+func (_u *RedirectUpdate) SetTarget(v string) *RedirectUpdate {
+	_u.mutation.SetTarget(v)
+	return _u
+}
+
+// SetNillableTarget sets the "target" field if the given value is not nil.
+func (_u *RedirectUpdate) SetNillableTarget(v *string) *RedirectUpdate {
+	if v != nil {
+		_u.SetTarget(*v)
+	}
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *RedirectUpdate) SetCode(v int) *RedirectUpdate {
+	_u.mutation.ResetCode()
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *RedirectUpdate) SetNillableCode(v *int) *RedirectUpdate {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// AddCode adds value to the "code" field.
+func (_u *RedirectUpdate) AddCode(v int) *RedirectUpdate {
+	_u.mutation.AddCode(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *RedirectUpdate) SetCreatedAt(v time.Time) *RedirectUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *RedirectUpdate) SetNillableCreatedAt(v *time.Time) *RedirectUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the RedirectMutation object of the builder.
+func (_u *RedirectUpdate) Mutation() *RedirectMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RedirectUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RedirectUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RedirectUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RedirectUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RedirectUpdate) check() error {
+	if v, ok := _u.mutation.Path(); ok {
+		if err := redirect.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "Redirect.path": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Target(); ok {
+		if err := redirect.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "Redirect.target": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RedirectUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(redirect.Table, redirect.Columns, sqlgraph.NewFieldSpec(redirect.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(redirect.FieldPath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Target(); ok {
+		_spec.SetField(redirect.FieldTarget, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(redirect.FieldCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCode(); ok {
+		_spec.AddField(redirect.FieldCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(redirect.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{redirect.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RedirectUpdateOne is the builder for updating a single Redirect entity.
+type RedirectUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RedirectMutation
+}
+
+// SetPath sets the "path" field.
+func (_u *RedirectUpdateOne) SetPath(v string) *RedirectUpdateOne {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *RedirectUpdateOne) SetNillablePath(v *string) *RedirectUpdateOne {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// SetTarget sets the "target" field.
+func (_u *RedirectUpdateOne) SetTarget(v string) *RedirectUpdateOne {
+	_u.mutation.SetTarget(v)
+	return _u
+}
+
+// SetNillableTarget sets the "target" field if the given value is not nil.
+func (_u *RedirectUpdateOne) SetNillableTarget(v *string) *RedirectUpdateOne {
+	if v != nil {
+		_u.SetTarget(*v)
+	}
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *RedirectUpdateOne) SetCode(v int) *RedirectUpdateOne {
+	_u.mutation.ResetCode()
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *RedirectUpdateOne) SetNillableCode(v *int) *RedirectUpdateOne {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// AddCode adds value to the "code" field.
+func (_u *RedirectUpdateOne) AddCode(v int) *RedirectUpdateOne {
+	_u.mutation.AddCode(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *RedirectUpdateOne) SetCreatedAt(v time.Time) *RedirectUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *RedirectUpdateOne) SetNillableCreatedAt(v *time.Time) *RedirectUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the RedirectMutation object of the builder.
+func (_u *RedirectUpdateOne) Mutation() *RedirectMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RedirectUpdate builder.
+func (_u *RedirectUpdateOne) Where(ps ...predicate.Redirect) *RedirectUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RedirectUpdateOne) Select(field string, fields ...string) *RedirectUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Redirect entity.
+func (_u *RedirectUpdateOne) Save(ctx context.Context) (*Redirect, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RedirectUpdateOne) SaveX(ctx context.Context) *Redirect {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RedirectUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RedirectUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RedirectUpdateOne) check() error {
+	if v, ok := _u.mutation.Path(); ok {
+		if err := redirect.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "Redirect.path": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Target(); ok {
+		if err := redirect.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "Redirect.target": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RedirectUpdateOne) sqlSave(ctx context.Context) (_node *Redirect, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(redirect.Table, redirect.Columns, sqlgraph.NewFieldSpec(redirect.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Redirect.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, redirect.FieldID)
+		for _, f := range fields {
+			if !redirect.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != redirect.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(redirect.FieldPath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Target(); ok {
+		_spec.SetField(redirect.FieldTarget, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(redirect.FieldCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCode(); ok {
+		_spec.AddField(redirect.FieldCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(redirect.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &Redirect{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{redirect.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
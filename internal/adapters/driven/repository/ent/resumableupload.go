@@ -0,0 +1,216 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/resumableupload"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/schema"
+)
+
+// ResumableUpload is the model entity for the ResumableUpload schema.
+type ResumableUpload struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID uuid.UUID `json:"owner_id,omitempty"`
+	// Length holds the value of the "length" field.
+	Length int64 `json:"length,omitempty"`
+	// Offset holds the value of the "offset" field.
+	Offset int64 `json:"offset,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// ObjectName holds the value of the "object_name" field.
+	ObjectName string `json:"object_name,omitempty"`
+	// MultipartID holds the value of the "multipart_id" field.
+	MultipartID string `json:"multipart_id,omitempty"`
+	// Parts holds the value of the "parts" field.
+	Parts []schema.UploadPart `json:"parts,omitempty"`
+	// ImageID holds the value of the "image_id" field.
+	ImageID uuid.UUID `json:"image_id,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ResumableUpload) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case resumableupload.FieldMetadata, resumableupload.FieldParts:
+			values[i] = new([]byte)
+		case resumableupload.FieldLength, resumableupload.FieldOffset:
+			values[i] = new(sql.NullInt64)
+		case resumableupload.FieldObjectName, resumableupload.FieldMultipartID:
+			values[i] = new(sql.NullString)
+		case resumableupload.FieldExpiresAt, resumableupload.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case resumableupload.FieldID, resumableupload.FieldOwnerID, resumableupload.FieldImageID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ResumableUpload fields.
+func (_m *ResumableUpload) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case resumableupload.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case resumableupload.FieldOwnerID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value != nil {
+				_m.OwnerID = *value
+			}
+		case resumableupload.FieldLength:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field length", values[i])
+			} else if value.Valid {
+				_m.Length = value.Int64
+			}
+		case resumableupload.FieldOffset:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field offset", values[i])
+			} else if value.Valid {
+				_m.Offset = value.Int64
+			}
+		case resumableupload.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case resumableupload.FieldObjectName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field object_name", values[i])
+			} else if value.Valid {
+				_m.ObjectName = value.String
+			}
+		case resumableupload.FieldMultipartID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field multipart_id", values[i])
+			} else if value.Valid {
+				_m.MultipartID = value.String
+			}
+		case resumableupload.FieldParts:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field parts", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Parts); err != nil {
+					return fmt.Errorf("unmarshal field parts: %w", err)
+				}
+			}
+		case resumableupload.FieldImageID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field image_id", values[i])
+			} else if value != nil {
+				_m.ImageID = *value
+			}
+		case resumableupload.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case resumableupload.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ResumableUpload.
+// This includes values selected through modifiers, order, etc.
+func (_m *ResumableUpload) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ResumableUpload.
+// Note that you need to call ResumableUpload.Unwrap() before calling this method if this ResumableUpload
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ResumableUpload) Update() *ResumableUploadUpdateOne {
+	return NewResumableUploadClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ResumableUpload entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ResumableUpload) Unwrap() *ResumableUpload {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ResumableUpload is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ResumableUpload) String() string {
+	var builder strings.Builder
+	builder.WriteString("ResumableUpload(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("owner_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OwnerID))
+	builder.WriteString(", ")
+	builder.WriteString("length=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Length))
+	builder.WriteString(", ")
+	builder.WriteString("offset=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Offset))
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("object_name=")
+	builder.WriteString(_m.ObjectName)
+	builder.WriteString(", ")
+	builder.WriteString("multipart_id=")
+	builder.WriteString(_m.MultipartID)
+	builder.WriteString(", ")
+	builder.WriteString("parts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Parts))
+	builder.WriteString(", ")
+	builder.WriteString("image_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ImageID))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ResumableUploads is a parsable slice of ResumableUpload.
+type ResumableUploads []*ResumableUpload
@@ -0,0 +1,123 @@
+// Code generated by ent, DO NOT EDIT.
+
+package resumableupload
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the resumableupload type in the database.
+	Label = "resumable_upload"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOwnerID holds the string denoting the owner_id field in the database.
+	FieldOwnerID = "owner_id"
+	// FieldLength holds the string denoting the length field in the database.
+	FieldLength = "length"
+	// FieldOffset holds the string denoting the offset field in the database.
+	FieldOffset = "offset"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// FieldObjectName holds the string denoting the object_name field in the database.
+	FieldObjectName = "object_name"
+	// FieldMultipartID holds the string denoting the multipart_id field in the database.
+	FieldMultipartID = "multipart_id"
+	// FieldParts holds the string denoting the parts field in the database.
+	FieldParts = "parts"
+	// FieldImageID holds the string denoting the image_id field in the database.
+	FieldImageID = "image_id"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the resumableupload in the database.
+	Table = "resumable_uploads"
+)
+
+// Columns holds all SQL columns for resumableupload fields.
+var Columns = []string{
+	FieldID,
+	FieldOwnerID,
+	FieldLength,
+	FieldOffset,
+	FieldMetadata,
+	FieldObjectName,
+	FieldMultipartID,
+	FieldParts,
+	FieldImageID,
+	FieldExpiresAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultOffset holds the default value on creation for the "offset" field.
+	DefaultOffset int64
+	// ObjectNameValidator is a validator for the "object_name" field. It is called by the builders before save.
+	ObjectNameValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the ResumableUpload queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByOwnerID orders the results by the owner_id field.
+func ByOwnerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwnerID, opts...).ToFunc()
+}
+
+// ByLength orders the results by the length field.
+func ByLength(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLength, opts...).ToFunc()
+}
+
+// ByOffset orders the results by the offset field.
+func ByOffset(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOffset, opts...).ToFunc()
+}
+
+// ByObjectName orders the results by the object_name field.
+func ByObjectName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldObjectName, opts...).ToFunc()
+}
+
+// ByMultipartID orders the results by the multipart_id field.
+func ByMultipartID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMultipartID, opts...).ToFunc()
+}
+
+// ByImageID orders the results by the image_id field.
+func ByImageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImageID, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
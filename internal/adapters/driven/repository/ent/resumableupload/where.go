@@ -0,0 +1,521 @@
+// Code generated by ent, DO NOT EDIT.
+
+package resumableupload
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldLTE(FieldID, id))
+}
+
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// Length applies equality check predicate on the "length" field. It's identical to LengthEQ.
+func Length(v int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldEQ(FieldLength, v))
+}
+
+// Offset applies equality check predicate on the "offset" field. It's identical to OffsetEQ.
+func Offset(v int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldEQ(FieldOffset, v))
+}
+
+// ObjectName applies equality check predicate on the "object_name" field. It's identical to ObjectNameEQ.
+func ObjectName(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldEQ(FieldObjectName, v))
+}
+
+// MultipartID applies equality check predicate on the "multipart_id" field. It's identical to MultipartIDEQ.
+func MultipartID(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldEQ(FieldMultipartID, v))
+}
+
+// ImageID applies equality check predicate on the "image_id" field. It's identical to ImageIDEQ.
+func ImageID(v uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldEQ(FieldImageID, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldNEQ(FieldOwnerID, v))
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldNotIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDGT applies the GT predicate on the "owner_id" field.
+func OwnerIDGT(v uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldGT(FieldOwnerID, v))
+}
+
+// OwnerIDGTE applies the GTE predicate on the "owner_id" field.
+func OwnerIDGTE(v uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldGTE(FieldOwnerID, v))
+}
+
+// OwnerIDLT applies the LT predicate on the "owner_id" field.
+func OwnerIDLT(v uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldLT(FieldOwnerID, v))
+}
+
+// OwnerIDLTE applies the LTE predicate on the "owner_id" field.
+func OwnerIDLTE(v uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldLTE(FieldOwnerID, v))
+}
+
+// LengthEQ applies the EQ predicate on the "length" field.
+func LengthEQ(v int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldEQ(FieldLength, v))
+}
+
+// LengthNEQ applies the NEQ predicate on the "length" field.
+func LengthNEQ(v int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldNEQ(FieldLength, v))
+}
+
+// LengthIn applies the In predicate on the "length" field.
+func LengthIn(vs ...int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldIn(FieldLength, vs...))
+}
+
+// LengthNotIn applies the NotIn predicate on the "length" field.
+func LengthNotIn(vs ...int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldNotIn(FieldLength, vs...))
+}
+
+// LengthGT applies the GT predicate on the "length" field.
+func LengthGT(v int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldGT(FieldLength, v))
+}
+
+// LengthGTE applies the GTE predicate on the "length" field.
+func LengthGTE(v int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldGTE(FieldLength, v))
+}
+
+// LengthLT applies the LT predicate on the "length" field.
+func LengthLT(v int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldLT(FieldLength, v))
+}
+
+// LengthLTE applies the LTE predicate on the "length" field.
+func LengthLTE(v int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldLTE(FieldLength, v))
+}
+
+// OffsetEQ applies the EQ predicate on the "offset" field.
+func OffsetEQ(v int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldEQ(FieldOffset, v))
+}
+
+// OffsetNEQ applies the NEQ predicate on the "offset" field.
+func OffsetNEQ(v int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldNEQ(FieldOffset, v))
+}
+
+// OffsetIn applies the In predicate on the "offset" field.
+func OffsetIn(vs ...int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldIn(FieldOffset, vs...))
+}
+
+// OffsetNotIn applies the NotIn predicate on the "offset" field.
+func OffsetNotIn(vs ...int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldNotIn(FieldOffset, vs...))
+}
+
+// OffsetGT applies the GT predicate on the "offset" field.
+func OffsetGT(v int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldGT(FieldOffset, v))
+}
+
+// OffsetGTE applies the GTE predicate on the "offset" field.
+func OffsetGTE(v int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldGTE(FieldOffset, v))
+}
+
+// OffsetLT applies the LT predicate on the "offset" field.
+func OffsetLT(v int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldLT(FieldOffset, v))
+}
+
+// OffsetLTE applies the LTE predicate on the "offset" field.
+func OffsetLTE(v int64) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldLTE(FieldOffset, v))
+}
+
+// MetadataIsNil applies the IsNil predicate on the "metadata" field.
+func MetadataIsNil() predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldIsNull(FieldMetadata))
+}
+
+// MetadataNotNil applies the NotNil predicate on the "metadata" field.
+func MetadataNotNil() predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldNotNull(FieldMetadata))
+}
+
+// ObjectNameEQ applies the EQ predicate on the "object_name" field.
+func ObjectNameEQ(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldEQ(FieldObjectName, v))
+}
+
+// ObjectNameNEQ applies the NEQ predicate on the "object_name" field.
+func ObjectNameNEQ(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldNEQ(FieldObjectName, v))
+}
+
+// ObjectNameIn applies the In predicate on the "object_name" field.
+func ObjectNameIn(vs ...string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldIn(FieldObjectName, vs...))
+}
+
+// ObjectNameNotIn applies the NotIn predicate on the "object_name" field.
+func ObjectNameNotIn(vs ...string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldNotIn(FieldObjectName, vs...))
+}
+
+// ObjectNameGT applies the GT predicate on the "object_name" field.
+func ObjectNameGT(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldGT(FieldObjectName, v))
+}
+
+// ObjectNameGTE applies the GTE predicate on the "object_name" field.
+func ObjectNameGTE(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldGTE(FieldObjectName, v))
+}
+
+// ObjectNameLT applies the LT predicate on the "object_name" field.
+func ObjectNameLT(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldLT(FieldObjectName, v))
+}
+
+// ObjectNameLTE applies the LTE predicate on the "object_name" field.
+func ObjectNameLTE(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldLTE(FieldObjectName, v))
+}
+
+// ObjectNameContains applies the Contains predicate on the "object_name" field.
+func ObjectNameContains(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldContains(FieldObjectName, v))
+}
+
+// ObjectNameHasPrefix applies the HasPrefix predicate on the "object_name" field.
+func ObjectNameHasPrefix(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldHasPrefix(FieldObjectName, v))
+}
+
+// ObjectNameHasSuffix applies the HasSuffix predicate on the "object_name" field.
+func ObjectNameHasSuffix(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldHasSuffix(FieldObjectName, v))
+}
+
+// ObjectNameEqualFold applies the EqualFold predicate on the "object_name" field.
+func ObjectNameEqualFold(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldEqualFold(FieldObjectName, v))
+}
+
+// ObjectNameContainsFold applies the ContainsFold predicate on the "object_name" field.
+func ObjectNameContainsFold(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldContainsFold(FieldObjectName, v))
+}
+
+// MultipartIDEQ applies the EQ predicate on the "multipart_id" field.
+func MultipartIDEQ(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldEQ(FieldMultipartID, v))
+}
+
+// MultipartIDNEQ applies the NEQ predicate on the "multipart_id" field.
+func MultipartIDNEQ(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldNEQ(FieldMultipartID, v))
+}
+
+// MultipartIDIn applies the In predicate on the "multipart_id" field.
+func MultipartIDIn(vs ...string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldIn(FieldMultipartID, vs...))
+}
+
+// MultipartIDNotIn applies the NotIn predicate on the "multipart_id" field.
+func MultipartIDNotIn(vs ...string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldNotIn(FieldMultipartID, vs...))
+}
+
+// MultipartIDGT applies the GT predicate on the "multipart_id" field.
+func MultipartIDGT(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldGT(FieldMultipartID, v))
+}
+
+// MultipartIDGTE applies the GTE predicate on the "multipart_id" field.
+func MultipartIDGTE(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldGTE(FieldMultipartID, v))
+}
+
+// MultipartIDLT applies the LT predicate on the "multipart_id" field.
+func MultipartIDLT(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldLT(FieldMultipartID, v))
+}
+
+// MultipartIDLTE applies the LTE predicate on the "multipart_id" field.
+func MultipartIDLTE(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldLTE(FieldMultipartID, v))
+}
+
+// MultipartIDContains applies the Contains predicate on the "multipart_id" field.
+func MultipartIDContains(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldContains(FieldMultipartID, v))
+}
+
+// MultipartIDHasPrefix applies the HasPrefix predicate on the "multipart_id" field.
+func MultipartIDHasPrefix(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldHasPrefix(FieldMultipartID, v))
+}
+
+// MultipartIDHasSuffix applies the HasSuffix predicate on the "multipart_id" field.
+func MultipartIDHasSuffix(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldHasSuffix(FieldMultipartID, v))
+}
+
+// MultipartIDIsNil applies the IsNil predicate on the "multipart_id" field.
+func MultipartIDIsNil() predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldIsNull(FieldMultipartID))
+}
+
+// MultipartIDNotNil applies the NotNil predicate on the "multipart_id" field.
+func MultipartIDNotNil() predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldNotNull(FieldMultipartID))
+}
+
+// MultipartIDEqualFold applies the EqualFold predicate on the "multipart_id" field.
+func MultipartIDEqualFold(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldEqualFold(FieldMultipartID, v))
+}
+
+// MultipartIDContainsFold applies the ContainsFold predicate on the "multipart_id" field.
+func MultipartIDContainsFold(v string) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldContainsFold(FieldMultipartID, v))
+}
+
+// PartsIsNil applies the IsNil predicate on the "parts" field.
+func PartsIsNil() predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldIsNull(FieldParts))
+}
+
+// PartsNotNil applies the NotNil predicate on the "parts" field.
+func PartsNotNil() predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldNotNull(FieldParts))
+}
+
+// ImageIDEQ applies the EQ predicate on the "image_id" field.
+func ImageIDEQ(v uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldEQ(FieldImageID, v))
+}
+
+// ImageIDNEQ applies the NEQ predicate on the "image_id" field.
+func ImageIDNEQ(v uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldNEQ(FieldImageID, v))
+}
+
+// ImageIDIn applies the In predicate on the "image_id" field.
+func ImageIDIn(vs ...uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldIn(FieldImageID, vs...))
+}
+
+// ImageIDNotIn applies the NotIn predicate on the "image_id" field.
+func ImageIDNotIn(vs ...uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldNotIn(FieldImageID, vs...))
+}
+
+// ImageIDGT applies the GT predicate on the "image_id" field.
+func ImageIDGT(v uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldGT(FieldImageID, v))
+}
+
+// ImageIDGTE applies the GTE predicate on the "image_id" field.
+func ImageIDGTE(v uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldGTE(FieldImageID, v))
+}
+
+// ImageIDLT applies the LT predicate on the "image_id" field.
+func ImageIDLT(v uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldLT(FieldImageID, v))
+}
+
+// ImageIDLTE applies the LTE predicate on the "image_id" field.
+func ImageIDLTE(v uuid.UUID) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldLTE(FieldImageID, v))
+}
+
+// ImageIDIsNil applies the IsNil predicate on the "image_id" field.
+func ImageIDIsNil() predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldIsNull(FieldImageID))
+}
+
+// ImageIDNotNil applies the NotNil predicate on the "image_id" field.
+func ImageIDNotNil() predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldNotNull(FieldImageID))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ResumableUpload) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ResumableUpload) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ResumableUpload) predicate.ResumableUpload {
+	return predicate.ResumableUpload(sql.NotPredicates(p))
+}
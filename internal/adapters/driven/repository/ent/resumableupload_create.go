@@ -0,0 +1,365 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/resumableupload"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/schema"
+)
+
+// ResumableUploadCreate is the builder for creating a ResumableUpload entity.
+type ResumableUploadCreate struct {
+	config
+	mutation *ResumableUploadMutation
+	hooks    []Hook
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_c *ResumableUploadCreate) SetOwnerID(v uuid.UUID) *ResumableUploadCreate {
+	_c.mutation.SetOwnerID(v)
+	return _c
+}
+
+// SetLength sets the "length" field.
+func (_c *ResumableUploadCreate) SetLength(v int64) *ResumableUploadCreate {
+	_c.mutation.SetLength(v)
+	return _c
+}
+
+// SetOffset sets the "offset" field.
+func (_c *ResumableUploadCreate) SetOffset(v int64) *ResumableUploadCreate {
+	_c.mutation.SetOffset(v)
+	return _c
+}
+
+// SetNillableOffset sets the "offset" field if the given value is not nil.
+func (_c *ResumableUploadCreate) SetNillableOffset(v *int64) *ResumableUploadCreate {
+	if v != nil {
+		_c.SetOffset(*v)
+	}
+	return _c
+}
+
+// SetMetadata sets the "metadata" field.
+func (_c *ResumableUploadCreate) SetMetadata(v map[string]string) *ResumableUploadCreate {
+	_c.mutation.SetMetadata(v)
+	return _c
+}
+
+// SetObjectName sets the "object_name" field.
+func (_c *ResumableUploadCreate) SetObjectName(v string) *ResumableUploadCreate {
+	_c.mutation.SetObjectName(v)
+	return _c
+}
+
+// SetMultipartID sets the "multipart_id" field.
+func (_c *ResumableUploadCreate) SetMultipartID(v string) *ResumableUploadCreate {
+	_c.mutation.SetMultipartID(v)
+	return _c
+}
+
+// SetNillableMultipartID sets the "multipart_id" field if the given value is not nil.
+func (_c *ResumableUploadCreate) SetNillableMultipartID(v *string) *ResumableUploadCreate {
+	if v != nil {
+		_c.SetMultipartID(*v)
+	}
+	return _c
+}
+
+// SetParts sets the "parts" field.
+func (_c *ResumableUploadCreate) SetParts(v []schema.UploadPart) *ResumableUploadCreate {
+	_c.mutation.SetParts(v)
+	return _c
+}
+
+// SetImageID sets the "image_id" field.
+func (_c *ResumableUploadCreate) SetImageID(v uuid.UUID) *ResumableUploadCreate {
+	_c.mutation.SetImageID(v)
+	return _c
+}
+
+// SetNillableImageID sets the "image_id" field if the given value is not nil.
+func (_c *ResumableUploadCreate) SetNillableImageID(v *uuid.UUID) *ResumableUploadCreate {
+	if v != nil {
+		_c.SetImageID(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *ResumableUploadCreate) SetExpiresAt(v time.Time) *ResumableUploadCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ResumableUploadCreate) SetCreatedAt(v time.Time) *ResumableUploadCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ResumableUploadCreate) SetNillableCreatedAt(v *time.Time) *ResumableUploadCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ResumableUploadCreate) SetID(v uuid.UUID) *ResumableUploadCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ResumableUploadCreate) SetNillableID(v *uuid.UUID) *ResumableUploadCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ResumableUploadMutation object of the builder.
+func (_c *ResumableUploadCreate) Mutation() *ResumableUploadMutation {
+	return _c.mutation
+}
+
+// Save creates the ResumableUpload in the database.
+func (_c *ResumableUploadCreate) Save(ctx context.Context) (*ResumableUpload, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ResumableUploadCreate) SaveX(ctx context.Context) *ResumableUpload {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ResumableUploadCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ResumableUploadCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ResumableUploadCreate) defaults() {
+	if _, ok := _c.mutation.Offset(); !ok {
+		v := resumableupload.DefaultOffset
+		_c.mutation.SetOffset(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := resumableupload.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := resumableupload.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ResumableUploadCreate) check() error {
+	if _, ok := _c.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner_id", err: errors.New(`ent: missing required field "ResumableUpload.owner_id"`)}
+	}
+	if _, ok := _c.mutation.Length(); !ok {
+		return &ValidationError{Name: "length", err: errors.New(`ent: missing required field "ResumableUpload.length"`)}
+	}
+	if _, ok := _c.mutation.Offset(); !ok {
+		return &ValidationError{Name: "offset", err: errors.New(`ent: missing required field "ResumableUpload.offset"`)}
+	}
+	if _, ok := _c.mutation.ObjectName(); !ok {
+		return &ValidationError{Name: "object_name", err: errors.New(`ent: missing required field "ResumableUpload.object_name"`)}
+	}
+	if v, ok := _c.mutation.ObjectName(); ok {
+		if err := resumableupload.ObjectNameValidator(v); err != nil {
+			return &ValidationError{Name: "object_name", err: fmt.Errorf(`ent: validator failed for field "ResumableUpload.object_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "ResumableUpload.expires_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ResumableUpload.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *ResumableUploadCreate) sqlSave(ctx context.Context) (*ResumableUpload, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ResumableUploadCreate) createSpec() (*ResumableUpload, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ResumableUpload{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(resumableupload.Table, sqlgraph.NewFieldSpec(resumableupload.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.OwnerID(); ok {
+		_spec.SetField(resumableupload.FieldOwnerID, field.TypeUUID, value)
+		_node.OwnerID = value
+	}
+	if value, ok := _c.mutation.Length(); ok {
+		_spec.SetField(resumableupload.FieldLength, field.TypeInt64, value)
+		_node.Length = value
+	}
+	if value, ok := _c.mutation.Offset(); ok {
+		_spec.SetField(resumableupload.FieldOffset, field.TypeInt64, value)
+		_node.Offset = value
+	}
+	if value, ok := _c.mutation.Metadata(); ok {
+		_spec.SetField(resumableupload.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := _c.mutation.ObjectName(); ok {
+		_spec.SetField(resumableupload.FieldObjectName, field.TypeString, value)
+		_node.ObjectName = value
+	}
+	if value, ok := _c.mutation.MultipartID(); ok {
+		_spec.SetField(resumableupload.FieldMultipartID, field.TypeString, value)
+		_node.MultipartID = value
+	}
+	if value, ok := _c.mutation.Parts(); ok {
+		_spec.SetField(resumableupload.FieldParts, field.TypeJSON, value)
+		_node.Parts = value
+	}
+	if value, ok := _c.mutation.ImageID(); ok {
+		_spec.SetField(resumableupload.FieldImageID, field.TypeUUID, value)
+		_node.ImageID = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(resumableupload.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(resumableupload.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// ResumableUploadCreateBulk is the builder for creating many ResumableUpload entities in bulk.
+type ResumableUploadCreateBulk struct {
+	config
+	err      error
+	builders []*ResumableUploadCreate
+}
+
+// Save creates the ResumableUpload entities in the database.
+func (_c *ResumableUploadCreateBulk) Save(ctx context.Context) ([]*ResumableUpload, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ResumableUpload, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ResumableUploadMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ResumableUploadCreateBulk) SaveX(ctx context.Context) []*ResumableUpload {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ResumableUploadCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ResumableUploadCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
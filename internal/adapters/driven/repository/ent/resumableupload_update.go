@@ -0,0 +1,647 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/predicate"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/resumableupload"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/schema"
+)
+
+// ResumableUploadUpdate is the builder for updating ResumableUpload entities.
+type ResumableUploadUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ResumableUploadMutation
+}
+
+// Where appends a list predicates to the ResumableUploadUpdate builder.
+func (_u *ResumableUploadUpdate) Where(ps ...predicate.ResumableUpload) *ResumableUploadUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *ResumableUploadUpdate) SetOwnerID(v uuid.UUID) *ResumableUploadUpdate {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *ResumableUploadUpdate) SetNillableOwnerID(v *uuid.UUID) *ResumableUploadUpdate {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// SetLength sets the "length" field.
+func (_u *ResumableUploadUpdate) SetLength(v int64) *ResumableUploadUpdate {
+	_u.mutation.ResetLength()
+	_u.mutation.SetLength(v)
+	return _u
+}
+
+// SetNillableLength sets the "length" field if the given value is not nil.
+func (_u *ResumableUploadUpdate) SetNillableLength(v *int64) *ResumableUploadUpdate {
+	if v != nil {
+		_u.SetLength(*v)
+	}
+	return _u
+}
+
+// AddLength adds value to the "length" field.
+func (_u *ResumableUploadUpdate) AddLength(v int64) *ResumableUploadUpdate {
+	_u.mutation.AddLength(v)
+	return _u
+}
+
+// SetOffset sets the "offset" field.
+func (_u *ResumableUploadUpdate) SetOffset(v int64) *ResumableUploadUpdate {
+	_u.mutation.ResetOffset()
+	_u.mutation.SetOffset(v)
+	return _u
+}
+
+// SetNillableOffset sets the "offset" field if the given value is not nil.
+func (_u *ResumableUploadUpdate) SetNillableOffset(v *int64) *ResumableUploadUpdate {
+	if v != nil {
+		_u.SetOffset(*v)
+	}
+	return _u
+}
+
+// AddOffset adds value to the "offset" field.
+func (_u *ResumableUploadUpdate) AddOffset(v int64) *ResumableUploadUpdate {
+	_u.mutation.AddOffset(v)
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *ResumableUploadUpdate) SetMetadata(v map[string]string) *ResumableUploadUpdate {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (_u *ResumableUploadUpdate) ClearMetadata() *ResumableUploadUpdate {
+	_u.mutation.ClearMetadata()
+	return _u
+}
+
+// SetObjectName sets the "object_name" field.
+func (_u *ResumableUploadUpdate) SetObjectName(v string) *ResumableUploadUpdate {
+	_u.mutation.SetObjectName(v)
+	return _u
+}
+
+// SetNillableObjectName sets the "object_name" field if the given value is not nil.
+func (_u *ResumableUploadUpdate) SetNillableObjectName(v *string) *ResumableUploadUpdate {
+	if v != nil {
+		_u.SetObjectName(*v)
+	}
+	return _u
+}
+
+// SetMultipartID sets the "multipart_id" field.
+func (_u *ResumableUploadUpdate) SetMultipartID(v string) *ResumableUploadUpdate {
+	_u.mutation.SetMultipartID(v)
+	return _u
+}
+
+// SetNillableMultipartID sets the "multipart_id" field if the given value is not nil.
+func (_u *ResumableUploadUpdate) SetNillableMultipartID(v *string) *ResumableUploadUpdate {
+	if v != nil {
+		_u.SetMultipartID(*v)
+	}
+	return _u
+}
+
+// ClearMultipartID clears the value of the "multipart_id" field.
+func (_u *ResumableUploadUpdate) ClearMultipartID() *ResumableUploadUpdate {
+	_u.mutation.ClearMultipartID()
+	return _u
+}
+
+// SetParts sets the "parts" field.
+func (_u *ResumableUploadUpdate) SetParts(v []schema.UploadPart) *ResumableUploadUpdate {
+	_u.mutation.SetParts(v)
+	return _u
+}
+
+// AppendParts appends value to the "parts" field.
+func (_u *ResumableUploadUpdate) AppendParts(v []schema.UploadPart) *ResumableUploadUpdate {
+	_u.mutation.AppendParts(v)
+	return _u
+}
+
+// ClearParts clears the value of the "parts" field.
+func (_u *ResumableUploadUpdate) ClearParts() *ResumableUploadUpdate {
+	_u.mutation.ClearParts()
+	return _u
+}
+
+// SetImageID sets the "image_id" field.
+func (_u *ResumableUploadUpdate) SetImageID(v uuid.UUID) *ResumableUploadUpdate {
+	_u.mutation.SetImageID(v)
+	return _u
+}
+
+// SetNillableImageID sets the "image_id" field if the given value is not nil.
+func (_u *ResumableUploadUpdate) SetNillableImageID(v *uuid.UUID) *ResumableUploadUpdate {
+	if v != nil {
+		_u.SetImageID(*v)
+	}
+	return _u
+}
+
+// ClearImageID clears the value of the "image_id" field.
+func (_u *ResumableUploadUpdate) ClearImageID() *ResumableUploadUpdate {
+	_u.mutation.ClearImageID()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ResumableUploadUpdate) SetExpiresAt(v time.Time) *ResumableUploadUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ResumableUploadUpdate) SetNillableExpiresAt(v *time.Time) *ResumableUploadUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ResumableUploadUpdate) SetCreatedAt(v time.Time) *ResumableUploadUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ResumableUploadUpdate) SetNillableCreatedAt(v *time.Time) *ResumableUploadUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ResumableUploadMutation object of the builder.
+func (_u *ResumableUploadUpdate) Mutation() *ResumableUploadMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ResumableUploadUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ResumableUploadUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ResumableUploadUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ResumableUploadUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ResumableUploadUpdate) check() error {
+	if v, ok := _u.mutation.ObjectName(); ok {
+		if err := resumableupload.ObjectNameValidator(v); err != nil {
+			return &ValidationError{Name: "object_name", err: fmt.Errorf(`ent: validator failed for field "ResumableUpload.object_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ResumableUploadUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(resumableupload.Table, resumableupload.Columns, sqlgraph.NewFieldSpec(resumableupload.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(resumableupload.FieldOwnerID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Length(); ok {
+		_spec.SetField(resumableupload.FieldLength, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLength(); ok {
+		_spec.AddField(resumableupload.FieldLength, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Offset(); ok {
+		_spec.SetField(resumableupload.FieldOffset, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOffset(); ok {
+		_spec.AddField(resumableupload.FieldOffset, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(resumableupload.FieldMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.MetadataCleared() {
+		_spec.ClearField(resumableupload.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ObjectName(); ok {
+		_spec.SetField(resumableupload.FieldObjectName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MultipartID(); ok {
+		_spec.SetField(resumableupload.FieldMultipartID, field.TypeString, value)
+	}
+	if _u.mutation.MultipartIDCleared() {
+		_spec.ClearField(resumableupload.FieldMultipartID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Parts(); ok {
+		_spec.SetField(resumableupload.FieldParts, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedParts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, resumableupload.FieldParts, value)
+		})
+	}
+	if _u.mutation.PartsCleared() {
+		_spec.ClearField(resumableupload.FieldParts, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ImageID(); ok {
+		_spec.SetField(resumableupload.FieldImageID, field.TypeUUID, value)
+	}
+	if _u.mutation.ImageIDCleared() {
+		_spec.ClearField(resumableupload.FieldImageID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(resumableupload.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(resumableupload.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{resumableupload.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ResumableUploadUpdateOne is the builder for updating a single ResumableUpload entity.
+type ResumableUploadUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ResumableUploadMutation
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *ResumableUploadUpdateOne) SetOwnerID(v uuid.UUID) *ResumableUploadUpdateOne {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *ResumableUploadUpdateOne) SetNillableOwnerID(v *uuid.UUID) *ResumableUploadUpdateOne {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// SetLength sets the "length" field.
+func (_u *ResumableUploadUpdateOne) SetLength(v int64) *ResumableUploadUpdateOne {
+	_u.mutation.ResetLength()
+	_u.mutation.SetLength(v)
+	return _u
+}
+
+// SetNillableLength sets the "length" field if the given value is not nil.
+func (_u *ResumableUploadUpdateOne) SetNillableLength(v *int64) *ResumableUploadUpdateOne {
+	if v != nil {
+		_u.SetLength(*v)
+	}
+	return _u
+}
+
+// AddLength adds value to the "length" field.
+func (_u *ResumableUploadUpdateOne) AddLength(v int64) *ResumableUploadUpdateOne {
+	_u.mutation.AddLength(v)
+	return _u
+}
+
+// SetOffset sets the "offset" field.
+func (_u *ResumableUploadUpdateOne) SetOffset(v int64) *ResumableUploadUpdateOne {
+	_u.mutation.ResetOffset()
+	_u.mutation.SetOffset(v)
+	return _u
+}
+
+// SetNillableOffset sets the "offset" field if the given value is not nil.
+func (_u *ResumableUploadUpdateOne) SetNillableOffset(v *int64) *ResumableUploadUpdateOne {
+	if v != nil {
+		_u.SetOffset(*v)
+	}
+	return _u
+}
+
+// AddOffset adds value to the "offset" field.
+func (_u *ResumableUploadUpdateOne) AddOffset(v int64) *ResumableUploadUpdateOne {
+	_u.mutation.AddOffset(v)
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *ResumableUploadUpdateOne) SetMetadata(v map[string]string) *ResumableUploadUpdateOne {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (_u *ResumableUploadUpdateOne) ClearMetadata() *ResumableUploadUpdateOne {
+	_u.mutation.ClearMetadata()
+	return _u
+}
+
+// SetObjectName sets the "object_name" field.
+func (_u *ResumableUploadUpdateOne) SetObjectName(v string) *ResumableUploadUpdateOne {
+	_u.mutation.SetObjectName(v)
+	return _u
+}
+
+// SetNillableObjectName sets the "object_name" field if the given value is not nil.
+func (_u *ResumableUploadUpdateOne) SetNillableObjectName(v *string) *ResumableUploadUpdateOne {
+	if v != nil {
+		_u.SetObjectName(*v)
+	}
+	return _u
+}
+
+// SetMultipartID sets the "multipart_id" field.
+func (_u *ResumableUploadUpdateOne) SetMultipartID(v string) *ResumableUploadUpdateOne {
+	_u.mutation.SetMultipartID(v)
+	return _u
+}
+
+// SetNillableMultipartID sets the "multipart_id" field if the given value is not nil.
+func (_u *ResumableUploadUpdateOne) SetNillableMultipartID(v *string) *ResumableUploadUpdateOne {
+	if v != nil {
+		_u.SetMultipartID(*v)
+	}
+	return _u
+}
+
+// ClearMultipartID clears the value of the "multipart_id" field.
+func (_u *ResumableUploadUpdateOne) ClearMultipartID() *ResumableUploadUpdateOne {
+	_u.mutation.ClearMultipartID()
+	return _u
+}
+
+// SetParts sets the "parts" field.
+func (_u *ResumableUploadUpdateOne) SetParts(v []schema.UploadPart) *ResumableUploadUpdateOne {
+	_u.mutation.SetParts(v)
+	return _u
+}
+
+// AppendParts appends value to the "parts" field.
+func (_u *ResumableUploadUpdateOne) AppendParts(v []schema.UploadPart) *ResumableUploadUpdateOne {
+	_u.mutation.AppendParts(v)
+	return _u
+}
+
+// ClearParts clears the value of the "parts" field.
+func (_u *ResumableUploadUpdateOne) ClearParts() *ResumableUploadUpdateOne {
+	_u.mutation.ClearParts()
+	return _u
+}
+
+// SetImageID sets the "image_id" field.
+func (_u *ResumableUploadUpdateOne) SetImageID(v uuid.UUID) *ResumableUploadUpdateOne {
+	_u.mutation.SetImageID(v)
+	return _u
+}
+
+// SetNillableImageID sets the "image_id" field if the given value is not nil.
+func (_u *ResumableUploadUpdateOne) SetNillableImageID(v *uuid.UUID) *ResumableUploadUpdateOne {
+	if v != nil {
+		_u.SetImageID(*v)
+	}
+	return _u
+}
+
+// ClearImageID clears the value of the "image_id" field.
+func (_u *ResumableUploadUpdateOne) ClearImageID() *ResumableUploadUpdateOne {
+	_u.mutation.ClearImageID()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ResumableUploadUpdateOne) SetExpiresAt(v time.Time) *ResumableUploadUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ResumableUploadUpdateOne) SetNillableExpiresAt(v *time.Time) *ResumableUploadUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ResumableUploadUpdateOne) SetCreatedAt(v time.Time) *ResumableUploadUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ResumableUploadUpdateOne) SetNillableCreatedAt(v *time.Time) *ResumableUploadUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ResumableUploadMutation object of the builder.
+func (_u *ResumableUploadUpdateOne) Mutation() *ResumableUploadMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ResumableUploadUpdate builder.
+func (_u *ResumableUploadUpdateOne) Where(ps ...predicate.ResumableUpload) *ResumableUploadUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ResumableUploadUpdateOne) Select(field string, fields ...string) *ResumableUploadUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ResumableUpload entity.
+func (_u *ResumableUploadUpdateOne) Save(ctx context.Context) (*ResumableUpload, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ResumableUploadUpdateOne) SaveX(ctx context.Context) *ResumableUpload {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ResumableUploadUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ResumableUploadUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ResumableUploadUpdateOne) check() error {
+	if v, ok := _u.mutation.ObjectName(); ok {
+		if err := resumableupload.ObjectNameValidator(v); err != nil {
+			return &ValidationError{Name: "object_name", err: fmt.Errorf(`ent: validator failed for field "ResumableUpload.object_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ResumableUploadUpdateOne) sqlSave(ctx context.Context) (_node *ResumableUpload, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(resumableupload.Table, resumableupload.Columns, sqlgraph.NewFieldSpec(resumableupload.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ResumableUpload.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, resumableupload.FieldID)
+		for _, f := range fields {
+			if !resumableupload.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != resumableupload.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(resumableupload.FieldOwnerID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Length(); ok {
+		_spec.SetField(resumableupload.FieldLength, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLength(); ok {
+		_spec.AddField(resumableupload.FieldLength, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Offset(); ok {
+		_spec.SetField(resumableupload.FieldOffset, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOffset(); ok {
+		_spec.AddField(resumableupload.FieldOffset, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(resumableupload.FieldMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.MetadataCleared() {
+		_spec.ClearField(resumableupload.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ObjectName(); ok {
+		_spec.SetField(resumableupload.FieldObjectName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MultipartID(); ok {
+		_spec.SetField(resumableupload.FieldMultipartID, field.TypeString, value)
+	}
+	if _u.mutation.MultipartIDCleared() {
+		_spec.ClearField(resumableupload.FieldMultipartID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Parts(); ok {
+		_spec.SetField(resumableupload.FieldParts, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedParts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, resumableupload.FieldParts, value)
+		})
+	}
+	if _u.mutation.PartsCleared() {
+		_spec.ClearField(resumableupload.FieldParts, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ImageID(); ok {
+		_spec.SetField(resumableupload.FieldImageID, field.TypeUUID, value)
+	}
+	if _u.mutation.ImageIDCleared() {
+		_spec.ClearField(resumableupload.FieldImageID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(resumableupload.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(resumableupload.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &ResumableUpload{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{resumableupload.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
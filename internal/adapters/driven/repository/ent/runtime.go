@@ -6,7 +6,19 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/album"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/blob"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/comment"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/contactmessage"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/image"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/post"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/postrevision"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/postslug"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/redirect"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/resumableupload"
 	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/schema"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/tag"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/upload"
 	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/user"
 )
 
@@ -14,6 +26,338 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	albumFields := schema.Album{}.Fields()
+	_ = albumFields
+	// albumDescSlug is the schema descriptor for slug field.
+	albumDescSlug := albumFields[2].Descriptor()
+	// album.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	album.SlugValidator = albumDescSlug.Validators[0].(func(string) error)
+	// albumDescTitle is the schema descriptor for title field.
+	albumDescTitle := albumFields[3].Descriptor()
+	// album.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	album.TitleValidator = albumDescTitle.Validators[0].(func(string) error)
+	// albumDescDescription is the schema descriptor for description field.
+	albumDescDescription := albumFields[4].Descriptor()
+	// album.DefaultDescription holds the default value on creation for the description field.
+	album.DefaultDescription = albumDescDescription.Default.(string)
+	// albumDescVisibility is the schema descriptor for visibility field.
+	albumDescVisibility := albumFields[5].Descriptor()
+	// album.DefaultVisibility holds the default value on creation for the visibility field.
+	album.DefaultVisibility = albumDescVisibility.Default.(string)
+	// albumDescCreatedAt is the schema descriptor for created_at field.
+	albumDescCreatedAt := albumFields[8].Descriptor()
+	// album.DefaultCreatedAt holds the default value on creation for the created_at field.
+	album.DefaultCreatedAt = albumDescCreatedAt.Default.(func() time.Time)
+	// albumDescUpdatedAt is the schema descriptor for updated_at field.
+	albumDescUpdatedAt := albumFields[9].Descriptor()
+	// album.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	album.DefaultUpdatedAt = albumDescUpdatedAt.Default.(func() time.Time)
+	// albumDescID is the schema descriptor for id field.
+	albumDescID := albumFields[0].Descriptor()
+	// album.DefaultID holds the default value on creation for the id field.
+	album.DefaultID = albumDescID.Default.(func() uuid.UUID)
+	blobFields := schema.Blob{}.Fields()
+	_ = blobFields
+	// blobDescChecksum is the schema descriptor for checksum field.
+	blobDescChecksum := blobFields[1].Descriptor()
+	// blob.ChecksumValidator is a validator for the "checksum" field. It is called by the builders before save.
+	blob.ChecksumValidator = blobDescChecksum.Validators[0].(func(string) error)
+	// blobDescContentType is the schema descriptor for content_type field.
+	blobDescContentType := blobFields[2].Descriptor()
+	// blob.ContentTypeValidator is a validator for the "content_type" field. It is called by the builders before save.
+	blob.ContentTypeValidator = blobDescContentType.Validators[0].(func(string) error)
+	// blobDescRefCount is the schema descriptor for ref_count field.
+	blobDescRefCount := blobFields[5].Descriptor()
+	// blob.RefCountValidator is a validator for the "ref_count" field. It is called by the builders before save.
+	blob.RefCountValidator = blobDescRefCount.Validators[0].(func(int) error)
+	// blobDescCreatedAt is the schema descriptor for created_at field.
+	blobDescCreatedAt := blobFields[6].Descriptor()
+	// blob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	blob.DefaultCreatedAt = blobDescCreatedAt.Default.(func() time.Time)
+	// blobDescID is the schema descriptor for id field.
+	blobDescID := blobFields[0].Descriptor()
+	// blob.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	blob.IDValidator = blobDescID.Validators[0].(func(string) error)
+	commentFields := schema.Comment{}.Fields()
+	_ = commentFields
+	// commentDescAuthorName is the schema descriptor for author_name field.
+	commentDescAuthorName := commentFields[4].Descriptor()
+	// comment.AuthorNameValidator is a validator for the "author_name" field. It is called by the builders before save.
+	comment.AuthorNameValidator = commentDescAuthorName.Validators[0].(func(string) error)
+	// commentDescAuthorEmail is the schema descriptor for author_email field.
+	commentDescAuthorEmail := commentFields[5].Descriptor()
+	// comment.DefaultAuthorEmail holds the default value on creation for the author_email field.
+	comment.DefaultAuthorEmail = commentDescAuthorEmail.Default.(string)
+	// commentDescBody is the schema descriptor for body field.
+	commentDescBody := commentFields[6].Descriptor()
+	// comment.BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	comment.BodyValidator = commentDescBody.Validators[0].(func(string) error)
+	// commentDescStatus is the schema descriptor for status field.
+	commentDescStatus := commentFields[7].Descriptor()
+	// comment.DefaultStatus holds the default value on creation for the status field.
+	comment.DefaultStatus = commentDescStatus.Default.(string)
+	// commentDescCreatedAt is the schema descriptor for created_at field.
+	commentDescCreatedAt := commentFields[8].Descriptor()
+	// comment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	comment.DefaultCreatedAt = commentDescCreatedAt.Default.(func() time.Time)
+	// commentDescUpdatedAt is the schema descriptor for updated_at field.
+	commentDescUpdatedAt := commentFields[9].Descriptor()
+	// comment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	comment.DefaultUpdatedAt = commentDescUpdatedAt.Default.(func() time.Time)
+	// commentDescID is the schema descriptor for id field.
+	commentDescID := commentFields[0].Descriptor()
+	// comment.DefaultID holds the default value on creation for the id field.
+	comment.DefaultID = commentDescID.Default.(func() uuid.UUID)
+	contactmessageFields := schema.ContactMessage{}.Fields()
+	_ = contactmessageFields
+	// contactmessageDescName is the schema descriptor for name field.
+	contactmessageDescName := contactmessageFields[1].Descriptor()
+	// contactmessage.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	contactmessage.NameValidator = contactmessageDescName.Validators[0].(func(string) error)
+	// contactmessageDescEmail is the schema descriptor for email field.
+	contactmessageDescEmail := contactmessageFields[2].Descriptor()
+	// contactmessage.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	contactmessage.EmailValidator = contactmessageDescEmail.Validators[0].(func(string) error)
+	// contactmessageDescSubject is the schema descriptor for subject field.
+	contactmessageDescSubject := contactmessageFields[3].Descriptor()
+	// contactmessage.DefaultSubject holds the default value on creation for the subject field.
+	contactmessage.DefaultSubject = contactmessageDescSubject.Default.(string)
+	// contactmessageDescMessage is the schema descriptor for message field.
+	contactmessageDescMessage := contactmessageFields[4].Descriptor()
+	// contactmessage.MessageValidator is a validator for the "message" field. It is called by the builders before save.
+	contactmessage.MessageValidator = contactmessageDescMessage.Validators[0].(func(string) error)
+	// contactmessageDescCreatedAt is the schema descriptor for created_at field.
+	contactmessageDescCreatedAt := contactmessageFields[5].Descriptor()
+	// contactmessage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	contactmessage.DefaultCreatedAt = contactmessageDescCreatedAt.Default.(func() time.Time)
+	// contactmessageDescID is the schema descriptor for id field.
+	contactmessageDescID := contactmessageFields[0].Descriptor()
+	// contactmessage.DefaultID holds the default value on creation for the id field.
+	contactmessage.DefaultID = contactmessageDescID.Default.(func() uuid.UUID)
+	imageFields := schema.Image{}.Fields()
+	_ = imageFields
+	// imageDescVisibility is the schema descriptor for visibility field.
+	imageDescVisibility := imageFields[2].Descriptor()
+	// image.DefaultVisibility holds the default value on creation for the visibility field.
+	image.DefaultVisibility = imageDescVisibility.Default.(string)
+	// imageDescStoredName is the schema descriptor for stored_name field.
+	imageDescStoredName := imageFields[4].Descriptor()
+	// image.StoredNameValidator is a validator for the "stored_name" field. It is called by the builders before save.
+	image.StoredNameValidator = imageDescStoredName.Validators[0].(func(string) error)
+	// imageDescContentType is the schema descriptor for content_type field.
+	imageDescContentType := imageFields[5].Descriptor()
+	// image.ContentTypeValidator is a validator for the "content_type" field. It is called by the builders before save.
+	image.ContentTypeValidator = imageDescContentType.Validators[0].(func(string) error)
+	// imageDescWidth is the schema descriptor for width field.
+	imageDescWidth := imageFields[9].Descriptor()
+	// image.DefaultWidth holds the default value on creation for the width field.
+	image.DefaultWidth = imageDescWidth.Default.(int)
+	// imageDescHeight is the schema descriptor for height field.
+	imageDescHeight := imageFields[10].Descriptor()
+	// image.DefaultHeight holds the default value on creation for the height field.
+	image.DefaultHeight = imageDescHeight.Default.(int)
+	// imageDescBlurHash is the schema descriptor for blur_hash field.
+	imageDescBlurHash := imageFields[11].Descriptor()
+	// image.DefaultBlurHash holds the default value on creation for the blur_hash field.
+	image.DefaultBlurHash = imageDescBlurHash.Default.(string)
+	// imageDescDominantColor is the schema descriptor for dominant_color field.
+	imageDescDominantColor := imageFields[12].Descriptor()
+	// image.DefaultDominantColor holds the default value on creation for the dominant_color field.
+	image.DefaultDominantColor = imageDescDominantColor.Default.(string)
+	// imageDescCaption is the schema descriptor for caption field.
+	imageDescCaption := imageFields[14].Descriptor()
+	// image.DefaultCaption holds the default value on creation for the caption field.
+	image.DefaultCaption = imageDescCaption.Default.(string)
+	// imageDescCredit is the schema descriptor for credit field.
+	imageDescCredit := imageFields[15].Descriptor()
+	// image.DefaultCredit holds the default value on creation for the credit field.
+	image.DefaultCredit = imageDescCredit.Default.(string)
+	// imageDescLicense is the schema descriptor for license field.
+	imageDescLicense := imageFields[16].Descriptor()
+	// image.DefaultLicense holds the default value on creation for the license field.
+	image.DefaultLicense = imageDescLicense.Default.(string)
+	// imageDescCreatedAt is the schema descriptor for created_at field.
+	imageDescCreatedAt := imageFields[17].Descriptor()
+	// image.DefaultCreatedAt holds the default value on creation for the created_at field.
+	image.DefaultCreatedAt = imageDescCreatedAt.Default.(func() time.Time)
+	// imageDescID is the schema descriptor for id field.
+	imageDescID := imageFields[0].Descriptor()
+	// image.DefaultID holds the default value on creation for the id field.
+	image.DefaultID = imageDescID.Default.(func() uuid.UUID)
+	postFields := schema.Post{}.Fields()
+	_ = postFields
+	// postDescSlug is the schema descriptor for slug field.
+	postDescSlug := postFields[2].Descriptor()
+	// post.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	post.SlugValidator = postDescSlug.Validators[0].(func(string) error)
+	// postDescTitle is the schema descriptor for title field.
+	postDescTitle := postFields[3].Descriptor()
+	// post.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	post.TitleValidator = postDescTitle.Validators[0].(func(string) error)
+	// postDescBody is the schema descriptor for body field.
+	postDescBody := postFields[4].Descriptor()
+	// post.DefaultBody holds the default value on creation for the body field.
+	post.DefaultBody = postDescBody.Default.(string)
+	// postDescHTML is the schema descriptor for html field.
+	postDescHTML := postFields[5].Descriptor()
+	// post.DefaultHTML holds the default value on creation for the html field.
+	post.DefaultHTML = postDescHTML.Default.(string)
+	// postDescText is the schema descriptor for text field.
+	postDescText := postFields[6].Descriptor()
+	// post.DefaultText holds the default value on creation for the text field.
+	post.DefaultText = postDescText.Default.(string)
+	// postDescExcerpt is the schema descriptor for excerpt field.
+	postDescExcerpt := postFields[7].Descriptor()
+	// post.DefaultExcerpt holds the default value on creation for the excerpt field.
+	post.DefaultExcerpt = postDescExcerpt.Default.(string)
+	// postDescSummary is the schema descriptor for summary field.
+	postDescSummary := postFields[8].Descriptor()
+	// post.DefaultSummary holds the default value on creation for the summary field.
+	post.DefaultSummary = postDescSummary.Default.(string)
+	// postDescStatus is the schema descriptor for status field.
+	postDescStatus := postFields[9].Descriptor()
+	// post.DefaultStatus holds the default value on creation for the status field.
+	post.DefaultStatus = postDescStatus.Default.(string)
+	// postDescCreatedAt is the schema descriptor for created_at field.
+	postDescCreatedAt := postFields[12].Descriptor()
+	// post.DefaultCreatedAt holds the default value on creation for the created_at field.
+	post.DefaultCreatedAt = postDescCreatedAt.Default.(func() time.Time)
+	// postDescUpdatedAt is the schema descriptor for updated_at field.
+	postDescUpdatedAt := postFields[13].Descriptor()
+	// post.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	post.DefaultUpdatedAt = postDescUpdatedAt.Default.(func() time.Time)
+	// postDescID is the schema descriptor for id field.
+	postDescID := postFields[0].Descriptor()
+	// post.DefaultID holds the default value on creation for the id field.
+	post.DefaultID = postDescID.Default.(func() uuid.UUID)
+	postrevisionFields := schema.PostRevision{}.Fields()
+	_ = postrevisionFields
+	// postrevisionDescNumber is the schema descriptor for number field.
+	postrevisionDescNumber := postrevisionFields[2].Descriptor()
+	// postrevision.NumberValidator is a validator for the "number" field. It is called by the builders before save.
+	postrevision.NumberValidator = postrevisionDescNumber.Validators[0].(func(int) error)
+	// postrevisionDescTitle is the schema descriptor for title field.
+	postrevisionDescTitle := postrevisionFields[4].Descriptor()
+	// postrevision.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	postrevision.TitleValidator = postrevisionDescTitle.Validators[0].(func(string) error)
+	// postrevisionDescSlug is the schema descriptor for slug field.
+	postrevisionDescSlug := postrevisionFields[5].Descriptor()
+	// postrevision.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	postrevision.SlugValidator = postrevisionDescSlug.Validators[0].(func(string) error)
+	// postrevisionDescBody is the schema descriptor for body field.
+	postrevisionDescBody := postrevisionFields[6].Descriptor()
+	// postrevision.DefaultBody holds the default value on creation for the body field.
+	postrevision.DefaultBody = postrevisionDescBody.Default.(string)
+	// postrevisionDescExcerpt is the schema descriptor for excerpt field.
+	postrevisionDescExcerpt := postrevisionFields[7].Descriptor()
+	// postrevision.DefaultExcerpt holds the default value on creation for the excerpt field.
+	postrevision.DefaultExcerpt = postrevisionDescExcerpt.Default.(string)
+	// postrevisionDescRestoredFrom is the schema descriptor for restored_from field.
+	postrevisionDescRestoredFrom := postrevisionFields[10].Descriptor()
+	// postrevision.DefaultRestoredFrom holds the default value on creation for the restored_from field.
+	postrevision.DefaultRestoredFrom = postrevisionDescRestoredFrom.Default.(int)
+	// postrevisionDescCreatedAt is the schema descriptor for created_at field.
+	postrevisionDescCreatedAt := postrevisionFields[11].Descriptor()
+	// postrevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	postrevision.DefaultCreatedAt = postrevisionDescCreatedAt.Default.(func() time.Time)
+	// postrevisionDescID is the schema descriptor for id field.
+	postrevisionDescID := postrevisionFields[0].Descriptor()
+	// postrevision.DefaultID holds the default value on creation for the id field.
+	postrevision.DefaultID = postrevisionDescID.Default.(func() uuid.UUID)
+	postslugFields := schema.PostSlug{}.Fields()
+	_ = postslugFields
+	// postslugDescSlug is the schema descriptor for slug field.
+	postslugDescSlug := postslugFields[1].Descriptor()
+	// postslug.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	postslug.SlugValidator = postslugDescSlug.Validators[0].(func(string) error)
+	// postslugDescCreatedAt is the schema descriptor for created_at field.
+	postslugDescCreatedAt := postslugFields[3].Descriptor()
+	// postslug.DefaultCreatedAt holds the default value on creation for the created_at field.
+	postslug.DefaultCreatedAt = postslugDescCreatedAt.Default.(func() time.Time)
+	// postslugDescID is the schema descriptor for id field.
+	postslugDescID := postslugFields[0].Descriptor()
+	// postslug.DefaultID holds the default value on creation for the id field.
+	postslug.DefaultID = postslugDescID.Default.(func() uuid.UUID)
+	redirectFields := schema.Redirect{}.Fields()
+	_ = redirectFields
+	// redirectDescPath is the schema descriptor for path field.
+	redirectDescPath := redirectFields[1].Descriptor()
+	// redirect.PathValidator is a validator for the "path" field. It is called by the builders before save.
+	redirect.PathValidator = redirectDescPath.Validators[0].(func(string) error)
+	// redirectDescTarget is the schema descriptor for target field.
+	redirectDescTarget := redirectFields[2].Descriptor()
+	// redirect.TargetValidator is a validator for the "target" field. It is called by the builders before save.
+	redirect.TargetValidator = redirectDescTarget.Validators[0].(func(string) error)
+	// redirectDescCode is the schema descriptor for code field.
+	redirectDescCode := redirectFields[3].Descriptor()
+	// redirect.DefaultCode holds the default value on creation for the code field.
+	redirect.DefaultCode = redirectDescCode.Default.(int)
+	// redirectDescCreatedAt is the schema descriptor for created_at field.
+	redirectDescCreatedAt := redirectFields[4].Descriptor()
+	// redirect.DefaultCreatedAt holds the default value on creation for the created_at field.
+	redirect.DefaultCreatedAt = redirectDescCreatedAt.Default.(func() time.Time)
+	// redirectDescID is the schema descriptor for id field.
+	redirectDescID := redirectFields[0].Descriptor()
+	// redirect.DefaultID holds the default value on creation for the id field.
+	redirect.DefaultID = redirectDescID.Default.(func() uuid.UUID)
+	resumableuploadFields := schema.ResumableUpload{}.Fields()
+	_ = resumableuploadFields
+	// resumableuploadDescOffset is the schema descriptor for offset field.
+	resumableuploadDescOffset := resumableuploadFields[3].Descriptor()
+	// resumableupload.DefaultOffset holds the default value on creation for the offset field.
+	resumableupload.DefaultOffset = resumableuploadDescOffset.Default.(int64)
+	// resumableuploadDescObjectName is the schema descriptor for object_name field.
+	resumableuploadDescObjectName := resumableuploadFields[5].Descriptor()
+	// resumableupload.ObjectNameValidator is a validator for the "object_name" field. It is called by the builders before save.
+	resumableupload.ObjectNameValidator = resumableuploadDescObjectName.Validators[0].(func(string) error)
+	// resumableuploadDescCreatedAt is the schema descriptor for created_at field.
+	resumableuploadDescCreatedAt := resumableuploadFields[10].Descriptor()
+	// resumableupload.DefaultCreatedAt holds the default value on creation for the created_at field.
+	resumableupload.DefaultCreatedAt = resumableuploadDescCreatedAt.Default.(func() time.Time)
+	// resumableuploadDescID is the schema descriptor for id field.
+	resumableuploadDescID := resumableuploadFields[0].Descriptor()
+	// resumableupload.DefaultID holds the default value on creation for the id field.
+	resumableupload.DefaultID = resumableuploadDescID.Default.(func() uuid.UUID)
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescSlug is the schema descriptor for slug field.
+	tagDescSlug := tagFields[1].Descriptor()
+	// tag.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	tag.SlugValidator = tagDescSlug.Validators[0].(func(string) error)
+	// tagDescName is the schema descriptor for name field.
+	tagDescName := tagFields[2].Descriptor()
+	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tag.NameValidator = tagDescName.Validators[0].(func(string) error)
+	// tagDescCreatedAt is the schema descriptor for created_at field.
+	tagDescCreatedAt := tagFields[3].Descriptor()
+	// tag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tag.DefaultCreatedAt = tagDescCreatedAt.Default.(func() time.Time)
+	// tagDescID is the schema descriptor for id field.
+	tagDescID := tagFields[0].Descriptor()
+	// tag.DefaultID holds the default value on creation for the id field.
+	tag.DefaultID = tagDescID.Default.(func() uuid.UUID)
+	uploadFields := schema.Upload{}.Fields()
+	_ = uploadFields
+	// uploadDescObjectName is the schema descriptor for object_name field.
+	uploadDescObjectName := uploadFields[3].Descriptor()
+	// upload.ObjectNameValidator is a validator for the "object_name" field. It is called by the builders before save.
+	upload.ObjectNameValidator = uploadDescObjectName.Validators[0].(func(string) error)
+	// uploadDescContentType is the schema descriptor for content_type field.
+	uploadDescContentType := uploadFields[4].Descriptor()
+	// upload.ContentTypeValidator is a validator for the "content_type" field. It is called by the builders before save.
+	upload.ContentTypeValidator = uploadDescContentType.Validators[0].(func(string) error)
+	// uploadDescChecksum is the schema descriptor for checksum field.
+	uploadDescChecksum := uploadFields[6].Descriptor()
+	// upload.ChecksumValidator is a validator for the "checksum" field. It is called by the builders before save.
+	upload.ChecksumValidator = uploadDescChecksum.Validators[0].(func(string) error)
+	// uploadDescCreatedAt is the schema descriptor for created_at field.
+	uploadDescCreatedAt := uploadFields[8].Descriptor()
+	// upload.DefaultCreatedAt holds the default value on creation for the created_at field.
+	upload.DefaultCreatedAt = uploadDescCreatedAt.Default.(func() time.Time)
+	// uploadDescID is the schema descriptor for id field.
+	uploadDescID := uploadFields[0].Descriptor()
+	// upload.DefaultID holds the default value on creation for the id field.
+	upload.DefaultID = uploadDescID.Default.(func() uuid.UUID)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescEmail is the schema descriptor for email field.
@@ -28,8 +372,20 @@ func init() {
 	userDescRole := userFields[3].Descriptor()
 	// user.RoleValidator is a validator for the "role" field. It is called by the builders before save.
 	user.RoleValidator = userDescRole.Validators[0].(func(string) error)
+	// userDescMaxFileSize is the schema descriptor for max_file_size field.
+	userDescMaxFileSize := userFields[4].Descriptor()
+	// user.DefaultMaxFileSize holds the default value on creation for the max_file_size field.
+	user.DefaultMaxFileSize = userDescMaxFileSize.Default.(int64)
+	// userDescMaxTotalBytes is the schema descriptor for max_total_bytes field.
+	userDescMaxTotalBytes := userFields[5].Descriptor()
+	// user.DefaultMaxTotalBytes holds the default value on creation for the max_total_bytes field.
+	user.DefaultMaxTotalBytes = userDescMaxTotalBytes.Default.(int64)
+	// userDescMaxFiles is the schema descriptor for max_files field.
+	userDescMaxFiles := userFields[6].Descriptor()
+	// user.DefaultMaxFiles holds the default value on creation for the max_files field.
+	user.DefaultMaxFiles = userDescMaxFiles.Default.(int)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[4].Descriptor()
+	userDescCreatedAt := userFields[8].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescID is the schema descriptor for id field.
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Album holds the schema definition for the Album entity,
+// an ordered group of images.
+type Album struct {
+	ent.Schema
+}
+
+// Fields of the Album.
+func (Album) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("owner_id", uuid.UUID{}),
+		field.String("slug").
+			Unique().
+			NotEmpty(),
+		field.String("title").
+			NotEmpty(),
+		field.Text("description").
+			Default(""),
+		field.String("visibility").
+			Default("public"),
+		field.UUID("cover_image_id", uuid.UUID{}).
+			Optional(),
+		// Kept as one ordered list, albums are always read and reordered as a whole
+		field.JSON("image_ids", []uuid.UUID{}).
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the Album.
+func (Album) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Album.
+func (Album) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_id"),
+	}
+}
@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Blob holds the schema definition for the Blob entity,
+// a stored file referenced by one or more images.
+type Blob struct {
+	ent.Schema
+}
+
+// Fields of the Blob.
+func (Blob) Fields() []ent.Field {
+	return []ent.Field{
+		// The object name in storage
+		field.String("id").
+			NotEmpty().
+			Immutable(),
+		field.String("checksum").
+			NotEmpty(),
+		field.String("content_type").
+			NotEmpty(),
+		field.Int64("size"),
+		field.String("url"),
+		field.Int("ref_count").
+			NonNegative(),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the Blob.
+func (Blob) Edges() []ent.Edge {
+	return nil
+}
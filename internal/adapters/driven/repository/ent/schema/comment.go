@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Comment holds the schema definition for the Comment entity,
+// a reader's comment on a post or a reply to another comment.
+type Comment struct {
+	ent.Schema
+}
+
+// Fields of the Comment.
+func (Comment) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("post_id", uuid.UUID{}),
+		// Replies keep pointing at their parent whatever its status, nil for comments on the post
+		field.UUID("parent_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		// Cleared when the author is deleted, and nil for anonymous comments
+		field.UUID("author_id", uuid.UUID{}).
+			Optional(),
+		field.String("author_name").
+			NotEmpty(),
+		field.String("author_email").
+			Default(""),
+		field.Text("body").
+			NotEmpty(),
+		field.String("status").
+			Default("pending"),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the Comment.
+func (Comment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("post", Post.Type).
+			Ref("comments").
+			Field("post_id").
+			Unique().
+			Required(),
+		edge.From("author", User.Type).
+			Ref("comments").
+			Field("author_id").
+			Unique(),
+	}
+}
+
+// Indexes of the Comment.
+func (Comment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("post_id", "created_at"),
+		index.Fields("status", "created_at"),
+	}
+}
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ContactMessage holds the schema definition for the ContactMessage entity,
+// a submission of the contact form.
+type ContactMessage struct {
+	ent.Schema
+}
+
+// Fields of the ContactMessage.
+func (ContactMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.String("name").
+			NotEmpty(),
+		field.String("email").
+			NotEmpty(),
+		field.String("subject").
+			Default(""),
+		field.Text("message").
+			NotEmpty(),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Indexes of the ContactMessage.
+func (ContactMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Image holds the schema definition for the Image entity.
+type Image struct {
+	ent.Schema
+}
+
+// Fields of the Image.
+func (Image) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("owner_id", uuid.UUID{}).
+			Optional(),
+		field.String("visibility").
+			Default("public"),
+		field.String("original_name"),
+		// Not unique, images with identical content share the stored file
+		field.String("stored_name").
+			NotEmpty(),
+		field.String("content_type").
+			NotEmpty(),
+		field.Int64("size"),
+		field.String("checksum").
+			Optional(),
+		field.String("url"),
+		field.Int("width").
+			Default(0),
+		field.Int("height").
+			Default(0),
+		field.String("blur_hash").
+			Default(""),
+		field.String("dominant_color").
+			Default(""),
+		// Alternative text by BCP 47 locale
+		field.JSON("alt", map[string]string{}).
+			Optional(),
+		field.String("caption").
+			Default(""),
+		field.String("credit").
+			Default(""),
+		field.String("license").
+			Default(""),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the Image.
+func (Image) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tags", Tag.Type).
+			Ref("images"),
+	}
+}
@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Post holds the schema definition for the Post entity,
+// a Markdown article of the site.
+type Post struct {
+	ent.Schema
+}
+
+// Fields of the Post.
+func (Post) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		// Cleared when the author is deleted, posts outlive their author
+		field.UUID("author_id", uuid.UUID{}).
+			Optional(),
+		field.String("slug").
+			Unique().
+			NotEmpty(),
+		field.String("title").
+			NotEmpty(),
+		field.Text("body").
+			Default(""),
+		// Sanitized rendering of the body, stored so reads never render
+		field.Text("html").
+			Default(""),
+		// Plain text of the body, indexed for search
+		field.Text("text").
+			Default(""),
+		field.Text("excerpt").
+			Default(""),
+		field.Text("summary").
+			Default(""),
+		field.String("status").
+			Default("draft"),
+		// Set while scheduled, the scheduler publishes the post once it has passed
+		field.Time("publish_at").
+			Optional().
+			Nillable(),
+		field.Time("published_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the Post.
+func (Post) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("author", User.Type).
+			Ref("posts").
+			Field("author_id").
+			Unique(),
+		edge.From("tags", Tag.Type).
+			Ref("posts"),
+		edge.To("comments", Comment.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("revisions", PostRevision.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("old_slugs", PostSlug.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Post.
+func (Post) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "published_at"),
+		index.Fields("status", "publish_at"),
+	}
+}
@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// PostRevision holds the schema definition for the PostRevision entity,
+// the content of a post as saved by one edit. Rows are only ever inserted.
+type PostRevision struct {
+	ent.Schema
+}
+
+// Fields of the PostRevision.
+func (PostRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("post_id", uuid.UUID{}),
+		field.Int("number").
+			Positive(),
+		// Cleared when the editor is deleted, and nil for revisions recorded without one
+		field.UUID("editor_id", uuid.UUID{}).
+			Optional(),
+		field.String("title").
+			NotEmpty(),
+		field.String("slug").
+			NotEmpty(),
+		field.Text("body").
+			Default(""),
+		field.Text("excerpt").
+			Default(""),
+		field.Strings("tags").
+			Optional(),
+		field.String("status"),
+		field.Int("restored_from").
+			Default(0),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the PostRevision.
+func (PostRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("post", Post.Type).
+			Ref("revisions").
+			Field("post_id").
+			Unique().
+			Required(),
+		edge.From("editor", User.Type).
+			Ref("revisions").
+			Field("editor_id").
+			Unique(),
+	}
+}
+
+// Indexes of the PostRevision.
+func (PostRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("post_id", "number").
+			Unique(),
+	}
+}
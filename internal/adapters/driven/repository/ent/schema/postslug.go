@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PostSlug holds the schema definition for the PostSlug entity,
+// a slug a post was reachable at before it was renamed.
+type PostSlug struct {
+	ent.Schema
+}
+
+// Fields of the PostSlug.
+func (PostSlug) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		// A slug leads to the last post that gave it up
+		field.String("slug").
+			Unique().
+			NotEmpty(),
+		field.UUID("post_id", uuid.UUID{}),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the PostSlug.
+func (PostSlug) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("post", Post.Type).
+			Ref("old_slugs").
+			Field("post_id").
+			Unique().
+			Required(),
+	}
+}
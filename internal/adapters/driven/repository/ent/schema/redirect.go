@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Redirect holds the schema definition for the Redirect entity,
+// a legacy path of the site sent elsewhere.
+type Redirect struct {
+	ent.Schema
+}
+
+// Fields of the Redirect.
+func (Redirect) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		// Path of the site that is redirected, FROM and TO are reserved words in SQL
+		field.String("path").
+			Unique().
+			NotEmpty(),
+		field.String("target").
+			NotEmpty(),
+		field.Int("code").
+			Default(301),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
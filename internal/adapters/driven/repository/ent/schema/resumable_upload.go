@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// UploadPart is a multipart part already written to storage.
+type UploadPart struct {
+	Number int    `json:"number"`
+	ETag   string `json:"etag"`
+	Size   int64  `json:"size"`
+}
+
+// ResumableUpload holds the schema definition for the ResumableUpload entity,
+// a tus upload whose chunks are assembled into a storage multipart upload.
+type ResumableUpload struct {
+	ent.Schema
+}
+
+// Fields of the ResumableUpload.
+func (ResumableUpload) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("owner_id", uuid.UUID{}),
+		field.Int64("length"),
+		field.Int64("offset").
+			Default(0),
+		field.JSON("metadata", map[string]string{}).
+			Optional(),
+		field.String("object_name").
+			Unique().
+			NotEmpty(),
+		field.String("multipart_id").
+			Optional(),
+		field.JSON("parts", []UploadPart{}).
+			Optional(),
+		field.UUID("image_id", uuid.UUID{}).
+			Optional(),
+		field.Time("expires_at"),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the ResumableUpload.
+func (ResumableUpload) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Upload holds the schema definition for the Upload entity,
+// a direct-to-storage upload that has been presigned but not completed yet.
+type Upload struct {
+	ent.Schema
+}
+
+// Fields of the Upload.
+func (Upload) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("owner_id", uuid.UUID{}),
+		field.String("original_name"),
+		field.String("object_name").
+			Unique().
+			NotEmpty(),
+		field.String("content_type").
+			NotEmpty(),
+		field.Int64("size"),
+		field.String("checksum").
+			NotEmpty(),
+		field.Time("expires_at"),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the Upload.
+func (Upload) Edges() []ent.Edge {
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 )
@@ -25,6 +27,15 @@ func (User) Fields() []ent.Field {
 			NotEmpty(),
 		field.String("role").
 			NotEmpty(),
+		// Per user overrides of the role upload limits, zero inherits
+		field.Int64("max_file_size").
+			Default(0),
+		field.Int64("max_total_bytes").
+			Default(0),
+		field.Int("max_files").
+			Default(0),
+		field.Strings("allowed_types").
+			Optional(),
 		field.Time("created_at").
 			Default(time.Now),
 	}
@@ -32,5 +43,12 @@ func (User) Fields() []ent.Field {
 
 // Edges of the User.
 func (User) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To("posts", Post.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("comments", Comment.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("revisions", PostRevision.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+	}
 }
@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -12,6 +14,30 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Album is the client for interacting with the Album builders.
+	Album *AlbumClient
+	// Blob is the client for interacting with the Blob builders.
+	Blob *BlobClient
+	// Comment is the client for interacting with the Comment builders.
+	Comment *CommentClient
+	// ContactMessage is the client for interacting with the ContactMessage builders.
+	ContactMessage *ContactMessageClient
+	// Image is the client for interacting with the Image builders.
+	Image *ImageClient
+	// Post is the client for interacting with the Post builders.
+	Post *PostClient
+	// PostRevision is the client for interacting with the PostRevision builders.
+	PostRevision *PostRevisionClient
+	// PostSlug is the client for interacting with the PostSlug builders.
+	PostSlug *PostSlugClient
+	// Redirect is the client for interacting with the Redirect builders.
+	Redirect *RedirectClient
+	// ResumableUpload is the client for interacting with the ResumableUpload builders.
+	ResumableUpload *ResumableUploadClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
+	// Upload is the client for interacting with the Upload builders.
+	Upload *UploadClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -145,6 +171,18 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Album = NewAlbumClient(tx.config)
+	tx.Blob = NewBlobClient(tx.config)
+	tx.Comment = NewCommentClient(tx.config)
+	tx.ContactMessage = NewContactMessageClient(tx.config)
+	tx.Image = NewImageClient(tx.config)
+	tx.Post = NewPostClient(tx.config)
+	tx.PostRevision = NewPostRevisionClient(tx.config)
+	tx.PostSlug = NewPostSlugClient(tx.config)
+	tx.Redirect = NewRedirectClient(tx.config)
+	tx.ResumableUpload = NewResumableUploadClient(tx.config)
+	tx.Tag = NewTagClient(tx.config)
+	tx.Upload = NewUploadClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -155,7 +193,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: User.QueryXXX(), the query will be executed
+// applies a query, for example: Album.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -208,3 +246,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
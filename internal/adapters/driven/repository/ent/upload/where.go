@@ -0,0 +1,531 @@
+// Code generated by ent, DO NOT EDIT.
+
+package upload
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Upload {
+	return predicate.Upload(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Upload {
+	return predicate.Upload(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Upload {
+	return predicate.Upload(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Upload {
+	return predicate.Upload(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Upload {
+	return predicate.Upload(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Upload {
+	return predicate.Upload(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Upload {
+	return predicate.Upload(sql.FieldLTE(FieldID, id))
+}
+
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v uuid.UUID) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OriginalName applies equality check predicate on the "original_name" field. It's identical to OriginalNameEQ.
+func OriginalName(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldOriginalName, v))
+}
+
+// ObjectName applies equality check predicate on the "object_name" field. It's identical to ObjectNameEQ.
+func ObjectName(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldObjectName, v))
+}
+
+// ContentType applies equality check predicate on the "content_type" field. It's identical to ContentTypeEQ.
+func ContentType(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldContentType, v))
+}
+
+// Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
+func Size(v int64) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldSize, v))
+}
+
+// Checksum applies equality check predicate on the "checksum" field. It's identical to ChecksumEQ.
+func Checksum(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldChecksum, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v uuid.UUID) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v uuid.UUID) predicate.Upload {
+	return predicate.Upload(sql.FieldNEQ(FieldOwnerID, v))
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...uuid.UUID) predicate.Upload {
+	return predicate.Upload(sql.FieldIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...uuid.UUID) predicate.Upload {
+	return predicate.Upload(sql.FieldNotIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDGT applies the GT predicate on the "owner_id" field.
+func OwnerIDGT(v uuid.UUID) predicate.Upload {
+	return predicate.Upload(sql.FieldGT(FieldOwnerID, v))
+}
+
+// OwnerIDGTE applies the GTE predicate on the "owner_id" field.
+func OwnerIDGTE(v uuid.UUID) predicate.Upload {
+	return predicate.Upload(sql.FieldGTE(FieldOwnerID, v))
+}
+
+// OwnerIDLT applies the LT predicate on the "owner_id" field.
+func OwnerIDLT(v uuid.UUID) predicate.Upload {
+	return predicate.Upload(sql.FieldLT(FieldOwnerID, v))
+}
+
+// OwnerIDLTE applies the LTE predicate on the "owner_id" field.
+func OwnerIDLTE(v uuid.UUID) predicate.Upload {
+	return predicate.Upload(sql.FieldLTE(FieldOwnerID, v))
+}
+
+// OriginalNameEQ applies the EQ predicate on the "original_name" field.
+func OriginalNameEQ(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldOriginalName, v))
+}
+
+// OriginalNameNEQ applies the NEQ predicate on the "original_name" field.
+func OriginalNameNEQ(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldNEQ(FieldOriginalName, v))
+}
+
+// OriginalNameIn applies the In predicate on the "original_name" field.
+func OriginalNameIn(vs ...string) predicate.Upload {
+	return predicate.Upload(sql.FieldIn(FieldOriginalName, vs...))
+}
+
+// OriginalNameNotIn applies the NotIn predicate on the "original_name" field.
+func OriginalNameNotIn(vs ...string) predicate.Upload {
+	return predicate.Upload(sql.FieldNotIn(FieldOriginalName, vs...))
+}
+
+// OriginalNameGT applies the GT predicate on the "original_name" field.
+func OriginalNameGT(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldGT(FieldOriginalName, v))
+}
+
+// OriginalNameGTE applies the GTE predicate on the "original_name" field.
+func OriginalNameGTE(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldGTE(FieldOriginalName, v))
+}
+
+// OriginalNameLT applies the LT predicate on the "original_name" field.
+func OriginalNameLT(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldLT(FieldOriginalName, v))
+}
+
+// OriginalNameLTE applies the LTE predicate on the "original_name" field.
+func OriginalNameLTE(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldLTE(FieldOriginalName, v))
+}
+
+// OriginalNameContains applies the Contains predicate on the "original_name" field.
+func OriginalNameContains(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldContains(FieldOriginalName, v))
+}
+
+// OriginalNameHasPrefix applies the HasPrefix predicate on the "original_name" field.
+func OriginalNameHasPrefix(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldHasPrefix(FieldOriginalName, v))
+}
+
+// OriginalNameHasSuffix applies the HasSuffix predicate on the "original_name" field.
+func OriginalNameHasSuffix(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldHasSuffix(FieldOriginalName, v))
+}
+
+// OriginalNameEqualFold applies the EqualFold predicate on the "original_name" field.
+func OriginalNameEqualFold(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEqualFold(FieldOriginalName, v))
+}
+
+// OriginalNameContainsFold applies the ContainsFold predicate on the "original_name" field.
+func OriginalNameContainsFold(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldContainsFold(FieldOriginalName, v))
+}
+
+// ObjectNameEQ applies the EQ predicate on the "object_name" field.
+func ObjectNameEQ(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldObjectName, v))
+}
+
+// ObjectNameNEQ applies the NEQ predicate on the "object_name" field.
+func ObjectNameNEQ(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldNEQ(FieldObjectName, v))
+}
+
+// ObjectNameIn applies the In predicate on the "object_name" field.
+func ObjectNameIn(vs ...string) predicate.Upload {
+	return predicate.Upload(sql.FieldIn(FieldObjectName, vs...))
+}
+
+// ObjectNameNotIn applies the NotIn predicate on the "object_name" field.
+func ObjectNameNotIn(vs ...string) predicate.Upload {
+	return predicate.Upload(sql.FieldNotIn(FieldObjectName, vs...))
+}
+
+// ObjectNameGT applies the GT predicate on the "object_name" field.
+func ObjectNameGT(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldGT(FieldObjectName, v))
+}
+
+// ObjectNameGTE applies the GTE predicate on the "object_name" field.
+func ObjectNameGTE(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldGTE(FieldObjectName, v))
+}
+
+// ObjectNameLT applies the LT predicate on the "object_name" field.
+func ObjectNameLT(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldLT(FieldObjectName, v))
+}
+
+// ObjectNameLTE applies the LTE predicate on the "object_name" field.
+func ObjectNameLTE(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldLTE(FieldObjectName, v))
+}
+
+// ObjectNameContains applies the Contains predicate on the "object_name" field.
+func ObjectNameContains(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldContains(FieldObjectName, v))
+}
+
+// ObjectNameHasPrefix applies the HasPrefix predicate on the "object_name" field.
+func ObjectNameHasPrefix(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldHasPrefix(FieldObjectName, v))
+}
+
+// ObjectNameHasSuffix applies the HasSuffix predicate on the "object_name" field.
+func ObjectNameHasSuffix(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldHasSuffix(FieldObjectName, v))
+}
+
+// ObjectNameEqualFold applies the EqualFold predicate on the "object_name" field.
+func ObjectNameEqualFold(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEqualFold(FieldObjectName, v))
+}
+
+// ObjectNameContainsFold applies the ContainsFold predicate on the "object_name" field.
+func ObjectNameContainsFold(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldContainsFold(FieldObjectName, v))
+}
+
+// ContentTypeEQ applies the EQ predicate on the "content_type" field.
+func ContentTypeEQ(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldContentType, v))
+}
+
+// ContentTypeNEQ applies the NEQ predicate on the "content_type" field.
+func ContentTypeNEQ(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldNEQ(FieldContentType, v))
+}
+
+// ContentTypeIn applies the In predicate on the "content_type" field.
+func ContentTypeIn(vs ...string) predicate.Upload {
+	return predicate.Upload(sql.FieldIn(FieldContentType, vs...))
+}
+
+// ContentTypeNotIn applies the NotIn predicate on the "content_type" field.
+func ContentTypeNotIn(vs ...string) predicate.Upload {
+	return predicate.Upload(sql.FieldNotIn(FieldContentType, vs...))
+}
+
+// ContentTypeGT applies the GT predicate on the "content_type" field.
+func ContentTypeGT(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldGT(FieldContentType, v))
+}
+
+// ContentTypeGTE applies the GTE predicate on the "content_type" field.
+func ContentTypeGTE(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldGTE(FieldContentType, v))
+}
+
+// ContentTypeLT applies the LT predicate on the "content_type" field.
+func ContentTypeLT(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldLT(FieldContentType, v))
+}
+
+// ContentTypeLTE applies the LTE predicate on the "content_type" field.
+func ContentTypeLTE(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldLTE(FieldContentType, v))
+}
+
+// ContentTypeContains applies the Contains predicate on the "content_type" field.
+func ContentTypeContains(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldContains(FieldContentType, v))
+}
+
+// ContentTypeHasPrefix applies the HasPrefix predicate on the "content_type" field.
+func ContentTypeHasPrefix(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldHasPrefix(FieldContentType, v))
+}
+
+// ContentTypeHasSuffix applies the HasSuffix predicate on the "content_type" field.
+func ContentTypeHasSuffix(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldHasSuffix(FieldContentType, v))
+}
+
+// ContentTypeEqualFold applies the EqualFold predicate on the "content_type" field.
+func ContentTypeEqualFold(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEqualFold(FieldContentType, v))
+}
+
+// ContentTypeContainsFold applies the ContainsFold predicate on the "content_type" field.
+func ContentTypeContainsFold(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldContainsFold(FieldContentType, v))
+}
+
+// SizeEQ applies the EQ predicate on the "size" field.
+func SizeEQ(v int64) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldSize, v))
+}
+
+// SizeNEQ applies the NEQ predicate on the "size" field.
+func SizeNEQ(v int64) predicate.Upload {
+	return predicate.Upload(sql.FieldNEQ(FieldSize, v))
+}
+
+// SizeIn applies the In predicate on the "size" field.
+func SizeIn(vs ...int64) predicate.Upload {
+	return predicate.Upload(sql.FieldIn(FieldSize, vs...))
+}
+
+// SizeNotIn applies the NotIn predicate on the "size" field.
+func SizeNotIn(vs ...int64) predicate.Upload {
+	return predicate.Upload(sql.FieldNotIn(FieldSize, vs...))
+}
+
+// SizeGT applies the GT predicate on the "size" field.
+func SizeGT(v int64) predicate.Upload {
+	return predicate.Upload(sql.FieldGT(FieldSize, v))
+}
+
+// SizeGTE applies the GTE predicate on the "size" field.
+func SizeGTE(v int64) predicate.Upload {
+	return predicate.Upload(sql.FieldGTE(FieldSize, v))
+}
+
+// SizeLT applies the LT predicate on the "size" field.
+func SizeLT(v int64) predicate.Upload {
+	return predicate.Upload(sql.FieldLT(FieldSize, v))
+}
+
+// SizeLTE applies the LTE predicate on the "size" field.
+func SizeLTE(v int64) predicate.Upload {
+	return predicate.Upload(sql.FieldLTE(FieldSize, v))
+}
+
+// ChecksumEQ applies the EQ predicate on the "checksum" field.
+func ChecksumEQ(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldChecksum, v))
+}
+
+// ChecksumNEQ applies the NEQ predicate on the "checksum" field.
+func ChecksumNEQ(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldNEQ(FieldChecksum, v))
+}
+
+// ChecksumIn applies the In predicate on the "checksum" field.
+func ChecksumIn(vs ...string) predicate.Upload {
+	return predicate.Upload(sql.FieldIn(FieldChecksum, vs...))
+}
+
+// ChecksumNotIn applies the NotIn predicate on the "checksum" field.
+func ChecksumNotIn(vs ...string) predicate.Upload {
+	return predicate.Upload(sql.FieldNotIn(FieldChecksum, vs...))
+}
+
+// ChecksumGT applies the GT predicate on the "checksum" field.
+func ChecksumGT(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldGT(FieldChecksum, v))
+}
+
+// ChecksumGTE applies the GTE predicate on the "checksum" field.
+func ChecksumGTE(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldGTE(FieldChecksum, v))
+}
+
+// ChecksumLT applies the LT predicate on the "checksum" field.
+func ChecksumLT(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldLT(FieldChecksum, v))
+}
+
+// ChecksumLTE applies the LTE predicate on the "checksum" field.
+func ChecksumLTE(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldLTE(FieldChecksum, v))
+}
+
+// ChecksumContains applies the Contains predicate on the "checksum" field.
+func ChecksumContains(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldContains(FieldChecksum, v))
+}
+
+// ChecksumHasPrefix applies the HasPrefix predicate on the "checksum" field.
+func ChecksumHasPrefix(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldHasPrefix(FieldChecksum, v))
+}
+
+// ChecksumHasSuffix applies the HasSuffix predicate on the "checksum" field.
+func ChecksumHasSuffix(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldHasSuffix(FieldChecksum, v))
+}
+
+// ChecksumEqualFold applies the EqualFold predicate on the "checksum" field.
+func ChecksumEqualFold(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldEqualFold(FieldChecksum, v))
+}
+
+// ChecksumContainsFold applies the ContainsFold predicate on the "checksum" field.
+func ChecksumContainsFold(v string) predicate.Upload {
+	return predicate.Upload(sql.FieldContainsFold(FieldChecksum, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Upload {
+	return predicate.Upload(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Upload) predicate.Upload {
+	return predicate.Upload(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Upload) predicate.Upload {
+	return predicate.Upload(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Upload) predicate.Upload {
+	return predicate.Upload(sql.NotPredicates(p))
+}
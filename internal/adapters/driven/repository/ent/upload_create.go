@@ -0,0 +1,332 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/upload"
+)
+
+// UploadCreate is the builder for creating a Upload entity.
+type UploadCreate struct {
+	config
+	mutation *UploadMutation
+	hooks    []Hook
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_c *UploadCreate) SetOwnerID(v uuid.UUID) *UploadCreate {
+	_c.mutation.SetOwnerID(v)
+	return _c
+}
+
+// SetOriginalName sets the "original_name" field.
+func (_c *UploadCreate) SetOriginalName(v string) *UploadCreate {
+	_c.mutation.SetOriginalName(v)
+	return _c
+}
+
+// SetObjectName sets the "object_name" field.
+func (_c *UploadCreate) SetObjectName(v string) *UploadCreate {
+	_c.mutation.SetObjectName(v)
+	return _c
+}
+
+// SetContentType sets the "content_type" field.
+func (_c *UploadCreate) SetContentType(v string) *UploadCreate {
+	_c.mutation.SetContentType(v)
+	return _c
+}
+
+// SetSize sets the "size" field.
+func (_c *UploadCreate) SetSize(v int64) *UploadCreate {
+	_c.mutation.SetSize(v)
+	return _c
+}
+
+// SetChecksum sets the "checksum" field.
+func (_c *UploadCreate) SetChecksum(v string) *UploadCreate {
+	_c.mutation.SetChecksum(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *UploadCreate) SetExpiresAt(v time.Time) *UploadCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *UploadCreate) SetCreatedAt(v time.Time) *UploadCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *UploadCreate) SetNillableCreatedAt(v *time.Time) *UploadCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *UploadCreate) SetID(v uuid.UUID) *UploadCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *UploadCreate) SetNillableID(v *uuid.UUID) *UploadCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the UploadMutation object of the builder.
+func (_c *UploadCreate) Mutation() *UploadMutation {
+	return _c.mutation
+}
+
+// Save creates the Upload in the database.
+func (_c *UploadCreate) Save(ctx context.Context) (*Upload, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UploadCreate) SaveX(ctx context.Context) *Upload {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UploadCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UploadCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UploadCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := upload.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := upload.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UploadCreate) check() error {
+	if _, ok := _c.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner_id", err: errors.New(`ent: missing required field "Upload.owner_id"`)}
+	}
+	if _, ok := _c.mutation.OriginalName(); !ok {
+		return &ValidationError{Name: "original_name", err: errors.New(`ent: missing required field "Upload.original_name"`)}
+	}
+	if _, ok := _c.mutation.ObjectName(); !ok {
+		return &ValidationError{Name: "object_name", err: errors.New(`ent: missing required field "Upload.object_name"`)}
+	}
+	if v, ok := _c.mutation.ObjectName(); ok {
+		if err := upload.ObjectNameValidator(v); err != nil {
+			return &ValidationError{Name: "object_name", err: fmt.Errorf(`ent: validator failed for field "Upload.object_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ContentType(); !ok {
+		return &ValidationError{Name: "content_type", err: errors.New(`ent: missing required field "Upload.content_type"`)}
+	}
+	if v, ok := _c.mutation.ContentType(); ok {
+		if err := upload.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`ent: validator failed for field "Upload.content_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "Upload.size"`)}
+	}
+	if _, ok := _c.mutation.Checksum(); !ok {
+		return &ValidationError{Name: "checksum", err: errors.New(`ent: missing required field "Upload.checksum"`)}
+	}
+	if v, ok := _c.mutation.Checksum(); ok {
+		if err := upload.ChecksumValidator(v); err != nil {
+			return &ValidationError{Name: "checksum", err: fmt.Errorf(`ent: validator failed for field "Upload.checksum": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "Upload.expires_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Upload.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *UploadCreate) sqlSave(ctx context.Context) (*Upload, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UploadCreate) createSpec() (*Upload, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Upload{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(upload.Table, sqlgraph.NewFieldSpec(upload.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.OwnerID(); ok {
+		_spec.SetField(upload.FieldOwnerID, field.TypeUUID, value)
+		_node.OwnerID = value
+	}
+	if value, ok := _c.mutation.OriginalName(); ok {
+		_spec.SetField(upload.FieldOriginalName, field.TypeString, value)
+		_node.OriginalName = value
+	}
+	if value, ok := _c.mutation.ObjectName(); ok {
+		_spec.SetField(upload.FieldObjectName, field.TypeString, value)
+		_node.ObjectName = value
+	}
+	if value, ok := _c.mutation.ContentType(); ok {
+		_spec.SetField(upload.FieldContentType, field.TypeString, value)
+		_node.ContentType = value
+	}
+	if value, ok := _c.mutation.Size(); ok {
+		_spec.SetField(upload.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := _c.mutation.Checksum(); ok {
+		_spec.SetField(upload.FieldChecksum, field.TypeString, value)
+		_node.Checksum = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(upload.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(upload.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// UploadCreateBulk is the builder for creating many Upload entities in bulk.
+type UploadCreateBulk struct {
+	config
+	err      error
+	builders []*UploadCreate
+}
+
+// Save creates the Upload entities in the database.
+func (_c *UploadCreateBulk) Save(ctx context.Context) ([]*Upload, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Upload, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UploadMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UploadCreateBulk) SaveX(ctx context.Context) []*Upload {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UploadCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UploadCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
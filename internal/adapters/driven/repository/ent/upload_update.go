@@ -0,0 +1,515 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/predicate"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/upload"
+)
+
+// UploadUpdate is the builder for updating Upload entities.
+type UploadUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UploadMutation
+}
+
+// Where appends a list predicates to the UploadUpdate builder.
+func (_u *UploadUpdate) Where(ps ...predicate.Upload) *UploadUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *UploadUpdate) SetOwnerID(v uuid.UUID) *UploadUpdate {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *UploadUpdate) SetNillableOwnerID(v *uuid.UUID) *UploadUpdate {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// SetOriginalName sets the "original_name" field.
+func (_u *UploadUpdate) SetOriginalName(v string) *UploadUpdate {
+	_u.mutation.SetOriginalName(v)
+	return _u
+}
+
+// SetNillableOriginalName sets the "original_name" field if the given value is not nil.
+func (_u *UploadUpdate) SetNillableOriginalName(v *string) *UploadUpdate {
+	if v != nil {
+		_u.SetOriginalName(*v)
+	}
+	return _u
+}
+
+// SetObjectName sets the "object_name" field.
+func (_u *UploadUpdate) SetObjectName(v string) *UploadUpdate {
+	_u.mutation.SetObjectName(v)
+	return _u
+}
+
+// SetNillableObjectName sets the "object_name" field if the given value is not nil.
+func (_u *UploadUpdate) SetNillableObjectName(v *string) *UploadUpdate {
+	if v != nil {
+		_u.SetObjectName(*v)
+	}
+	return _u
+}
+
+// SetContentType sets the "content_type" field.
+func (_u *UploadUpdate) SetContentType(v string) *UploadUpdate {
+	_u.mutation.SetContentType(v)
+	return _u
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (_u *UploadUpdate) SetNillableContentType(v *string) *UploadUpdate {
+	if v != nil {
+		_u.SetContentType(*v)
+	}
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *UploadUpdate) SetSize(v int64) *UploadUpdate {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *UploadUpdate) SetNillableSize(v *int64) *UploadUpdate {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *UploadUpdate) AddSize(v int64) *UploadUpdate {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetChecksum sets the "checksum" field.
+func (_u *UploadUpdate) SetChecksum(v string) *UploadUpdate {
+	_u.mutation.SetChecksum(v)
+	return _u
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (_u *UploadUpdate) SetNillableChecksum(v *string) *UploadUpdate {
+	if v != nil {
+		_u.SetChecksum(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *UploadUpdate) SetExpiresAt(v time.Time) *UploadUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *UploadUpdate) SetNillableExpiresAt(v *time.Time) *UploadUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *UploadUpdate) SetCreatedAt(v time.Time) *UploadUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *UploadUpdate) SetNillableCreatedAt(v *time.Time) *UploadUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the UploadMutation object of the builder.
+func (_u *UploadUpdate) Mutation() *UploadMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UploadUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UploadUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UploadUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UploadUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UploadUpdate) check() error {
+	if v, ok := _u.mutation.ObjectName(); ok {
+		if err := upload.ObjectNameValidator(v); err != nil {
+			return &ValidationError{Name: "object_name", err: fmt.Errorf(`ent: validator failed for field "Upload.object_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ContentType(); ok {
+		if err := upload.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`ent: validator failed for field "Upload.content_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Checksum(); ok {
+		if err := upload.ChecksumValidator(v); err != nil {
+			return &ValidationError{Name: "checksum", err: fmt.Errorf(`ent: validator failed for field "Upload.checksum": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UploadUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(upload.Table, upload.Columns, sqlgraph.NewFieldSpec(upload.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(upload.FieldOwnerID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.OriginalName(); ok {
+		_spec.SetField(upload.FieldOriginalName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ObjectName(); ok {
+		_spec.SetField(upload.FieldObjectName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ContentType(); ok {
+		_spec.SetField(upload.FieldContentType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(upload.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(upload.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Checksum(); ok {
+		_spec.SetField(upload.FieldChecksum, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(upload.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(upload.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{upload.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UploadUpdateOne is the builder for updating a single Upload entity.
+type UploadUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UploadMutation
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *UploadUpdateOne) SetOwnerID(v uuid.UUID) *UploadUpdateOne {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *UploadUpdateOne) SetNillableOwnerID(v *uuid.UUID) *UploadUpdateOne {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// SetOriginalName sets the "original_name" field.
+func (_u *UploadUpdateOne) SetOriginalName(v string) *UploadUpdateOne {
+	_u.mutation.SetOriginalName(v)
+	return _u
+}
+
+// SetNillableOriginalName sets the "original_name" field if the given value is not nil.
+func (_u *UploadUpdateOne) SetNillableOriginalName(v *string) *UploadUpdateOne {
+	if v != nil {
+		_u.SetOriginalName(*v)
+	}
+	return _u
+}
+
+// SetObjectName sets the "object_name" field.
+func (_u *UploadUpdateOne) SetObjectName(v string) *UploadUpdateOne {
+	_u.mutation.SetObjectName(v)
+	return _u
+}
+
+// SetNillableObjectName sets the "object_name" field if the given value is not nil.
+func (_u *UploadUpdateOne) SetNillableObjectName(v *string) *UploadUpdateOne {
+	if v != nil {
+		_u.SetObjectName(*v)
+	}
+	return _u
+}
+
+// SetContentType sets the "content_type" field.
+func (_u *UploadUpdateOne) SetContentType(v string) *UploadUpdateOne {
+	_u.mutation.SetContentType(v)
+	return _u
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (_u *UploadUpdateOne) SetNillableContentType(v *string) *UploadUpdateOne {
+	if v != nil {
+		_u.SetContentType(*v)
+	}
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *UploadUpdateOne) SetSize(v int64) *UploadUpdateOne {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *UploadUpdateOne) SetNillableSize(v *int64) *UploadUpdateOne {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *UploadUpdateOne) AddSize(v int64) *UploadUpdateOne {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetChecksum sets the "checksum" field.
+func (_u *UploadUpdateOne) SetChecksum(v string) *UploadUpdateOne {
+	_u.mutation.SetChecksum(v)
+	return _u
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (_u *UploadUpdateOne) SetNillableChecksum(v *string) *UploadUpdateOne {
+	if v != nil {
+		_u.SetChecksum(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *UploadUpdateOne) SetExpiresAt(v time.Time) *UploadUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *UploadUpdateOne) SetNillableExpiresAt(v *time.Time) *UploadUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *UploadUpdateOne) SetCreatedAt(v time.Time) *UploadUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *UploadUpdateOne) SetNillableCreatedAt(v *time.Time) *UploadUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the UploadMutation object of the builder.
+func (_u *UploadUpdateOne) Mutation() *UploadMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the UploadUpdate builder.
+func (_u *UploadUpdateOne) Where(ps ...predicate.Upload) *UploadUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UploadUpdateOne) Select(field string, fields ...string) *UploadUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Upload entity.
+func (_u *UploadUpdateOne) Save(ctx context.Context) (*Upload, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UploadUpdateOne) SaveX(ctx context.Context) *Upload {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UploadUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UploadUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UploadUpdateOne) check() error {
+	if v, ok := _u.mutation.ObjectName(); ok {
+		if err := upload.ObjectNameValidator(v); err != nil {
+			return &ValidationError{Name: "object_name", err: fmt.Errorf(`ent: validator failed for field "Upload.object_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ContentType(); ok {
+		if err := upload.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`ent: validator failed for field "Upload.content_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Checksum(); ok {
+		if err := upload.ChecksumValidator(v); err != nil {
+			return &ValidationError{Name: "checksum", err: fmt.Errorf(`ent: validator failed for field "Upload.checksum": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UploadUpdateOne) sqlSave(ctx context.Context) (_node *Upload, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(upload.Table, upload.Columns, sqlgraph.NewFieldSpec(upload.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Upload.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, upload.FieldID)
+		for _, f := range fields {
+			if !upload.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != upload.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(upload.FieldOwnerID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.OriginalName(); ok {
+		_spec.SetField(upload.FieldOriginalName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ObjectName(); ok {
+		_spec.SetField(upload.FieldObjectName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ContentType(); ok {
+		_spec.SetField(upload.FieldContentType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(upload.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(upload.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Checksum(); ok {
+		_spec.SetField(upload.FieldChecksum, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(upload.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(upload.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &Upload{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{upload.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
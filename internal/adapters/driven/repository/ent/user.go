@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -24,16 +25,71 @@ type User struct {
 	PasswordHash string `json:"password_hash,omitempty"`
 	// Role holds the value of the "role" field.
 	Role string `json:"role,omitempty"`
+	// MaxFileSize holds the value of the "max_file_size" field.
+	MaxFileSize int64 `json:"max_file_size,omitempty"`
+	// MaxTotalBytes holds the value of the "max_total_bytes" field.
+	MaxTotalBytes int64 `json:"max_total_bytes,omitempty"`
+	// MaxFiles holds the value of the "max_files" field.
+	MaxFiles int `json:"max_files,omitempty"`
+	// AllowedTypes holds the value of the "allowed_types" field.
+	AllowedTypes []string `json:"allowed_types,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
-	CreatedAt    time.Time `json:"created_at,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserQuery when eager-loading is set.
+	Edges        UserEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// UserEdges holds the relations/edges for other nodes in the graph.
+type UserEdges struct {
+	// Posts holds the value of the posts edge.
+	Posts []*Post `json:"posts,omitempty"`
+	// Comments holds the value of the comments edge.
+	Comments []*Comment `json:"comments,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*PostRevision `json:"revisions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// PostsOrErr returns the Posts value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PostsOrErr() ([]*Post, error) {
+	if e.loadedTypes[0] {
+		return e.Posts, nil
+	}
+	return nil, &NotLoadedError{edge: "posts"}
+}
+
+// CommentsOrErr returns the Comments value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) CommentsOrErr() ([]*Comment, error) {
+	if e.loadedTypes[1] {
+		return e.Comments, nil
+	}
+	return nil, &NotLoadedError{edge: "comments"}
+}
+
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RevisionsOrErr() ([]*PostRevision, error) {
+	if e.loadedTypes[2] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case user.FieldAllowedTypes:
+			values[i] = new([]byte)
+		case user.FieldMaxFileSize, user.FieldMaxTotalBytes, user.FieldMaxFiles:
+			values[i] = new(sql.NullInt64)
 		case user.FieldEmail, user.FieldPasswordHash, user.FieldRole:
 			values[i] = new(sql.NullString)
 		case user.FieldCreatedAt:
@@ -79,6 +135,32 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Role = value.String
 			}
+		case user.FieldMaxFileSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_file_size", values[i])
+			} else if value.Valid {
+				_m.MaxFileSize = value.Int64
+			}
+		case user.FieldMaxTotalBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_total_bytes", values[i])
+			} else if value.Valid {
+				_m.MaxTotalBytes = value.Int64
+			}
+		case user.FieldMaxFiles:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_files", values[i])
+			} else if value.Valid {
+				_m.MaxFiles = int(value.Int64)
+			}
+		case user.FieldAllowedTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field allowed_types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AllowedTypes); err != nil {
+					return fmt.Errorf("unmarshal field allowed_types: %w", err)
+				}
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -98,6 +180,21 @@ func (_m *User) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryPosts queries the "posts" edge of the User entity.
+func (_m *User) QueryPosts() *PostQuery {
+	return NewUserClient(_m.config).QueryPosts(_m)
+}
+
+// QueryComments queries the "comments" edge of the User entity.
+func (_m *User) QueryComments() *CommentQuery {
+	return NewUserClient(_m.config).QueryComments(_m)
+}
+
+// QueryRevisions queries the "revisions" edge of the User entity.
+func (_m *User) QueryRevisions() *PostRevisionQuery {
+	return NewUserClient(_m.config).QueryRevisions(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -130,6 +227,18 @@ func (_m *User) String() string {
 	builder.WriteString("role=")
 	builder.WriteString(_m.Role)
 	builder.WriteString(", ")
+	builder.WriteString("max_file_size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxFileSize))
+	builder.WriteString(", ")
+	builder.WriteString("max_total_bytes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxTotalBytes))
+	builder.WriteString(", ")
+	builder.WriteString("max_files=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxFiles))
+	builder.WriteString(", ")
+	builder.WriteString("allowed_types=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AllowedTypes))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
 	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/predicate"
 )
@@ -70,6 +71,21 @@ func Role(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldRole, v))
 }
 
+// MaxFileSize applies equality check predicate on the "max_file_size" field. It's identical to MaxFileSizeEQ.
+func MaxFileSize(v int64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMaxFileSize, v))
+}
+
+// MaxTotalBytes applies equality check predicate on the "max_total_bytes" field. It's identical to MaxTotalBytesEQ.
+func MaxTotalBytes(v int64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMaxTotalBytes, v))
+}
+
+// MaxFiles applies equality check predicate on the "max_files" field. It's identical to MaxFilesEQ.
+func MaxFiles(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMaxFiles, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -270,6 +286,136 @@ func RoleContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldRole, v))
 }
 
+// MaxFileSizeEQ applies the EQ predicate on the "max_file_size" field.
+func MaxFileSizeEQ(v int64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMaxFileSize, v))
+}
+
+// MaxFileSizeNEQ applies the NEQ predicate on the "max_file_size" field.
+func MaxFileSizeNEQ(v int64) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldMaxFileSize, v))
+}
+
+// MaxFileSizeIn applies the In predicate on the "max_file_size" field.
+func MaxFileSizeIn(vs ...int64) predicate.User {
+	return predicate.User(sql.FieldIn(FieldMaxFileSize, vs...))
+}
+
+// MaxFileSizeNotIn applies the NotIn predicate on the "max_file_size" field.
+func MaxFileSizeNotIn(vs ...int64) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldMaxFileSize, vs...))
+}
+
+// MaxFileSizeGT applies the GT predicate on the "max_file_size" field.
+func MaxFileSizeGT(v int64) predicate.User {
+	return predicate.User(sql.FieldGT(FieldMaxFileSize, v))
+}
+
+// MaxFileSizeGTE applies the GTE predicate on the "max_file_size" field.
+func MaxFileSizeGTE(v int64) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldMaxFileSize, v))
+}
+
+// MaxFileSizeLT applies the LT predicate on the "max_file_size" field.
+func MaxFileSizeLT(v int64) predicate.User {
+	return predicate.User(sql.FieldLT(FieldMaxFileSize, v))
+}
+
+// MaxFileSizeLTE applies the LTE predicate on the "max_file_size" field.
+func MaxFileSizeLTE(v int64) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldMaxFileSize, v))
+}
+
+// MaxTotalBytesEQ applies the EQ predicate on the "max_total_bytes" field.
+func MaxTotalBytesEQ(v int64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMaxTotalBytes, v))
+}
+
+// MaxTotalBytesNEQ applies the NEQ predicate on the "max_total_bytes" field.
+func MaxTotalBytesNEQ(v int64) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldMaxTotalBytes, v))
+}
+
+// MaxTotalBytesIn applies the In predicate on the "max_total_bytes" field.
+func MaxTotalBytesIn(vs ...int64) predicate.User {
+	return predicate.User(sql.FieldIn(FieldMaxTotalBytes, vs...))
+}
+
+// MaxTotalBytesNotIn applies the NotIn predicate on the "max_total_bytes" field.
+func MaxTotalBytesNotIn(vs ...int64) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldMaxTotalBytes, vs...))
+}
+
+// MaxTotalBytesGT applies the GT predicate on the "max_total_bytes" field.
+func MaxTotalBytesGT(v int64) predicate.User {
+	return predicate.User(sql.FieldGT(FieldMaxTotalBytes, v))
+}
+
+// MaxTotalBytesGTE applies the GTE predicate on the "max_total_bytes" field.
+func MaxTotalBytesGTE(v int64) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldMaxTotalBytes, v))
+}
+
+// MaxTotalBytesLT applies the LT predicate on the "max_total_bytes" field.
+func MaxTotalBytesLT(v int64) predicate.User {
+	return predicate.User(sql.FieldLT(FieldMaxTotalBytes, v))
+}
+
+// MaxTotalBytesLTE applies the LTE predicate on the "max_total_bytes" field.
+func MaxTotalBytesLTE(v int64) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldMaxTotalBytes, v))
+}
+
+// MaxFilesEQ applies the EQ predicate on the "max_files" field.
+func MaxFilesEQ(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMaxFiles, v))
+}
+
+// MaxFilesNEQ applies the NEQ predicate on the "max_files" field.
+func MaxFilesNEQ(v int) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldMaxFiles, v))
+}
+
+// MaxFilesIn applies the In predicate on the "max_files" field.
+func MaxFilesIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldIn(FieldMaxFiles, vs...))
+}
+
+// MaxFilesNotIn applies the NotIn predicate on the "max_files" field.
+func MaxFilesNotIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldMaxFiles, vs...))
+}
+
+// MaxFilesGT applies the GT predicate on the "max_files" field.
+func MaxFilesGT(v int) predicate.User {
+	return predicate.User(sql.FieldGT(FieldMaxFiles, v))
+}
+
+// MaxFilesGTE applies the GTE predicate on the "max_files" field.
+func MaxFilesGTE(v int) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldMaxFiles, v))
+}
+
+// MaxFilesLT applies the LT predicate on the "max_files" field.
+func MaxFilesLT(v int) predicate.User {
+	return predicate.User(sql.FieldLT(FieldMaxFiles, v))
+}
+
+// MaxFilesLTE applies the LTE predicate on the "max_files" field.
+func MaxFilesLTE(v int) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldMaxFiles, v))
+}
+
+// AllowedTypesIsNil applies the IsNil predicate on the "allowed_types" field.
+func AllowedTypesIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldAllowedTypes))
+}
+
+// AllowedTypesNotNil applies the NotNil predicate on the "allowed_types" field.
+func AllowedTypesNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldAllowedTypes))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -310,6 +456,75 @@ func CreatedAtLTE(v time.Time) predicate.User {
 	return predicate.User(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// HasPosts applies the HasEdge predicate on the "posts" edge.
+func HasPosts() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PostsTable, PostsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPostsWith applies the HasEdge predicate on the "posts" edge with a given conditions (other predicates).
+func HasPostsWith(preds ...predicate.Post) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newPostsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasComments applies the HasEdge predicate on the "comments" edge.
+func HasComments() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CommentsTable, CommentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCommentsWith applies the HasEdge predicate on the "comments" edge with a given conditions (other predicates).
+func HasCommentsWith(preds ...predicate.Comment) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newCommentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRevisions applies the HasEdge predicate on the "revisions" edge.
+func HasRevisions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRevisionsWith applies the HasEdge predicate on the "revisions" edge with a given conditions (other predicates).
+func HasRevisionsWith(preds ...predicate.PostRevision) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newRevisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
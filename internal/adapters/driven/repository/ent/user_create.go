@@ -11,6 +11,9 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/comment"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/post"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/postrevision"
 	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/user"
 )
 
@@ -39,6 +42,54 @@ func (_c *UserCreate) SetRole(v string) *UserCreate {
 	return _c
 }
 
+// SetMaxFileSize sets the "max_file_size" field.
+func (_c *UserCreate) SetMaxFileSize(v int64) *UserCreate {
+	_c.mutation.SetMaxFileSize(v)
+	return _c
+}
+
+// SetNillableMaxFileSize sets the "max_file_size" field if the given value is not nil.
+func (_c *UserCreate) SetNillableMaxFileSize(v *int64) *UserCreate {
+	if v != nil {
+		_c.SetMaxFileSize(*v)
+	}
+	return _c
+}
+
+// SetMaxTotalBytes sets the "max_total_bytes" field.
+func (_c *UserCreate) SetMaxTotalBytes(v int64) *UserCreate {
+	_c.mutation.SetMaxTotalBytes(v)
+	return _c
+}
+
+// SetNillableMaxTotalBytes sets the "max_total_bytes" field if the given value is not nil.
+func (_c *UserCreate) SetNillableMaxTotalBytes(v *int64) *UserCreate {
+	if v != nil {
+		_c.SetMaxTotalBytes(*v)
+	}
+	return _c
+}
+
+// SetMaxFiles sets the "max_files" field.
+func (_c *UserCreate) SetMaxFiles(v int) *UserCreate {
+	_c.mutation.SetMaxFiles(v)
+	return _c
+}
+
+// SetNillableMaxFiles sets the "max_files" field if the given value is not nil.
+func (_c *UserCreate) SetNillableMaxFiles(v *int) *UserCreate {
+	if v != nil {
+		_c.SetMaxFiles(*v)
+	}
+	return _c
+}
+
+// SetAllowedTypes sets the "allowed_types" field.
+func (_c *UserCreate) SetAllowedTypes(v []string) *UserCreate {
+	_c.mutation.SetAllowedTypes(v)
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *UserCreate) SetCreatedAt(v time.Time) *UserCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -67,6 +118,51 @@ func (_c *UserCreate) SetNillableID(v *uuid.UUID) *UserCreate {
 	return _c
 }
 
+// AddPostIDs adds the "posts" edge to the Post entity by IDs.
+func (_c *UserCreate) AddPostIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddPostIDs(ids...)
+	return _c
+}
+
+// AddPosts adds the "posts" edges to the Post entity.
+func (_c *UserCreate) AddPosts(v ...*Post) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPostIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (_c *UserCreate) AddCommentIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddCommentIDs(ids...)
+	return _c
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (_c *UserCreate) AddComments(v ...*Comment) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCommentIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the PostRevision entity by IDs.
+func (_c *UserCreate) AddRevisionIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddRevisionIDs(ids...)
+	return _c
+}
+
+// AddRevisions adds the "revisions" edges to the PostRevision entity.
+func (_c *UserCreate) AddRevisions(v ...*PostRevision) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRevisionIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_c *UserCreate) Mutation() *UserMutation {
 	return _c.mutation
@@ -102,6 +198,18 @@ func (_c *UserCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *UserCreate) defaults() {
+	if _, ok := _c.mutation.MaxFileSize(); !ok {
+		v := user.DefaultMaxFileSize
+		_c.mutation.SetMaxFileSize(v)
+	}
+	if _, ok := _c.mutation.MaxTotalBytes(); !ok {
+		v := user.DefaultMaxTotalBytes
+		_c.mutation.SetMaxTotalBytes(v)
+	}
+	if _, ok := _c.mutation.MaxFiles(); !ok {
+		v := user.DefaultMaxFiles
+		_c.mutation.SetMaxFiles(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := user.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -138,6 +246,15 @@ func (_c *UserCreate) check() error {
 			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.MaxFileSize(); !ok {
+		return &ValidationError{Name: "max_file_size", err: errors.New(`ent: missing required field "User.max_file_size"`)}
+	}
+	if _, ok := _c.mutation.MaxTotalBytes(); !ok {
+		return &ValidationError{Name: "max_total_bytes", err: errors.New(`ent: missing required field "User.max_total_bytes"`)}
+	}
+	if _, ok := _c.mutation.MaxFiles(); !ok {
+		return &ValidationError{Name: "max_files", err: errors.New(`ent: missing required field "User.max_files"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "User.created_at"`)}
 	}
@@ -188,10 +305,74 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldRole, field.TypeString, value)
 		_node.Role = value
 	}
+	if value, ok := _c.mutation.MaxFileSize(); ok {
+		_spec.SetField(user.FieldMaxFileSize, field.TypeInt64, value)
+		_node.MaxFileSize = value
+	}
+	if value, ok := _c.mutation.MaxTotalBytes(); ok {
+		_spec.SetField(user.FieldMaxTotalBytes, field.TypeInt64, value)
+		_node.MaxTotalBytes = value
+	}
+	if value, ok := _c.mutation.MaxFiles(); ok {
+		_spec.SetField(user.FieldMaxFiles, field.TypeInt, value)
+		_node.MaxFiles = value
+	}
+	if value, ok := _c.mutation.AllowedTypes(); ok {
+		_spec.SetField(user.FieldAllowedTypes, field.TypeJSON, value)
+		_node.AllowedTypes = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if nodes := _c.mutation.PostsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PostsTable,
+			Columns: []string{user.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CommentsTable,
+			Columns: []string{user.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RevisionsTable,
+			Columns: []string{user.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -10,7 +10,12 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/comment"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/post"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/postrevision"
 	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/predicate"
 	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/user"
 )
@@ -70,6 +75,87 @@ func (_u *UserUpdate) SetNillableRole(v *string) *UserUpdate {
 	return _u
 }
 
+// SetMaxFileSize sets the "max_file_size" field.
+func (_u *UserUpdate) SetMaxFileSize(v int64) *UserUpdate {
+	_u.mutation.ResetMaxFileSize()
+	_u.mutation.SetMaxFileSize(v)
+	return _u
+}
+
+// SetNillableMaxFileSize sets the "max_file_size" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableMaxFileSize(v *int64) *UserUpdate {
+	if v != nil {
+		_u.SetMaxFileSize(*v)
+	}
+	return _u
+}
+
+// AddMaxFileSize adds value to the "max_file_size" field.
+func (_u *UserUpdate) AddMaxFileSize(v int64) *UserUpdate {
+	_u.mutation.AddMaxFileSize(v)
+	return _u
+}
+
+// SetMaxTotalBytes sets the "max_total_bytes" field.
+func (_u *UserUpdate) SetMaxTotalBytes(v int64) *UserUpdate {
+	_u.mutation.ResetMaxTotalBytes()
+	_u.mutation.SetMaxTotalBytes(v)
+	return _u
+}
+
+// SetNillableMaxTotalBytes sets the "max_total_bytes" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableMaxTotalBytes(v *int64) *UserUpdate {
+	if v != nil {
+		_u.SetMaxTotalBytes(*v)
+	}
+	return _u
+}
+
+// AddMaxTotalBytes adds value to the "max_total_bytes" field.
+func (_u *UserUpdate) AddMaxTotalBytes(v int64) *UserUpdate {
+	_u.mutation.AddMaxTotalBytes(v)
+	return _u
+}
+
+// SetMaxFiles sets the "max_files" field.
+func (_u *UserUpdate) SetMaxFiles(v int) *UserUpdate {
+	_u.mutation.ResetMaxFiles()
+	_u.mutation.SetMaxFiles(v)
+	return _u
+}
+
+// SetNillableMaxFiles sets the "max_files" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableMaxFiles(v *int) *UserUpdate {
+	if v != nil {
+		_u.SetMaxFiles(*v)
+	}
+	return _u
+}
+
+// AddMaxFiles adds value to the "max_files" field.
+func (_u *UserUpdate) AddMaxFiles(v int) *UserUpdate {
+	_u.mutation.AddMaxFiles(v)
+	return _u
+}
+
+// SetAllowedTypes sets the "allowed_types" field.
+func (_u *UserUpdate) SetAllowedTypes(v []string) *UserUpdate {
+	_u.mutation.SetAllowedTypes(v)
+	return _u
+}
+
+// AppendAllowedTypes appends value to the "allowed_types" field.
+func (_u *UserUpdate) AppendAllowedTypes(v []string) *UserUpdate {
+	_u.mutation.AppendAllowedTypes(v)
+	return _u
+}
+
+// ClearAllowedTypes clears the value of the "allowed_types" field.
+func (_u *UserUpdate) ClearAllowedTypes() *UserUpdate {
+	_u.mutation.ClearAllowedTypes()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *UserUpdate) SetCreatedAt(v time.Time) *UserUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -84,11 +170,119 @@ func (_u *UserUpdate) SetNillableCreatedAt(v *time.Time) *UserUpdate {
 	return _u
 }
 
+// AddPostIDs adds the "posts" edge to the Post entity by IDs.
+func (_u *UserUpdate) AddPostIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddPostIDs(ids...)
+	return _u
+}
+
+// AddPosts adds the "posts" edges to the Post entity.
+func (_u *UserUpdate) AddPosts(v ...*Post) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPostIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (_u *UserUpdate) AddCommentIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddCommentIDs(ids...)
+	return _u
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (_u *UserUpdate) AddComments(v ...*Comment) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCommentIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the PostRevision entity by IDs.
+func (_u *UserUpdate) AddRevisionIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the PostRevision entity.
+func (_u *UserUpdate) AddRevisions(v ...*PostRevision) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdate) Mutation() *UserMutation {
 	return _u.mutation
 }
 
+// ClearPosts clears all "posts" edges to the Post entity.
+func (_u *UserUpdate) ClearPosts() *UserUpdate {
+	_u.mutation.ClearPosts()
+	return _u
+}
+
+// RemovePostIDs removes the "posts" edge to Post entities by IDs.
+func (_u *UserUpdate) RemovePostIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemovePostIDs(ids...)
+	return _u
+}
+
+// RemovePosts removes "posts" edges to Post entities.
+func (_u *UserUpdate) RemovePosts(v ...*Post) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePostIDs(ids...)
+}
+
+// ClearComments clears all "comments" edges to the Comment entity.
+func (_u *UserUpdate) ClearComments() *UserUpdate {
+	_u.mutation.ClearComments()
+	return _u
+}
+
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (_u *UserUpdate) RemoveCommentIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveCommentIDs(ids...)
+	return _u
+}
+
+// RemoveComments removes "comments" edges to Comment entities.
+func (_u *UserUpdate) RemoveComments(v ...*Comment) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCommentIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the PostRevision entity.
+func (_u *UserUpdate) ClearRevisions() *UserUpdate {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to PostRevision entities by IDs.
+func (_u *UserUpdate) RemoveRevisionIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to PostRevision entities.
+func (_u *UserUpdate) RemoveRevisions(v ...*PostRevision) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -157,9 +351,173 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Role(); ok {
 		_spec.SetField(user.FieldRole, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.MaxFileSize(); ok {
+		_spec.SetField(user.FieldMaxFileSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxFileSize(); ok {
+		_spec.AddField(user.FieldMaxFileSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MaxTotalBytes(); ok {
+		_spec.SetField(user.FieldMaxTotalBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxTotalBytes(); ok {
+		_spec.AddField(user.FieldMaxTotalBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MaxFiles(); ok {
+		_spec.SetField(user.FieldMaxFiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxFiles(); ok {
+		_spec.AddField(user.FieldMaxFiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AllowedTypes(); ok {
+		_spec.SetField(user.FieldAllowedTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldAllowedTypes, value)
+		})
+	}
+	if _u.mutation.AllowedTypesCleared() {
+		_spec.ClearField(user.FieldAllowedTypes, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 	}
+	if _u.mutation.PostsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PostsTable,
+			Columns: []string{user.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPostsIDs(); len(nodes) > 0 && !_u.mutation.PostsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PostsTable,
+			Columns: []string{user.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PostsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PostsTable,
+			Columns: []string{user.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CommentsTable,
+			Columns: []string{user.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !_u.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CommentsTable,
+			Columns: []string{user.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CommentsTable,
+			Columns: []string{user.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RevisionsTable,
+			Columns: []string{user.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RevisionsTable,
+			Columns: []string{user.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RevisionsTable,
+			Columns: []string{user.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -222,6 +580,87 @@ func (_u *UserUpdateOne) SetNillableRole(v *string) *UserUpdateOne {
 	return _u
 }
 
+// SetMaxFileSize sets the "max_file_size" field.
+func (_u *UserUpdateOne) SetMaxFileSize(v int64) *UserUpdateOne {
+	_u.mutation.ResetMaxFileSize()
+	_u.mutation.SetMaxFileSize(v)
+	return _u
+}
+
+// SetNillableMaxFileSize sets the "max_file_size" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableMaxFileSize(v *int64) *UserUpdateOne {
+	if v != nil {
+		_u.SetMaxFileSize(*v)
+	}
+	return _u
+}
+
+// AddMaxFileSize adds value to the "max_file_size" field.
+func (_u *UserUpdateOne) AddMaxFileSize(v int64) *UserUpdateOne {
+	_u.mutation.AddMaxFileSize(v)
+	return _u
+}
+
+// SetMaxTotalBytes sets the "max_total_bytes" field.
+func (_u *UserUpdateOne) SetMaxTotalBytes(v int64) *UserUpdateOne {
+	_u.mutation.ResetMaxTotalBytes()
+	_u.mutation.SetMaxTotalBytes(v)
+	return _u
+}
+
+// SetNillableMaxTotalBytes sets the "max_total_bytes" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableMaxTotalBytes(v *int64) *UserUpdateOne {
+	if v != nil {
+		_u.SetMaxTotalBytes(*v)
+	}
+	return _u
+}
+
+// AddMaxTotalBytes adds value to the "max_total_bytes" field.
+func (_u *UserUpdateOne) AddMaxTotalBytes(v int64) *UserUpdateOne {
+	_u.mutation.AddMaxTotalBytes(v)
+	return _u
+}
+
+// SetMaxFiles sets the "max_files" field.
+func (_u *UserUpdateOne) SetMaxFiles(v int) *UserUpdateOne {
+	_u.mutation.ResetMaxFiles()
+	_u.mutation.SetMaxFiles(v)
+	return _u
+}
+
+// SetNillableMaxFiles sets the "max_files" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableMaxFiles(v *int) *UserUpdateOne {
+	if v != nil {
+		_u.SetMaxFiles(*v)
+	}
+	return _u
+}
+
+// AddMaxFiles adds value to the "max_files" field.
+func (_u *UserUpdateOne) AddMaxFiles(v int) *UserUpdateOne {
+	_u.mutation.AddMaxFiles(v)
+	return _u
+}
+
+// SetAllowedTypes sets the "allowed_types" field.
+func (_u *UserUpdateOne) SetAllowedTypes(v []string) *UserUpdateOne {
+	_u.mutation.SetAllowedTypes(v)
+	return _u
+}
+
+// AppendAllowedTypes appends value to the "allowed_types" field.
+func (_u *UserUpdateOne) AppendAllowedTypes(v []string) *UserUpdateOne {
+	_u.mutation.AppendAllowedTypes(v)
+	return _u
+}
+
+// ClearAllowedTypes clears the value of the "allowed_types" field.
+func (_u *UserUpdateOne) ClearAllowedTypes() *UserUpdateOne {
+	_u.mutation.ClearAllowedTypes()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *UserUpdateOne) SetCreatedAt(v time.Time) *UserUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -236,11 +675,119 @@ func (_u *UserUpdateOne) SetNillableCreatedAt(v *time.Time) *UserUpdateOne {
 	return _u
 }
 
+// AddPostIDs adds the "posts" edge to the Post entity by IDs.
+func (_u *UserUpdateOne) AddPostIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddPostIDs(ids...)
+	return _u
+}
+
+// AddPosts adds the "posts" edges to the Post entity.
+func (_u *UserUpdateOne) AddPosts(v ...*Post) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPostIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (_u *UserUpdateOne) AddCommentIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddCommentIDs(ids...)
+	return _u
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (_u *UserUpdateOne) AddComments(v ...*Comment) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCommentIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the PostRevision entity by IDs.
+func (_u *UserUpdateOne) AddRevisionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the PostRevision entity.
+func (_u *UserUpdateOne) AddRevisions(v ...*PostRevision) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdateOne) Mutation() *UserMutation {
 	return _u.mutation
 }
 
+// ClearPosts clears all "posts" edges to the Post entity.
+func (_u *UserUpdateOne) ClearPosts() *UserUpdateOne {
+	_u.mutation.ClearPosts()
+	return _u
+}
+
+// RemovePostIDs removes the "posts" edge to Post entities by IDs.
+func (_u *UserUpdateOne) RemovePostIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemovePostIDs(ids...)
+	return _u
+}
+
+// RemovePosts removes "posts" edges to Post entities.
+func (_u *UserUpdateOne) RemovePosts(v ...*Post) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePostIDs(ids...)
+}
+
+// ClearComments clears all "comments" edges to the Comment entity.
+func (_u *UserUpdateOne) ClearComments() *UserUpdateOne {
+	_u.mutation.ClearComments()
+	return _u
+}
+
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (_u *UserUpdateOne) RemoveCommentIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveCommentIDs(ids...)
+	return _u
+}
+
+// RemoveComments removes "comments" edges to Comment entities.
+func (_u *UserUpdateOne) RemoveComments(v ...*Comment) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCommentIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the PostRevision entity.
+func (_u *UserUpdateOne) ClearRevisions() *UserUpdateOne {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to PostRevision entities by IDs.
+func (_u *UserUpdateOne) RemoveRevisionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to PostRevision entities.
+func (_u *UserUpdateOne) RemoveRevisions(v ...*PostRevision) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (_u *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	_u.mutation.Where(ps...)
@@ -339,9 +886,173 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if value, ok := _u.mutation.Role(); ok {
 		_spec.SetField(user.FieldRole, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.MaxFileSize(); ok {
+		_spec.SetField(user.FieldMaxFileSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxFileSize(); ok {
+		_spec.AddField(user.FieldMaxFileSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MaxTotalBytes(); ok {
+		_spec.SetField(user.FieldMaxTotalBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxTotalBytes(); ok {
+		_spec.AddField(user.FieldMaxTotalBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MaxFiles(); ok {
+		_spec.SetField(user.FieldMaxFiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxFiles(); ok {
+		_spec.AddField(user.FieldMaxFiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AllowedTypes(); ok {
+		_spec.SetField(user.FieldAllowedTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldAllowedTypes, value)
+		})
+	}
+	if _u.mutation.AllowedTypesCleared() {
+		_spec.ClearField(user.FieldAllowedTypes, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 	}
+	if _u.mutation.PostsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PostsTable,
+			Columns: []string{user.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPostsIDs(); len(nodes) > 0 && !_u.mutation.PostsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PostsTable,
+			Columns: []string{user.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PostsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PostsTable,
+			Columns: []string{user.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CommentsTable,
+			Columns: []string{user.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !_u.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CommentsTable,
+			Columns: []string{user.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CommentsTable,
+			Columns: []string{user.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RevisionsTable,
+			Columns: []string{user.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RevisionsTable,
+			Columns: []string{user.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RevisionsTable,
+			Columns: []string{user.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
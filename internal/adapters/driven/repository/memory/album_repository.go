@@ -0,0 +1,127 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+type InMemoryAlbumRepository struct {
+	albums map[uuid.UUID]*domain.Album
+	mu     sync.RWMutex
+}
+
+var _ outports.AlbumRepository = (*InMemoryAlbumRepository)(nil)
+
+func NewAlbumRepository() *InMemoryAlbumRepository {
+	return &InMemoryAlbumRepository{
+		albums: make(map[uuid.UUID]*domain.Album),
+	}
+}
+
+func (r *InMemoryAlbumRepository) Save(ctx context.Context, album *domain.Album) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.slugTaken(album) {
+		return domain.ErrSlugTaken
+	}
+	r.albums[album.ID] = copyAlbum(album)
+	return nil
+}
+
+func (r *InMemoryAlbumRepository) Edit(ctx context.Context, id uuid.UUID, edit func(album *domain.Album) error) (*domain.Album, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	album, exists := r.albums[id]
+	if !exists {
+		return nil, domain.ErrAlbumNotFound
+	}
+
+	edited := copyAlbum(album)
+	if err := edit(edited); err != nil {
+		return nil, err
+	}
+	if r.slugTaken(edited) {
+		return nil, domain.ErrSlugTaken
+	}
+	r.albums[id] = copyAlbum(edited)
+	return edited, nil
+}
+
+func (r *InMemoryAlbumRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Album, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	album, exists := r.albums[id]
+	if !exists {
+		return nil, domain.ErrAlbumNotFound
+	}
+	return copyAlbum(album), nil
+}
+
+func (r *InMemoryAlbumRepository) FindBySlug(ctx context.Context, slug string) (*domain.Album, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, album := range r.albums {
+		if album.Slug == slug {
+			return copyAlbum(album), nil
+		}
+	}
+	return nil, domain.ErrAlbumNotFound
+}
+
+func (r *InMemoryAlbumRepository) FindByOwner(ctx context.Context, ownerID uuid.UUID) ([]*domain.Album, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var albums []*domain.Album
+	for _, album := range r.albums {
+		if album.OwnerID == ownerID {
+			albums = append(albums, copyAlbum(album))
+		}
+	}
+	slices.SortFunc(albums, func(a, b *domain.Album) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+	return albums, nil
+}
+
+func (r *InMemoryAlbumRepository) FindPublic(ctx context.Context) ([]*domain.Album, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var albums []*domain.Album
+	for _, album := range r.albums {
+		if album.IsPublic() {
+			albums = append(albums, copyAlbum(album))
+		}
+	}
+	slices.SortFunc(albums, func(a, b *domain.Album) int {
+		return b.UpdatedAt.Compare(a.UpdatedAt)
+	})
+	return albums, nil
+}
+
+func (r *InMemoryAlbumRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.albums, id)
+	return nil
+}
+
+func (r *InMemoryAlbumRepository) slugTaken(album *domain.Album) bool {
+	for _, other := range r.albums {
+		if other.Slug == album.Slug && other.ID != album.ID {
+			return true
+		}
+	}
+	return false
+}
+
+// copyAlbum keeps callers from mutating the stored image order
+func copyAlbum(album *domain.Album) *domain.Album {
+	c := *album
+	c.ImageIDs = slices.Clone(album.ImageIDs)
+	return &c
+}
@@ -0,0 +1,82 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+type InMemoryBlobRepository struct {
+	blobs map[string]*domain.Blob
+	mu    sync.Mutex
+}
+
+var _ outports.BlobRepository = (*InMemoryBlobRepository)(nil)
+
+func NewBlobRepository() *InMemoryBlobRepository {
+	return &InMemoryBlobRepository{
+		blobs: make(map[string]*domain.Blob),
+	}
+}
+
+func (r *InMemoryBlobRepository) Create(ctx context.Context, blob *domain.Blob) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.blobs[blob.Name]; exists {
+		return domain.ErrBlobExists
+	}
+	stored := *blob
+	r.blobs[blob.Name] = &stored
+	return nil
+}
+
+func (r *InMemoryBlobRepository) FindAll(ctx context.Context) ([]*domain.Blob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	blobs := make([]*domain.Blob, 0, len(r.blobs))
+	for _, blob := range r.blobs {
+		found := *blob
+		blobs = append(blobs, &found)
+	}
+	return blobs, nil
+}
+
+func (r *InMemoryBlobRepository) Acquire(ctx context.Context, name string) (*domain.Blob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	blob, exists := r.blobs[name]
+	if !exists {
+		return nil, domain.ErrBlobNotFound
+	}
+	blob.RefCount++
+	acquired := *blob
+	return &acquired, nil
+}
+
+func (r *InMemoryBlobRepository) UpdateURL(ctx context.Context, name, url string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	blob, exists := r.blobs[name]
+	if !exists {
+		return domain.ErrBlobNotFound
+	}
+	blob.URL = url
+	return nil
+}
+
+func (r *InMemoryBlobRepository) Release(ctx context.Context, name string, deleteFile func() error) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	blob, exists := r.blobs[name]
+	if !exists {
+		return 0, domain.ErrBlobNotFound
+	}
+	blob.RefCount--
+	if blob.RefCount <= 0 {
+		delete(r.blobs, name)
+		return 0, deleteFile()
+	}
+	return blob.RefCount, nil
+}
@@ -0,0 +1,88 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+type InMemoryCommentRepository struct {
+	comments map[uuid.UUID]*domain.Comment
+	mu       sync.RWMutex
+}
+
+var _ outports.CommentRepository = (*InMemoryCommentRepository)(nil)
+
+func NewCommentRepository() *InMemoryCommentRepository {
+	return &InMemoryCommentRepository{
+		comments: make(map[uuid.UUID]*domain.Comment),
+	}
+}
+
+func (r *InMemoryCommentRepository) Save(ctx context.Context, comment *domain.Comment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c := *comment
+	r.comments[comment.ID] = &c
+	return nil
+}
+
+func (r *InMemoryCommentRepository) Update(ctx context.Context, comment *domain.Comment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, exists := r.comments[comment.ID]
+	if !exists {
+		return domain.ErrCommentNotFound
+	}
+	// Like Postgres, only the moderation status changes
+	stored.Status = comment.Status
+	stored.UpdatedAt = comment.UpdatedAt
+	return nil
+}
+
+func (r *InMemoryCommentRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Comment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	comment, exists := r.comments[id]
+	if !exists {
+		return nil, domain.ErrCommentNotFound
+	}
+	c := *comment
+	return &c, nil
+}
+
+func (r *InMemoryCommentRepository) FindByPost(ctx context.Context, postID uuid.UUID) ([]*domain.Comment, error) {
+	comments := r.filter(func(c *domain.Comment) bool { return c.PostID == postID })
+	slices.SortFunc(comments, func(a, b *domain.Comment) int { return a.CreatedAt.Compare(b.CreatedAt) })
+	return comments, nil
+}
+
+func (r *InMemoryCommentRepository) List(ctx context.Context, filter domain.CommentFilter) (*domain.CommentList, error) {
+	comments := r.filter(func(c *domain.Comment) bool { return filter.Status == "" || c.Status == filter.Status })
+	slices.SortFunc(comments, func(a, b *domain.Comment) int { return b.CreatedAt.Compare(a.CreatedAt) })
+
+	list := &domain.CommentList{Comments: []*domain.Comment{}, Total: len(comments)}
+	if filter.Offset < len(comments) {
+		comments = comments[filter.Offset:]
+		list.Comments = comments[:min(filter.Limit, len(comments))]
+	}
+	return list, nil
+}
+
+// filter returns copies of the comments that match
+func (r *InMemoryCommentRepository) filter(match func(*domain.Comment) bool) []*domain.Comment {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var comments []*domain.Comment
+	for _, comment := range r.comments {
+		if match(comment) {
+			c := *comment
+			comments = append(comments, &c)
+		}
+	}
+	return comments
+}
@@ -0,0 +1,60 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+type InMemoryContactRepository struct {
+	messages map[uuid.UUID]*domain.ContactMessage
+	mu       sync.RWMutex
+}
+
+var _ outports.ContactRepository = (*InMemoryContactRepository)(nil)
+
+func NewContactRepository() *InMemoryContactRepository {
+	return &InMemoryContactRepository{
+		messages: make(map[uuid.UUID]*domain.ContactMessage),
+	}
+}
+
+func (r *InMemoryContactRepository) Save(ctx context.Context, message *domain.ContactMessage) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	m := *message
+	r.messages[message.ID] = &m
+	return nil
+}
+
+func (r *InMemoryContactRepository) List(ctx context.Context, limit, offset int) (*domain.ContactMessageList, error) {
+	r.mu.RLock()
+	messages := make([]*domain.ContactMessage, 0, len(r.messages))
+	for _, message := range r.messages {
+		m := *message
+		messages = append(messages, &m)
+	}
+	r.mu.RUnlock()
+	slices.SortFunc(messages, func(a, b *domain.ContactMessage) int { return b.CreatedAt.Compare(a.CreatedAt) })
+
+	list := &domain.ContactMessageList{Messages: []*domain.ContactMessage{}, Total: len(messages)}
+	if offset < len(messages) {
+		messages = messages[offset:]
+		list.Messages = messages[:min(limit, len(messages))]
+	}
+	return list, nil
+}
+
+func (r *InMemoryContactRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.messages[id]; !exists {
+		return domain.ErrContactMessageNotFound
+	}
+	delete(r.messages, id)
+	return nil
+}
@@ -0,0 +1,119 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+type InMemoryImageRepository struct {
+	images map[uuid.UUID]*domain.Image
+	mu     sync.RWMutex
+}
+
+var _ outports.ImageRepository = (*InMemoryImageRepository)(nil)
+
+func NewImageRepository() *InMemoryImageRepository {
+	return &InMemoryImageRepository{
+		images: make(map[uuid.UUID]*domain.Image),
+	}
+}
+
+func (r *InMemoryImageRepository) Save(ctx context.Context, image *domain.Image) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.images[image.ID] = image
+	return nil
+}
+
+func (r *InMemoryImageRepository) Update(ctx context.Context, image *domain.Image) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.images[image.ID]; !exists {
+		return domain.ErrImageNotFound
+	}
+	r.images[image.ID] = image
+	return nil
+}
+
+func (r *InMemoryImageRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Image, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	image, exists := r.images[id]
+	if !exists {
+		return nil, domain.ErrImageNotFound
+	}
+	return image, nil
+}
+
+func (r *InMemoryImageRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Image, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var images []*domain.Image
+	for _, id := range ids {
+		if image, exists := r.images[id]; exists {
+			images = append(images, image)
+		}
+	}
+	return images, nil
+}
+
+func (r *InMemoryImageRepository) FindAll(ctx context.Context) ([]*domain.Image, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	images := make([]*domain.Image, 0, len(r.images))
+	for _, image := range r.images {
+		images = append(images, image)
+	}
+	return images, nil
+}
+
+func (r *InMemoryImageRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.images, id)
+	return nil
+}
+
+func (r *InMemoryImageRepository) UpdateURL(ctx context.Context, storedName string, visibility domain.Visibility, url string) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	updated := 0
+	for _, image := range r.images {
+		if image.StoredName == storedName && image.Visibility == visibility && image.URL != url {
+			image.URL = url
+			updated++
+		}
+	}
+	return updated, nil
+}
+
+func (r *InMemoryImageRepository) Rename(ctx context.Context, oldName string, visibility domain.Visibility, newName, url string) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	updated := 0
+	for _, image := range r.images {
+		if image.StoredName == oldName && image.Visibility == visibility {
+			image.StoredName = newName
+			image.URL = url
+			updated++
+		}
+	}
+	return updated, nil
+}
+
+func (r *InMemoryImageRepository) Usage(ctx context.Context, ownerID uuid.UUID) (domain.StorageUsage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var usage domain.StorageUsage
+	for _, image := range r.images {
+		if image.OwnerID == ownerID {
+			usage.Bytes += image.Size
+			usage.Files++
+		}
+	}
+	return usage, nil
+}
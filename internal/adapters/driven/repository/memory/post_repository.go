@@ -0,0 +1,162 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+type InMemoryPostRepository struct {
+	posts map[uuid.UUID]*domain.Post
+	// oldSlugs leads each slug given up by a rename to the last post that had it
+	oldSlugs map[string]uuid.UUID
+	mu       sync.RWMutex
+}
+
+var _ outports.PostRepository = (*InMemoryPostRepository)(nil)
+
+func NewPostRepository() *InMemoryPostRepository {
+	return &InMemoryPostRepository{
+		posts:    make(map[uuid.UUID]*domain.Post),
+		oldSlugs: make(map[string]uuid.UUID),
+	}
+}
+
+func (r *InMemoryPostRepository) Save(ctx context.Context, post *domain.Post) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.slugTaken(post) {
+		return domain.ErrSlugTaken
+	}
+	c := *post
+	r.posts[post.ID] = &c
+	return nil
+}
+
+func (r *InMemoryPostRepository) Update(ctx context.Context, post *domain.Post) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.update(post)
+}
+
+// update requires the write lock
+func (r *InMemoryPostRepository) update(post *domain.Post) error {
+	stored, exists := r.posts[post.ID]
+	if !exists {
+		return domain.ErrPostNotFound
+	}
+	if r.slugTaken(post) {
+		return domain.ErrSlugTaken
+	}
+	if stored.Slug != post.Slug {
+		delete(r.oldSlugs, post.Slug)
+		r.oldSlugs[stored.Slug] = post.ID
+	}
+	c := *post
+	r.posts[post.ID] = &c
+	return nil
+}
+
+func (r *InMemoryPostRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Post, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	post, exists := r.posts[id]
+	if !exists {
+		return nil, domain.ErrPostNotFound
+	}
+	c := *post
+	return &c, nil
+}
+
+func (r *InMemoryPostRepository) FindBySlug(ctx context.Context, slug string) (*domain.Post, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, post := range r.posts {
+		if post.Slug == slug {
+			c := *post
+			return &c, nil
+		}
+	}
+	return nil, domain.ErrPostNotFound
+}
+
+func (r *InMemoryPostRepository) FindByOldSlug(ctx context.Context, slug string) (*domain.Post, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	post, exists := r.posts[r.oldSlugs[slug]]
+	if !exists {
+		return nil, domain.ErrPostNotFound
+	}
+	c := *post
+	return &c, nil
+}
+
+func (r *InMemoryPostRepository) List(ctx context.Context, filter domain.PostFilter) (*domain.PostList, error) {
+	r.mu.RLock()
+	var posts []*domain.Post
+	for _, post := range r.posts {
+		if (filter.Status == "" || post.Status == filter.Status) && (filter.Tag == "" || hasTag(post.Tags, filter.Tag)) {
+			c := *post
+			posts = append(posts, &c)
+		}
+	}
+	r.mu.RUnlock()
+
+	// Same order as Postgres, where unpublished posts have a NULL published_at
+	slices.SortFunc(posts, func(a, b *domain.Post) int {
+		if a.PublishedAt.IsZero() != b.PublishedAt.IsZero() {
+			if a.PublishedAt.IsZero() {
+				return -1
+			}
+			return 1
+		}
+		if c := b.PublishedAt.Compare(a.PublishedAt); c != 0 {
+			return c
+		}
+		return b.UpdatedAt.Compare(a.UpdatedAt)
+	})
+
+	list := &domain.PostList{Posts: []*domain.Post{}, Total: len(posts)}
+	if filter.Offset < len(posts) {
+		posts = posts[filter.Offset:]
+		list.Posts = posts[:min(filter.Limit, len(posts))]
+	}
+	return list, nil
+}
+
+func (r *InMemoryPostRepository) FindDue(ctx context.Context, now time.Time) ([]*domain.Post, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var posts []*domain.Post
+	for _, post := range r.posts {
+		if post.Status == domain.PostScheduled && !post.PublishAt.After(now) {
+			c := *post
+			posts = append(posts, &c)
+		}
+	}
+	slices.SortFunc(posts, func(a, b *domain.Post) int {
+		return a.PublishAt.Compare(b.PublishAt)
+	})
+	return posts, nil
+}
+
+func (r *InMemoryPostRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.posts, id)
+	return nil
+}
+
+func (r *InMemoryPostRepository) slugTaken(post *domain.Post) bool {
+	for _, other := range r.posts {
+		if other.Slug == post.Slug && other.ID != post.ID {
+			return true
+		}
+	}
+	return false
+}
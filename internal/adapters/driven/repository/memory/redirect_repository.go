@@ -0,0 +1,83 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+type InMemoryRedirectRepository struct {
+	redirects map[uuid.UUID]*domain.Redirect
+	mu        sync.RWMutex
+}
+
+var _ outports.RedirectRepository = (*InMemoryRedirectRepository)(nil)
+
+func NewRedirectRepository() *InMemoryRedirectRepository {
+	return &InMemoryRedirectRepository{
+		redirects: make(map[uuid.UUID]*domain.Redirect),
+	}
+}
+
+func (r *InMemoryRedirectRepository) Save(ctx context.Context, redirect *domain.Redirect) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, other := range r.redirects {
+		if other.From == redirect.From {
+			return domain.ErrRedirectExists
+		}
+	}
+	c := *redirect
+	r.redirects[redirect.ID] = &c
+	return nil
+}
+
+func (r *InMemoryRedirectRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.redirects[id]; !exists {
+		return domain.ErrRedirectNotFound
+	}
+	delete(r.redirects, id)
+	return nil
+}
+
+func (r *InMemoryRedirectRepository) GetByPath(ctx context.Context, path string) (*domain.Redirect, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, redirect := range r.redirects {
+		if redirect.From == path {
+			c := *redirect
+			return &c, nil
+		}
+	}
+	return nil, domain.ErrRedirectNotFound
+}
+
+func (r *InMemoryRedirectRepository) List(ctx context.Context, limit, offset int) (*domain.RedirectList, error) {
+	redirects, _ := r.All(ctx)
+	slices.SortFunc(redirects, func(a, b *domain.Redirect) int { return strings.Compare(a.From, b.From) })
+
+	list := &domain.RedirectList{Redirects: []*domain.Redirect{}, Total: len(redirects)}
+	if offset < len(redirects) {
+		redirects = redirects[offset:]
+		list.Redirects = redirects[:min(limit, len(redirects))]
+	}
+	return list, nil
+}
+
+func (r *InMemoryRedirectRepository) All(ctx context.Context) ([]*domain.Redirect, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	redirects := make([]*domain.Redirect, 0, len(r.redirects))
+	for _, redirect := range r.redirects {
+		c := *redirect
+		redirects = append(redirects, &c)
+	}
+	return redirects, nil
+}
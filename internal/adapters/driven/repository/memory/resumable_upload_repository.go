@@ -0,0 +1,89 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+type InMemoryResumableUploadRepository struct {
+	uploads map[uuid.UUID]*domain.ResumableUpload
+	mu      sync.RWMutex
+}
+
+var _ outports.ResumableUploadRepository = (*InMemoryResumableUploadRepository)(nil)
+
+func NewResumableUploadRepository() *InMemoryResumableUploadRepository {
+	return &InMemoryResumableUploadRepository{
+		uploads: make(map[uuid.UUID]*domain.ResumableUpload),
+	}
+}
+
+func (r *InMemoryResumableUploadRepository) Save(ctx context.Context, upload *domain.ResumableUpload) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.uploads[upload.ID] = upload
+	return nil
+}
+
+func (r *InMemoryResumableUploadRepository) Update(ctx context.Context, upload *domain.ResumableUpload) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.uploads[upload.ID]; !exists {
+		return domain.ErrUploadNotFound
+	}
+	r.uploads[upload.ID] = upload
+	return nil
+}
+
+func (r *InMemoryResumableUploadRepository) Edit(ctx context.Context, id uuid.UUID, edit func(upload *domain.ResumableUpload) error) (*domain.ResumableUpload, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	upload, exists := r.uploads[id]
+	if !exists {
+		return nil, domain.ErrUploadNotFound
+	}
+
+	// edit works on a copy, so a failed edit leaves the upload as it was
+	edited := *upload
+	edited.Parts = slices.Clone(upload.Parts)
+	if err := edit(&edited); err != nil {
+		return nil, err
+	}
+	r.uploads[id] = &edited
+	return &edited, nil
+}
+
+func (r *InMemoryResumableUploadRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.ResumableUpload, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	upload, exists := r.uploads[id]
+	if !exists {
+		return nil, domain.ErrUploadNotFound
+	}
+	return upload, nil
+}
+
+func (r *InMemoryResumableUploadRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.uploads, id)
+	return nil
+}
+
+func (r *InMemoryResumableUploadRepository) FindExpired(ctx context.Context, t time.Time) ([]*domain.ResumableUpload, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var expired []*domain.ResumableUpload
+	for _, upload := range r.uploads {
+		if upload.ExpiresAt.Before(t) {
+			expired = append(expired, upload)
+		}
+	}
+	return expired, nil
+}
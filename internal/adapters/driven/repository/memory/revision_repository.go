@@ -0,0 +1,90 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+// InMemoryPostRevisionRepository keeps the revisions of each post in the order they were saved.
+// It edits the posts of the post repository.
+type InMemoryPostRevisionRepository struct {
+	posts     *InMemoryPostRepository
+	revisions map[uuid.UUID][]*domain.PostRevision
+	mu        sync.RWMutex
+}
+
+var _ outports.PostRevisionRepository = (*InMemoryPostRevisionRepository)(nil)
+
+func NewPostRevisionRepository(posts *InMemoryPostRepository) *InMemoryPostRevisionRepository {
+	return &InMemoryPostRevisionRepository{
+		posts:     posts,
+		revisions: make(map[uuid.UUID][]*domain.PostRevision),
+	}
+}
+
+func (r *InMemoryPostRevisionRepository) Save(ctx context.Context, revision *domain.PostRevision) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	revision.Number = len(r.revisions[revision.PostID]) + 1
+	r.revisions[revision.PostID] = append(r.revisions[revision.PostID], copyRevision(revision))
+	return nil
+}
+
+// Edit holds the lock of the posts throughout, the lock of the revisions is taken after it
+func (r *InMemoryPostRevisionRepository) Edit(ctx context.Context, postID uuid.UUID, edit func(post *domain.Post, revisions int) ([]*domain.PostRevision, error)) (*domain.Post, error) {
+	r.posts.mu.Lock()
+	defer r.posts.mu.Unlock()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.posts.posts[postID]
+	if !exists {
+		return nil, domain.ErrPostNotFound
+	}
+	post := *stored
+	post.Tags = slices.Clone(stored.Tags)
+	revisions, err := edit(&post, len(r.revisions[postID]))
+	if err != nil {
+		return nil, err
+	}
+	if err := r.posts.update(&post); err != nil {
+		return nil, err
+	}
+	for _, revision := range revisions {
+		revision.Number = len(r.revisions[postID]) + 1
+		r.revisions[postID] = append(r.revisions[postID], copyRevision(revision))
+	}
+	return &post, nil
+}
+
+func (r *InMemoryPostRevisionRepository) List(ctx context.Context, postID uuid.UUID, limit, offset int) (*domain.PostRevisionList, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	revisions := r.revisions[postID]
+	list := &domain.PostRevisionList{Revisions: []*domain.PostRevision{}, Total: len(revisions)}
+	for i := len(revisions) - 1 - offset; i >= 0 && len(list.Revisions) < limit; i-- {
+		list.Revisions = append(list.Revisions, copyRevision(revisions[i]))
+	}
+	return list, nil
+}
+
+func (r *InMemoryPostRevisionRepository) FindByNumber(ctx context.Context, postID uuid.UUID, number int) (*domain.PostRevision, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	revisions := r.revisions[postID]
+	if number < 1 || number > len(revisions) {
+		return nil, domain.ErrRevisionNotFound
+	}
+	return copyRevision(revisions[number-1]), nil
+}
+
+func copyRevision(revision *domain.PostRevision) *domain.PostRevision {
+	c := *revision
+	c.Tags = slices.Clone(revision.Tags)
+	return &c
+}
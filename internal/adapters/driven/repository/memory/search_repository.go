@@ -0,0 +1,199 @@
+package memory
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+// Weights of the fields a term matches in, the defaults of Postgres ts_rank for A, B and C
+const (
+	titleWeight = 1.0
+	tagWeight   = 0.4
+	textWeight  = 0.2
+)
+
+// snippetWords is how many words of the body a snippet shows, starting a little before the first match
+const (
+	snippetWords  = 24
+	snippetBefore = 8
+)
+
+// InMemorySearchRepository scans the posts and images on every query. Unlike Postgres it does not
+// stem, so terms only match words they start.
+type InMemorySearchRepository struct {
+	posts  *InMemoryPostRepository
+	images *InMemoryImageRepository
+}
+
+var _ outports.SearchRepository = (*InMemorySearchRepository)(nil)
+
+func NewSearchRepository(posts *InMemoryPostRepository, images *InMemoryImageRepository) *InMemorySearchRepository {
+	return &InMemorySearchRepository{posts: posts, images: images}
+}
+
+func (r *InMemorySearchRepository) Search(ctx context.Context, query domain.SearchQuery) (*domain.SearchResults, error) {
+	type scored struct {
+		hit  domain.SearchHit
+		rank float64
+		at   time.Time
+	}
+	var matches []scored
+
+	r.posts.mu.RLock()
+	for _, post := range r.posts.posts {
+		if !post.IsPublished() {
+			continue
+		}
+		if rank, ok := rankMatch(query, post.Title, post.Tags, post.Excerpt+" "+postText(post)); ok {
+			c := *post
+			matches = append(matches, scored{hit: domain.SearchHit{Post: &c}, rank: rank, at: c.PublishedAt})
+		}
+	}
+	r.posts.mu.RUnlock()
+
+	r.images.mu.RLock()
+	for _, img := range r.images.images {
+		if !img.IsPublic() {
+			continue
+		}
+		if rank, ok := rankMatch(query, img.Description.Caption, img.Tags, imageText(img)); ok {
+			c := *img
+			matches = append(matches, scored{hit: domain.SearchHit{Image: &c}, rank: rank, at: c.CreatedAt})
+		}
+	}
+	r.images.mu.RUnlock()
+
+	slices.SortFunc(matches, func(a, b scored) int {
+		if a.rank != b.rank {
+			if a.rank > b.rank {
+				return -1
+			}
+			return 1
+		}
+		return b.at.Compare(a.at)
+	})
+
+	results := &domain.SearchResults{Hits: []domain.SearchHit{}, Total: len(matches)}
+	if query.Offset < len(matches) {
+		matches = matches[query.Offset:]
+		for _, m := range matches[:min(query.Limit, len(matches))] {
+			hit := m.hit
+			if hit.Post != nil {
+				hit.Title = highlight(hit.Post.Title, query)
+				hit.Snippet = snippet(postText(hit.Post), query)
+			} else {
+				hit.Title = highlight(hit.Image.Description.Caption, query)
+				hit.Snippet = snippet(imageText(hit.Image), query)
+			}
+			results.Hits = append(results.Hits, hit)
+		}
+	}
+	return results, nil
+}
+
+// rankMatch requires every term to start a word of the title, tags or text, each adding the
+// weight of the best field it is found in
+func rankMatch(query domain.SearchQuery, title string, tags []domain.Tag, text string) (float64, bool) {
+	tagNames := make([]string, len(tags))
+	for i, tag := range tags {
+		tagNames[i] = tag.Name
+	}
+	fields := []struct {
+		words  []string
+		weight float64
+	}{
+		{domain.SearchWords(title), titleWeight},
+		{domain.SearchWords(strings.Join(tagNames, " ")), tagWeight},
+		{domain.SearchWords(text), textWeight},
+	}
+
+	var rank float64
+	for _, term := range query.Terms {
+		found := false
+		for _, field := range fields {
+			if slices.ContainsFunc(field.words, func(word string) bool { return strings.HasPrefix(word, term) }) {
+				rank += field.weight
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, false
+		}
+	}
+	return rank, true
+}
+
+// imageText is the alternative text of an image in every locale, in the order of the locales
+func imageText(img *domain.Image) string {
+	locales := slices.Sorted(maps.Keys(img.Description.Alt))
+	texts := make([]string, len(locales))
+	for i, locale := range locales {
+		texts[i] = img.Description.Alt[locale]
+	}
+	return strings.Join(texts, " ")
+}
+
+// postText is the plain text of the body, or its Markdown for posts never rendered
+func postText(post *domain.Post) string {
+	if post.Text != "" {
+		return post.Text
+	}
+	return post.Body
+}
+
+// snippet cuts the words around the first match out of text, highlighted
+func snippet(text string, query domain.SearchQuery) string {
+	words := strings.Fields(text)
+	start := 0
+	for i, word := range words {
+		if slices.ContainsFunc(domain.SearchWords(word), query.Matches) {
+			start = max(i-snippetBefore, 0)
+			break
+		}
+	}
+	end := min(start+snippetWords, len(words))
+
+	cut := highlight(strings.Join(words[start:end], " "), query)
+	if start > 0 {
+		cut = "… " + cut
+	}
+	if end < len(words) {
+		cut += " …"
+	}
+	return cut
+}
+
+// highlight marks the words of text matching the query
+func highlight(text string, query domain.SearchQuery) string {
+	var b strings.Builder
+	isWordRune := func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }
+	for len(text) > 0 {
+		i := strings.IndexFunc(text, isWordRune)
+		if i < 0 {
+			b.WriteString(text)
+			break
+		}
+		b.WriteString(text[:i])
+		text = text[i:]
+		end := strings.IndexFunc(text, func(r rune) bool { return !isWordRune(r) })
+		if end < 0 {
+			end = len(text)
+		}
+		word := text[:end]
+		if query.Matches(strings.ToLower(word)) {
+			b.WriteString(domain.HighlightStart + word + domain.HighlightStop)
+		} else {
+			b.WriteString(word)
+		}
+		text = text[end:]
+	}
+	return b.String()
+}
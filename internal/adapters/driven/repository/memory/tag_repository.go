@@ -0,0 +1,170 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+// InMemoryTagRepository works on the tags held by the posts and images, a tag exists
+// as long as something carries it
+type InMemoryTagRepository struct {
+	posts  *InMemoryPostRepository
+	images *InMemoryImageRepository
+}
+
+var _ outports.TagRepository = (*InMemoryTagRepository)(nil)
+
+func NewTagRepository(posts *InMemoryPostRepository, images *InMemoryImageRepository) *InMemoryTagRepository {
+	return &InMemoryTagRepository{posts: posts, images: images}
+}
+
+func (r *InMemoryTagRepository) FindBySlug(ctx context.Context, slug string) (domain.Tag, error) {
+	unlock := r.lock(false)
+	defer unlock()
+	for tags := range r.tagLists() {
+		for _, tag := range *tags {
+			if tag.Slug == slug {
+				return tag, nil
+			}
+		}
+	}
+	return domain.Tag{}, domain.ErrTagNotFound
+}
+
+func (r *InMemoryTagRepository) Counts(ctx context.Context) ([]domain.TagCount, error) {
+	unlock := r.lock(false)
+	defer unlock()
+
+	counts := make(map[string]*domain.TagCount)
+	count := func(tags []domain.Tag) []*domain.TagCount {
+		result := make([]*domain.TagCount, len(tags))
+		for i, tag := range tags {
+			if counts[tag.Slug] == nil {
+				counts[tag.Slug] = &domain.TagCount{Tag: tag}
+			}
+			result[i] = counts[tag.Slug]
+		}
+		return result
+	}
+	for _, post := range r.posts.posts {
+		for _, c := range count(post.Tags) {
+			if post.IsPublished() {
+				c.Posts++
+			}
+		}
+	}
+	for _, img := range r.images.images {
+		for _, c := range count(img.Tags) {
+			if img.Visibility == domain.VisibilityPublic {
+				c.Images++
+			}
+		}
+	}
+
+	result := make([]domain.TagCount, 0, len(counts))
+	for _, c := range counts {
+		result = append(result, *c)
+	}
+	slices.SortFunc(result, func(a, b domain.TagCount) int { return strings.Compare(a.Name, b.Name) })
+	return result, nil
+}
+
+func (r *InMemoryTagRepository) Rename(ctx context.Context, slug string, tag domain.Tag) error {
+	unlock := r.lock(true)
+	defer unlock()
+
+	found := false
+	for tags := range r.tagLists() {
+		if hasTag(*tags, tag.Slug) && tag.Slug != slug {
+			return domain.ErrTagExists
+		}
+		found = found || hasTag(*tags, slug)
+	}
+	if !found {
+		return domain.ErrTagNotFound
+	}
+	r.replace([]string{slug}, tag)
+	return nil
+}
+
+func (r *InMemoryTagRepository) Merge(ctx context.Context, sources []string, target string) error {
+	unlock := r.lock(true)
+	defer unlock()
+
+	var into *domain.Tag
+	found := make(map[string]bool)
+	for tags := range r.tagLists() {
+		for _, tag := range *tags {
+			found[tag.Slug] = true
+			if tag.Slug == target {
+				into = &tag
+			}
+		}
+	}
+	if into == nil {
+		return domain.ErrTagNotFound
+	}
+	for _, slug := range sources {
+		if !found[slug] {
+			return domain.ErrTagNotFound
+		}
+	}
+	r.replace(append(sources, target), *into)
+	return nil
+}
+
+// replace swaps the tags with the given slugs for tag, slices are replaced rather than
+// modified since images are shared with callers
+func (r *InMemoryTagRepository) replace(slugs []string, tag domain.Tag) {
+	for tags := range r.tagLists() {
+		if !slices.ContainsFunc(*tags, func(t domain.Tag) bool { return slices.Contains(slugs, t.Slug) }) {
+			continue
+		}
+		replaced := slices.DeleteFunc(slices.Clone(*tags), func(t domain.Tag) bool { return slices.Contains(slugs, t.Slug) })
+		replaced = append(replaced, tag)
+		slices.SortFunc(replaced, func(a, b domain.Tag) int { return strings.Compare(a.Slug, b.Slug) })
+		*tags = replaced
+	}
+}
+
+// tagLists yields the tags of every post and image, the caller holds the locks
+func (r *InMemoryTagRepository) tagLists() func(yield func(*[]domain.Tag) bool) {
+	return func(yield func(*[]domain.Tag) bool) {
+		for _, post := range r.posts.posts {
+			if !yield(&post.Tags) {
+				return
+			}
+		}
+		for _, img := range r.images.images {
+			if !yield(&img.Tags) {
+				return
+			}
+		}
+	}
+}
+
+// lock takes the locks of both repositories, always in the same order
+func (r *InMemoryTagRepository) lock(write bool) (unlock func()) {
+	if write {
+		r.posts.mu.Lock()
+		r.images.mu.Lock()
+		return func() {
+			r.images.mu.Unlock()
+			r.posts.mu.Unlock()
+		}
+	}
+	r.posts.mu.RLock()
+	r.images.mu.RLock()
+	return func() {
+		r.images.mu.RUnlock()
+		r.posts.mu.RUnlock()
+	}
+}
+
+func hasTag(tags []domain.Tag, slug string) bool {
+	return slices.ContainsFunc(tags, func(t domain.Tag) bool { return t.Slug == slug })
+}
@@ -0,0 +1,61 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+type InMemoryUploadRepository struct {
+	uploads map[uuid.UUID]*domain.Upload
+	mu      sync.RWMutex
+}
+
+var _ outports.UploadRepository = (*InMemoryUploadRepository)(nil)
+
+func NewUploadRepository() *InMemoryUploadRepository {
+	return &InMemoryUploadRepository{
+		uploads: make(map[uuid.UUID]*domain.Upload),
+	}
+}
+
+func (r *InMemoryUploadRepository) Save(ctx context.Context, upload *domain.Upload) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.uploads[upload.ID] = upload
+	return nil
+}
+
+func (r *InMemoryUploadRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Upload, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	upload, exists := r.uploads[id]
+	if !exists {
+		return nil, domain.ErrUploadNotFound
+	}
+	return upload, nil
+}
+
+func (r *InMemoryUploadRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.uploads, id)
+	return nil
+}
+
+func (r *InMemoryUploadRepository) DeleteExpired(ctx context.Context, t time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	deleted := 0
+	for id, upload := range r.uploads {
+		if upload.ExpiresAt.Before(t) {
+			delete(r.uploads, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
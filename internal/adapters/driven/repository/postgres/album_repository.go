@@ -0,0 +1,154 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/album"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+type PostgresAlbumRepository struct {
+	client *ent.Client
+}
+
+var _ outports.AlbumRepository = (*PostgresAlbumRepository)(nil)
+
+func NewAlbumRepository(client *ent.Client) *PostgresAlbumRepository {
+	return &PostgresAlbumRepository{client: client}
+}
+
+func (r *PostgresAlbumRepository) Save(ctx context.Context, a *domain.Album) error {
+	_, err := r.client.Album.Create().
+		SetID(a.ID).
+		SetOwnerID(a.OwnerID).
+		SetSlug(a.Slug).
+		SetTitle(a.Title).
+		SetDescription(a.Description).
+		SetVisibility(string(a.Visibility)).
+		SetCoverImageID(a.CoverImageID).
+		SetImageIds(a.ImageIDs).
+		SetCreatedAt(a.CreatedAt).
+		SetUpdatedAt(a.UpdatedAt).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return domain.ErrSlugTaken
+	}
+	return err
+}
+
+func (r *PostgresAlbumRepository) Edit(ctx context.Context, id uuid.UUID, edit func(album *domain.Album) error) (*domain.Album, error) {
+	var edited *domain.Album
+	err := withTx(ctx, r.client, func(tx *ent.Tx) error {
+		if _, err := tx.ExecContext(ctx, "SELECT 1 FROM albums WHERE id = $1 FOR UPDATE", id); err != nil {
+			return err
+		}
+		a, err := tx.Album.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		edited = toDomainAlbum(a)
+		if err := edit(edited); err != nil {
+			return err
+		}
+		return tx.Album.UpdateOneID(id).
+			SetSlug(edited.Slug).
+			SetTitle(edited.Title).
+			SetDescription(edited.Description).
+			SetVisibility(string(edited.Visibility)).
+			SetCoverImageID(edited.CoverImageID).
+			SetImageIds(edited.ImageIDs).
+			SetUpdatedAt(edited.UpdatedAt).
+			Exec(ctx)
+	})
+	if ent.IsConstraintError(err) {
+		return nil, domain.ErrSlugTaken
+	}
+	if ent.IsNotFound(err) {
+		return nil, domain.ErrAlbumNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return edited, nil
+}
+
+func (r *PostgresAlbumRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Album, error) {
+	a, err := r.client.Album.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, domain.ErrAlbumNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainAlbum(a), nil
+}
+
+func (r *PostgresAlbumRepository) FindBySlug(ctx context.Context, slug string) (*domain.Album, error) {
+	a, err := r.client.Album.Query().
+		Where(album.Slug(slug)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, domain.ErrAlbumNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainAlbum(a), nil
+}
+
+func (r *PostgresAlbumRepository) FindByOwner(ctx context.Context, ownerID uuid.UUID) ([]*domain.Album, error) {
+	albums, err := r.client.Album.Query().
+		Where(album.OwnerID(ownerID)).
+		Order(ent.Desc(album.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*domain.Album, len(albums))
+	for i, a := range albums {
+		result[i] = toDomainAlbum(a)
+	}
+	return result, nil
+}
+
+func (r *PostgresAlbumRepository) FindPublic(ctx context.Context) ([]*domain.Album, error) {
+	albums, err := r.client.Album.Query().
+		Where(album.Visibility(string(domain.VisibilityPublic))).
+		Order(ent.Desc(album.FieldUpdatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*domain.Album, len(albums))
+	for i, a := range albums {
+		result[i] = toDomainAlbum(a)
+	}
+	return result, nil
+}
+
+func (r *PostgresAlbumRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.client.Album.DeleteOneID(id).Exec(ctx)
+}
+
+func toDomainAlbum(a *ent.Album) *domain.Album {
+	imageIDs := a.ImageIds
+	if imageIDs == nil {
+		imageIDs = []uuid.UUID{}
+	}
+	return &domain.Album{
+		ID:           a.ID,
+		OwnerID:      a.OwnerID,
+		Slug:         a.Slug,
+		Title:        a.Title,
+		Description:  a.Description,
+		Visibility:   domain.Visibility(a.Visibility),
+		CoverImageID: a.CoverImageID,
+		ImageIDs:     imageIDs,
+		CreatedAt:    a.CreatedAt,
+		UpdatedAt:    a.UpdatedAt,
+	}
+}
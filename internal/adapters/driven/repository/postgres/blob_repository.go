@@ -0,0 +1,140 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/blob"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+type PostgresBlobRepository struct {
+	client *ent.Client
+}
+
+var _ outports.BlobRepository = (*PostgresBlobRepository)(nil)
+
+func NewBlobRepository(client *ent.Client) *PostgresBlobRepository {
+	return &PostgresBlobRepository{client: client}
+}
+
+func (r *PostgresBlobRepository) Create(ctx context.Context, b *domain.Blob) error {
+	_, err := r.client.Blob.Create().
+		SetID(b.Name).
+		SetChecksum(b.Checksum).
+		SetContentType(b.ContentType).
+		SetSize(b.Size).
+		SetURL(b.URL).
+		SetRefCount(b.RefCount).
+		SetCreatedAt(b.CreatedAt).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return domain.ErrBlobExists
+	}
+	return err
+}
+
+func (r *PostgresBlobRepository) FindAll(ctx context.Context) ([]*domain.Blob, error) {
+	blobs, err := r.client.Blob.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*domain.Blob, len(blobs))
+	for i, b := range blobs {
+		result[i] = toDomainBlob(b)
+	}
+	return result, nil
+}
+
+// Acquire increments in SQL so concurrent uploads of the same file do not lose references
+func (r *PostgresBlobRepository) Acquire(ctx context.Context, name string) (*domain.Blob, error) {
+	n, err := r.client.Blob.Update().
+		Where(blob.ID(name)).
+		AddRefCount(1).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, domain.ErrBlobNotFound
+	}
+
+	b, err := r.client.Blob.Get(ctx, name)
+	if ent.IsNotFound(err) {
+		return nil, domain.ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainBlob(b), nil
+}
+
+func (r *PostgresBlobRepository) UpdateURL(ctx context.Context, name, url string) error {
+	err := r.client.Blob.UpdateOneID(name).SetURL(url).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return domain.ErrBlobNotFound
+	}
+	return err
+}
+
+func (r *PostgresBlobRepository) Release(ctx context.Context, name string, deleteFile func() error) (int, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	remaining, err := release(ctx, tx, name)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back: %v", err, rerr)
+		}
+		return 0, err
+	}
+
+	// The decrement holds the row lock until commit, a concurrent Acquire blocks on it and then finds
+	// no blob, so its upload stores the file again after it was deleted here
+	var deleteErr error
+	if remaining == 0 {
+		deleteErr = deleteFile()
+	}
+	return remaining, errors.Join(tx.Commit(), deleteErr)
+}
+
+func release(ctx context.Context, tx *ent.Tx, name string) (int, error) {
+	n, err := tx.Blob.Update().
+		Where(blob.ID(name), blob.RefCountGT(0)).
+		AddRefCount(-1).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if n == 0 {
+		return 0, domain.ErrBlobNotFound
+	}
+
+	b, err := tx.Blob.Get(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+	if b.RefCount == 0 {
+		if err := tx.Blob.DeleteOneID(name).Exec(ctx); err != nil {
+			return 0, err
+		}
+	}
+	return b.RefCount, nil
+}
+
+func toDomainBlob(b *ent.Blob) *domain.Blob {
+	return &domain.Blob{
+		Name:        b.ID,
+		Checksum:    b.Checksum,
+		ContentType: b.ContentType,
+		Size:        b.Size,
+		URL:         b.URL,
+		RefCount:    b.RefCount,
+		CreatedAt:   b.CreatedAt,
+	}
+}
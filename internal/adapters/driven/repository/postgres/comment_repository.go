@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/comment"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+type PostgresCommentRepository struct {
+	client *ent.Client
+}
+
+var _ outports.CommentRepository = (*PostgresCommentRepository)(nil)
+
+func NewCommentRepository(client *ent.Client) *PostgresCommentRepository {
+	return &PostgresCommentRepository{client: client}
+}
+
+func (r *PostgresCommentRepository) Save(ctx context.Context, c *domain.Comment) error {
+	create := r.client.Comment.Create().
+		SetID(c.ID).
+		SetPostID(c.PostID).
+		SetAuthorName(c.AuthorName).
+		SetAuthorEmail(c.AuthorEmail).
+		SetBody(c.Body).
+		SetStatus(string(c.Status)).
+		SetCreatedAt(c.CreatedAt).
+		SetUpdatedAt(c.UpdatedAt)
+	if c.ParentID != uuid.Nil {
+		create.SetParentID(c.ParentID)
+	}
+	if c.AuthorID != uuid.Nil {
+		create.SetAuthorID(c.AuthorID)
+	}
+	return create.Exec(ctx)
+}
+
+// Update only stores the moderation status, the content of comments is never edited
+func (r *PostgresCommentRepository) Update(ctx context.Context, c *domain.Comment) error {
+	err := r.client.Comment.UpdateOneID(c.ID).
+		SetStatus(string(c.Status)).
+		SetUpdatedAt(c.UpdatedAt).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		return domain.ErrCommentNotFound
+	}
+	return err
+}
+
+func (r *PostgresCommentRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Comment, error) {
+	c, err := r.client.Comment.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, domain.ErrCommentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainComment(c), nil
+}
+
+func (r *PostgresCommentRepository) FindByPost(ctx context.Context, postID uuid.UUID) ([]*domain.Comment, error) {
+	comments, err := r.client.Comment.Query().
+		Where(comment.PostID(postID)).
+		Order(ent.Asc(comment.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainComments(comments), nil
+}
+
+func (r *PostgresCommentRepository) List(ctx context.Context, filter domain.CommentFilter) (*domain.CommentList, error) {
+	query := r.client.Comment.Query()
+	if filter.Status != "" {
+		query = query.Where(comment.Status(string(filter.Status)))
+	}
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	comments, err := query.
+		Order(ent.Desc(comment.FieldCreatedAt)).
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.CommentList{Comments: toDomainComments(comments), Total: total}, nil
+}
+
+func toDomainComments(comments []*ent.Comment) []*domain.Comment {
+	result := make([]*domain.Comment, len(comments))
+	for i, c := range comments {
+		result[i] = toDomainComment(c)
+	}
+	return result
+}
+
+func toDomainComment(c *ent.Comment) *domain.Comment {
+	var parentID uuid.UUID
+	if c.ParentID != nil {
+		parentID = *c.ParentID
+	}
+	return &domain.Comment{
+		ID:          c.ID,
+		PostID:      c.PostID,
+		ParentID:    parentID,
+		AuthorID:    c.AuthorID,
+		AuthorName:  c.AuthorName,
+		AuthorEmail: c.AuthorEmail,
+		Body:        c.Body,
+		Status:      domain.CommentStatus(c.Status),
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+	}
+}
@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/contactmessage"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+type PostgresContactRepository struct {
+	client *ent.Client
+}
+
+var _ outports.ContactRepository = (*PostgresContactRepository)(nil)
+
+func NewContactRepository(client *ent.Client) *PostgresContactRepository {
+	return &PostgresContactRepository{client: client}
+}
+
+func (r *PostgresContactRepository) Save(ctx context.Context, m *domain.ContactMessage) error {
+	return r.client.ContactMessage.Create().
+		SetID(m.ID).
+		SetName(m.Name).
+		SetEmail(m.Email).
+		SetSubject(m.Subject).
+		SetMessage(m.Message).
+		SetCreatedAt(m.CreatedAt).
+		Exec(ctx)
+}
+
+func (r *PostgresContactRepository) List(ctx context.Context, limit, offset int) (*domain.ContactMessageList, error) {
+	total, err := r.client.ContactMessage.Query().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	messages, err := r.client.ContactMessage.Query().
+		Order(ent.Desc(contactmessage.FieldCreatedAt)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list := &domain.ContactMessageList{Messages: make([]*domain.ContactMessage, len(messages)), Total: total}
+	for i, m := range messages {
+		list.Messages[i] = toDomainContactMessage(m)
+	}
+	return list, nil
+}
+
+func (r *PostgresContactRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	err := r.client.ContactMessage.DeleteOneID(id).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return domain.ErrContactMessageNotFound
+	}
+	return err
+}
+
+func toDomainContactMessage(m *ent.ContactMessage) *domain.ContactMessage {
+	return &domain.ContactMessage{
+		ID:        m.ID,
+		Name:      m.Name,
+		Email:     m.Email,
+		Subject:   m.Subject,
+		Message:   m.Message,
+		CreatedAt: m.CreatedAt,
+	}
+}
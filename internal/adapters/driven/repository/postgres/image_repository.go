@@ -0,0 +1,171 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/image"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+type PostgresImageRepository struct {
+	client *ent.Client
+}
+
+var _ outports.ImageRepository = (*PostgresImageRepository)(nil)
+
+func NewImageRepository(client *ent.Client) *PostgresImageRepository {
+	return &PostgresImageRepository{client: client}
+}
+
+func (r *PostgresImageRepository) Save(ctx context.Context, img *domain.Image) error {
+	_, err := r.client.Image.Create().
+		SetID(img.ID).
+		SetOwnerID(img.OwnerID).
+		SetVisibility(string(img.Visibility)).
+		SetOriginalName(img.OriginalName).
+		SetStoredName(img.StoredName).
+		SetContentType(img.ContentType).
+		SetSize(img.Size).
+		SetChecksum(img.Checksum).
+		SetURL(img.URL).
+		SetWidth(img.Preview.Width).
+		SetHeight(img.Preview.Height).
+		SetBlurHash(img.Preview.BlurHash).
+		SetDominantColor(img.Preview.DominantColor).
+		SetAlt(img.Description.Alt).
+		SetCaption(img.Description.Caption).
+		SetCredit(img.Description.Credit).
+		SetLicense(img.Description.License).
+		SetCreatedAt(img.CreatedAt).
+		Save(ctx)
+	return err
+}
+
+// Update saves the description and tags, the rest of an image does not change after upload
+func (r *PostgresImageRepository) Update(ctx context.Context, img *domain.Image) error {
+	err := withTx(ctx, r.client, func(tx *ent.Tx) error {
+		tagIDs, err := resolveTags(ctx, tx, img.Tags)
+		if err != nil {
+			return err
+		}
+		return tx.Image.UpdateOneID(img.ID).
+			SetAlt(img.Description.Alt).
+			SetCaption(img.Description.Caption).
+			SetCredit(img.Description.Credit).
+			SetLicense(img.Description.License).
+			ClearTags().
+			AddTagIDs(tagIDs...).
+			Exec(ctx)
+	})
+	if ent.IsNotFound(err) {
+		return domain.ErrImageNotFound
+	}
+	return err
+}
+
+func (r *PostgresImageRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Image, error) {
+	img, err := r.client.Image.Query().
+		Where(image.ID(id)).
+		WithTags().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, domain.ErrImageNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainImage(img), nil
+}
+
+func (r *PostgresImageRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Image, error) {
+	images, err := r.client.Image.Query().
+		Where(image.IDIn(ids...)).
+		WithTags().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*domain.Image, len(images))
+	for i, img := range images {
+		result[i] = toDomainImage(img)
+	}
+	return result, nil
+}
+
+func (r *PostgresImageRepository) FindAll(ctx context.Context) ([]*domain.Image, error) {
+	images, err := r.client.Image.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*domain.Image, len(images))
+	for i, img := range images {
+		result[i] = toDomainImage(img)
+	}
+	return result, nil
+}
+
+func (r *PostgresImageRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.client.Image.DeleteOneID(id).Exec(ctx)
+}
+
+func (r *PostgresImageRepository) UpdateURL(ctx context.Context, storedName string, visibility domain.Visibility, url string) (int, error) {
+	return r.client.Image.Update().
+		Where(image.StoredName(storedName), image.Visibility(string(visibility)), image.URLNEQ(url)).
+		SetURL(url).
+		Save(ctx)
+}
+
+func (r *PostgresImageRepository) Rename(ctx context.Context, oldName string, visibility domain.Visibility, newName, url string) (int, error) {
+	return r.client.Image.Update().
+		Where(image.StoredName(oldName), image.Visibility(string(visibility))).
+		SetStoredName(newName).
+		SetURL(url).
+		Save(ctx)
+}
+
+func (r *PostgresImageRepository) Usage(ctx context.Context, ownerID uuid.UUID) (domain.StorageUsage, error) {
+	var rows []struct {
+		Count int           `json:"count"`
+		Sum   sql.NullInt64 `json:"sum"` // NULL when the owner has no images
+	}
+	err := r.client.Image.Query().
+		Where(image.OwnerID(ownerID)).
+		Aggregate(ent.Count(), ent.As(ent.Sum(image.FieldSize), "sum")).
+		Scan(ctx, &rows)
+	if err != nil || len(rows) == 0 {
+		return domain.StorageUsage{}, err
+	}
+	return domain.StorageUsage{Bytes: rows[0].Sum.Int64, Files: rows[0].Count}, nil
+}
+
+func toDomainImage(img *ent.Image) *domain.Image {
+	return &domain.Image{
+		ID:           img.ID,
+		OwnerID:      img.OwnerID,
+		Visibility:   domain.Visibility(img.Visibility),
+		OriginalName: img.OriginalName,
+		StoredName:   img.StoredName,
+		ContentType:  img.ContentType,
+		Size:         img.Size,
+		Checksum:     img.Checksum,
+		URL:          img.URL,
+		Preview: domain.ImagePreview{
+			Width:         img.Width,
+			Height:        img.Height,
+			BlurHash:      img.BlurHash,
+			DominantColor: img.DominantColor,
+		},
+		Description: domain.ImageDescription{
+			Alt:     img.Alt,
+			Caption: img.Caption,
+			Credit:  img.Credit,
+			License: img.License,
+		},
+		Tags:      toDomainTags(img.Edges.Tags),
+		CreatedAt: img.CreatedAt,
+	}
+}
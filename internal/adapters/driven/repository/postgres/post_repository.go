@@ -0,0 +1,258 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/post"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/postslug"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/tag"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+type PostgresPostRepository struct {
+	client *ent.Client
+}
+
+var _ outports.PostRepository = (*PostgresPostRepository)(nil)
+
+func NewPostRepository(client *ent.Client) *PostgresPostRepository {
+	return &PostgresPostRepository{client: client}
+}
+
+func (r *PostgresPostRepository) Save(ctx context.Context, p *domain.Post) error {
+	err := withTx(ctx, r.client, func(tx *ent.Tx) error {
+		tagIDs, err := resolveTags(ctx, tx, p.Tags)
+		if err != nil {
+			return err
+		}
+		return r.create(ctx, tx, p).AddTagIDs(tagIDs...).Exec(ctx)
+	})
+	if ent.IsConstraintError(err) {
+		return domain.ErrSlugTaken
+	}
+	return err
+}
+
+func (r *PostgresPostRepository) create(ctx context.Context, tx *ent.Tx, p *domain.Post) *ent.PostCreate {
+	create := tx.Post.Create().
+		SetID(p.ID).
+		SetSlug(p.Slug).
+		SetTitle(p.Title).
+		SetBody(p.Body).
+		SetHTML(p.HTML).
+		SetText(p.Text).
+		SetExcerpt(p.Excerpt).
+		SetSummary(p.Summary).
+		SetStatus(string(p.Status)).
+		SetNillablePublishAt(nillableTime(p.PublishAt)).
+		SetNillablePublishedAt(nillableTime(p.PublishedAt)).
+		SetCreatedAt(p.CreatedAt).
+		SetUpdatedAt(p.UpdatedAt)
+	if p.AuthorID != uuid.Nil {
+		create.SetAuthorID(p.AuthorID)
+	}
+	return create
+}
+
+func (r *PostgresPostRepository) Update(ctx context.Context, p *domain.Post) error {
+	err := withTx(ctx, r.client, func(tx *ent.Tx) error {
+		return updatePost(ctx, tx, p)
+	})
+	if ent.IsConstraintError(err) {
+		return domain.ErrSlugTaken
+	}
+	if ent.IsNotFound(err) {
+		return domain.ErrPostNotFound
+	}
+	return err
+}
+
+func updatePost(ctx context.Context, tx *ent.Tx, p *domain.Post) error {
+	oldSlug, err := tx.Post.Query().
+		Where(post.ID(p.ID)).
+		Select(post.FieldSlug).
+		String(ctx)
+	if err != nil {
+		return err
+	}
+	tagIDs, err := resolveTags(ctx, tx, p.Tags)
+	if err != nil {
+		return err
+	}
+	if err := postUpdate(tx, p).ClearTags().AddTagIDs(tagIDs...).Exec(ctx); err != nil {
+		return err
+	}
+	if oldSlug == p.Slug {
+		return nil
+	}
+	return renameSlug(ctx, tx, p.ID, oldSlug, p.Slug)
+}
+
+// renameSlug records the old slug of the post, taking it from whichever post gave it up before.
+// The new slug now leads to the post itself, it is no longer an old one.
+func renameSlug(ctx context.Context, tx *ent.Tx, postID uuid.UUID, oldSlug, newSlug string) error {
+	_, err := tx.PostSlug.Delete().
+		Where(postslug.SlugIn(oldSlug, newSlug)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return tx.PostSlug.Create().
+		SetSlug(oldSlug).
+		SetPostID(postID).
+		Exec(ctx)
+}
+
+func postUpdate(tx *ent.Tx, p *domain.Post) *ent.PostUpdateOne {
+	update := tx.Post.UpdateOneID(p.ID).
+		SetSlug(p.Slug).
+		SetTitle(p.Title).
+		SetBody(p.Body).
+		SetHTML(p.HTML).
+		SetText(p.Text).
+		SetExcerpt(p.Excerpt).
+		SetSummary(p.Summary).
+		SetStatus(string(p.Status)).
+		SetUpdatedAt(p.UpdatedAt)
+	if p.PublishAt.IsZero() {
+		update.ClearPublishAt()
+	} else {
+		update.SetPublishAt(p.PublishAt)
+	}
+	if p.PublishedAt.IsZero() {
+		update.ClearPublishedAt()
+	} else {
+		update.SetPublishedAt(p.PublishedAt)
+	}
+	return update
+}
+
+func (r *PostgresPostRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Post, error) {
+	p, err := r.client.Post.Query().
+		Where(post.ID(id)).
+		WithTags().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, domain.ErrPostNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainPost(p), nil
+}
+
+func (r *PostgresPostRepository) FindBySlug(ctx context.Context, slug string) (*domain.Post, error) {
+	p, err := r.client.Post.Query().
+		Where(post.Slug(slug)).
+		WithTags().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, domain.ErrPostNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainPost(p), nil
+}
+
+func (r *PostgresPostRepository) FindByOldSlug(ctx context.Context, slug string) (*domain.Post, error) {
+	p, err := r.client.PostSlug.Query().
+		Where(postslug.Slug(slug)).
+		QueryPost().
+		WithTags().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, domain.ErrPostNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainPost(p), nil
+}
+
+func (r *PostgresPostRepository) List(ctx context.Context, filter domain.PostFilter) (*domain.PostList, error) {
+	query := r.client.Post.Query()
+	if filter.Status != "" {
+		query = query.Where(post.Status(string(filter.Status)))
+	}
+	if filter.Tag != "" {
+		query = query.Where(post.HasTagsWith(tag.Slug(filter.Tag)))
+	}
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Postgres sorts NULLs first in descending order, so unpublished posts lead
+	posts, err := query.
+		Order(ent.Desc(post.FieldPublishedAt), ent.Desc(post.FieldUpdatedAt)).
+		WithTags().
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list := &domain.PostList{Posts: make([]*domain.Post, len(posts)), Total: total}
+	for i, p := range posts {
+		list.Posts[i] = toDomainPost(p)
+	}
+	return list, nil
+}
+
+func (r *PostgresPostRepository) FindDue(ctx context.Context, now time.Time) ([]*domain.Post, error) {
+	posts, err := r.client.Post.Query().
+		Where(post.Status(string(domain.PostScheduled)), post.PublishAtLTE(now)).
+		Order(ent.Asc(post.FieldPublishAt)).
+		WithTags().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*domain.Post, len(posts))
+	for i, p := range posts {
+		result[i] = toDomainPost(p)
+	}
+	return result, nil
+}
+
+func (r *PostgresPostRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.client.Post.DeleteOneID(id).Exec(ctx)
+}
+
+func nillableTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func toDomainPost(p *ent.Post) *domain.Post {
+	var publishAt, publishedAt time.Time
+	if p.PublishAt != nil {
+		publishAt = *p.PublishAt
+	}
+	if p.PublishedAt != nil {
+		publishedAt = *p.PublishedAt
+	}
+	return &domain.Post{
+		ID:          p.ID,
+		AuthorID:    p.AuthorID,
+		Slug:        p.Slug,
+		Title:       p.Title,
+		Body:        p.Body,
+		HTML:        p.HTML,
+		Text:        p.Text,
+		Excerpt:     p.Excerpt,
+		Summary:     p.Summary,
+		Tags:        toDomainTags(p.Edges.Tags),
+		Status:      domain.PostStatus(p.Status),
+		PublishAt:   publishAt,
+		PublishedAt: publishedAt,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+	}
+}
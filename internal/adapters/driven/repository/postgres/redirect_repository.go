@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/redirect"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+type PostgresRedirectRepository struct {
+	client *ent.Client
+}
+
+var _ outports.RedirectRepository = (*PostgresRedirectRepository)(nil)
+
+func NewRedirectRepository(client *ent.Client) *PostgresRedirectRepository {
+	return &PostgresRedirectRepository{client: client}
+}
+
+func (r *PostgresRedirectRepository) Save(ctx context.Context, rd *domain.Redirect) error {
+	err := r.client.Redirect.Create().
+		SetID(rd.ID).
+		SetPath(rd.From).
+		SetTarget(rd.To).
+		SetCode(rd.Code).
+		SetCreatedAt(rd.CreatedAt).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		return domain.ErrRedirectExists
+	}
+	return err
+}
+
+func (r *PostgresRedirectRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	err := r.client.Redirect.DeleteOneID(id).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return domain.ErrRedirectNotFound
+	}
+	return err
+}
+
+func (r *PostgresRedirectRepository) GetByPath(ctx context.Context, path string) (*domain.Redirect, error) {
+	rd, err := r.client.Redirect.Query().Where(redirect.Path(path)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, domain.ErrRedirectNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainRedirect(rd), nil
+}
+
+func (r *PostgresRedirectRepository) List(ctx context.Context, limit, offset int) (*domain.RedirectList, error) {
+	total, err := r.client.Redirect.Query().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	redirects, err := r.client.Redirect.Query().
+		Order(ent.Asc(redirect.FieldPath)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.RedirectList{Redirects: toDomainRedirects(redirects), Total: total}, nil
+}
+
+func (r *PostgresRedirectRepository) All(ctx context.Context) ([]*domain.Redirect, error) {
+	redirects, err := r.client.Redirect.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainRedirects(redirects), nil
+}
+
+func toDomainRedirects(redirects []*ent.Redirect) []*domain.Redirect {
+	result := make([]*domain.Redirect, len(redirects))
+	for i, rd := range redirects {
+		result[i] = toDomainRedirect(rd)
+	}
+	return result
+}
+
+func toDomainRedirect(rd *ent.Redirect) *domain.Redirect {
+	return &domain.Redirect{
+		ID:        rd.ID,
+		From:      rd.Path,
+		To:        rd.Target,
+		Code:      rd.Code,
+		CreatedAt: rd.CreatedAt,
+	}
+}
@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/resumableupload"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/schema"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+type PostgresResumableUploadRepository struct {
+	client *ent.Client
+}
+
+var _ outports.ResumableUploadRepository = (*PostgresResumableUploadRepository)(nil)
+
+func NewResumableUploadRepository(client *ent.Client) *PostgresResumableUploadRepository {
+	return &PostgresResumableUploadRepository{client: client}
+}
+
+func (r *PostgresResumableUploadRepository) Save(ctx context.Context, u *domain.ResumableUpload) error {
+	_, err := r.client.ResumableUpload.Create().
+		SetID(u.ID).
+		SetOwnerID(u.OwnerID).
+		SetLength(u.Length).
+		SetOffset(u.Offset).
+		SetMetadata(u.Metadata).
+		SetObjectName(u.ObjectName).
+		SetMultipartID(u.MultipartID).
+		SetParts(toSchemaParts(u.Parts)).
+		SetImageID(u.ImageID).
+		SetExpiresAt(u.ExpiresAt).
+		SetCreatedAt(u.CreatedAt).
+		Save(ctx)
+	return err
+}
+
+func (r *PostgresResumableUploadRepository) Update(ctx context.Context, u *domain.ResumableUpload) error {
+	return resumableUploadUpdate(r.client.ResumableUpload, u).Exec(ctx)
+}
+
+func (r *PostgresResumableUploadRepository) Edit(ctx context.Context, id uuid.UUID, edit func(upload *domain.ResumableUpload) error) (*domain.ResumableUpload, error) {
+	var edited *domain.ResumableUpload
+	err := withTx(ctx, r.client, func(tx *ent.Tx) error {
+		if _, err := tx.ExecContext(ctx, "SELECT 1 FROM resumable_uploads WHERE id = $1 FOR UPDATE", id); err != nil {
+			return err
+		}
+		u, err := tx.ResumableUpload.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		edited = toDomainResumableUpload(u)
+		if err := edit(edited); err != nil {
+			return err
+		}
+		return resumableUploadUpdate(tx.ResumableUpload, edited).Exec(ctx)
+	})
+	if ent.IsNotFound(err) {
+		return nil, domain.ErrUploadNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return edited, nil
+}
+
+func resumableUploadUpdate(client *ent.ResumableUploadClient, u *domain.ResumableUpload) *ent.ResumableUploadUpdateOne {
+	return client.UpdateOneID(u.ID).
+		SetOffset(u.Offset).
+		SetMultipartID(u.MultipartID).
+		SetParts(toSchemaParts(u.Parts)).
+		SetImageID(u.ImageID).
+		SetExpiresAt(u.ExpiresAt)
+}
+
+func (r *PostgresResumableUploadRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.ResumableUpload, error) {
+	u, err := r.client.ResumableUpload.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, domain.ErrUploadNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainResumableUpload(u), nil
+}
+
+func (r *PostgresResumableUploadRepository) FindExpired(ctx context.Context, t time.Time) ([]*domain.ResumableUpload, error) {
+	uploads, err := r.client.ResumableUpload.Query().
+		Where(resumableupload.ExpiresAtLT(t)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*domain.ResumableUpload, len(uploads))
+	for i, u := range uploads {
+		result[i] = toDomainResumableUpload(u)
+	}
+	return result, nil
+}
+
+func (r *PostgresResumableUploadRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.client.ResumableUpload.DeleteOneID(id).Exec(ctx)
+}
+
+func toSchemaParts(parts []domain.UploadPart) []schema.UploadPart {
+	out := make([]schema.UploadPart, len(parts))
+	for i, p := range parts {
+		out[i] = schema.UploadPart{Number: p.Number, ETag: p.ETag, Size: p.Size}
+	}
+	return out
+}
+
+func toDomainResumableUpload(u *ent.ResumableUpload) *domain.ResumableUpload {
+	parts := make([]domain.UploadPart, len(u.Parts))
+	for i, p := range u.Parts {
+		parts[i] = domain.UploadPart{Number: p.Number, ETag: p.ETag, Size: p.Size}
+	}
+	return &domain.ResumableUpload{
+		ID:          u.ID,
+		OwnerID:     u.OwnerID,
+		Length:      u.Length,
+		Offset:      u.Offset,
+		Metadata:    u.Metadata,
+		ObjectName:  u.ObjectName,
+		MultipartID: u.MultipartID,
+		Parts:       parts,
+		ImageID:     u.ImageID,
+		ExpiresAt:   u.ExpiresAt,
+		CreatedAt:   u.CreatedAt,
+	}
+}
@@ -0,0 +1,183 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/post"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/postrevision"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+type PostgresPostRevisionRepository struct {
+	client *ent.Client
+}
+
+var _ outports.PostRevisionRepository = (*PostgresPostRevisionRepository)(nil)
+
+func NewPostRevisionRepository(client *ent.Client) *PostgresPostRevisionRepository {
+	return &PostgresPostRevisionRepository{client: client}
+}
+
+// Save locks the post row, so concurrent edits of a post take the following numbers
+func (r *PostgresPostRevisionRepository) Save(ctx context.Context, rev *domain.PostRevision) error {
+	err := withTx(ctx, r.client, func(tx *ent.Tx) error {
+		if err := lockPost(ctx, tx, rev.PostID); err != nil {
+			return err
+		}
+		return saveRevision(ctx, tx, rev)
+	})
+	// The post is gone, with the lock held nothing else can break the constraints
+	if ent.IsConstraintError(err) {
+		return domain.ErrPostNotFound
+	}
+	return err
+}
+
+// Edit holds the post row lock from reading the post to saving its revisions, so concurrent edits
+// neither overwrite each other nor number their revisions out of order
+func (r *PostgresPostRevisionRepository) Edit(ctx context.Context, postID uuid.UUID, edit func(post *domain.Post, revisions int) ([]*domain.PostRevision, error)) (*domain.Post, error) {
+	var edited *domain.Post
+	err := withTx(ctx, r.client, func(tx *ent.Tx) error {
+		if err := lockPost(ctx, tx, postID); err != nil {
+			return err
+		}
+		p, err := tx.Post.Query().
+			Where(post.ID(postID)).
+			WithTags().
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		count, err := tx.PostRevision.Query().Where(postrevision.PostID(postID)).Count(ctx)
+		if err != nil {
+			return err
+		}
+
+		edited = toDomainPost(p)
+		revisions, err := edit(edited, count)
+		if err != nil {
+			return err
+		}
+		if err := updatePost(ctx, tx, edited); err != nil {
+			return err
+		}
+		for _, rev := range revisions {
+			if err := saveRevision(ctx, tx, rev); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	// The post is locked, only its new slug can break a constraint
+	if ent.IsConstraintError(err) {
+		return nil, domain.ErrSlugTaken
+	}
+	if ent.IsNotFound(err) {
+		return nil, domain.ErrPostNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return edited, nil
+}
+
+func lockPost(ctx context.Context, tx *ent.Tx, postID uuid.UUID) error {
+	_, err := tx.ExecContext(ctx, "SELECT 1 FROM posts WHERE id = $1 FOR UPDATE", postID)
+	return err
+}
+
+// saveRevision numbers the revision after the last one of its post, the caller holds the post row lock
+func saveRevision(ctx context.Context, tx *ent.Tx, rev *domain.PostRevision) error {
+	last, err := tx.PostRevision.Query().
+		Where(postrevision.PostID(rev.PostID)).
+		Order(ent.Desc(postrevision.FieldNumber)).
+		Limit(1).
+		Select(postrevision.FieldNumber).
+		Ints(ctx)
+	if err != nil {
+		return err
+	}
+	number := 1
+	if len(last) > 0 {
+		number = last[0] + 1
+	}
+
+	create := tx.PostRevision.Create().
+		SetID(rev.ID).
+		SetPostID(rev.PostID).
+		SetNumber(number).
+		SetTitle(rev.Title).
+		SetSlug(rev.Slug).
+		SetBody(rev.Body).
+		SetExcerpt(rev.Excerpt).
+		SetTags(rev.Tags).
+		SetStatus(string(rev.Status)).
+		SetRestoredFrom(rev.RestoredFrom).
+		SetCreatedAt(rev.CreatedAt)
+	if rev.EditorID != uuid.Nil {
+		create.SetEditorID(rev.EditorID)
+	}
+	if err := create.Exec(ctx); err != nil {
+		return err
+	}
+	rev.Number = number
+	return nil
+}
+
+func (r *PostgresPostRevisionRepository) List(ctx context.Context, postID uuid.UUID, limit, offset int) (*domain.PostRevisionList, error) {
+	query := r.client.PostRevision.Query().Where(postrevision.PostID(postID))
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	revisions, err := query.
+		Order(ent.Desc(postrevision.FieldNumber)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list := &domain.PostRevisionList{Revisions: make([]*domain.PostRevision, len(revisions)), Total: total}
+	for i, rev := range revisions {
+		list.Revisions[i] = toDomainPostRevision(rev)
+	}
+	return list, nil
+}
+
+func (r *PostgresPostRevisionRepository) FindByNumber(ctx context.Context, postID uuid.UUID, number int) (*domain.PostRevision, error) {
+	rev, err := r.client.PostRevision.Query().
+		Where(postrevision.PostID(postID), postrevision.Number(number)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, domain.ErrRevisionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainPostRevision(rev), nil
+}
+
+func toDomainPostRevision(rev *ent.PostRevision) *domain.PostRevision {
+	tags := rev.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	return &domain.PostRevision{
+		ID:           rev.ID,
+		PostID:       rev.PostID,
+		Number:       rev.Number,
+		EditorID:     rev.EditorID,
+		Title:        rev.Title,
+		Slug:         rev.Slug,
+		Body:         rev.Body,
+		Excerpt:      rev.Excerpt,
+		Tags:         tags,
+		Status:       domain.PostStatus(rev.Status),
+		RestoredFrom: rev.RestoredFrom,
+		CreatedAt:    rev.CreatedAt,
+	}
+}
@@ -0,0 +1,153 @@
+-- Full text search of posts and images. The documents live in tables of their own, kept up to
+-- date by triggers, so the schema migration of ent never touches them. Run at every start, it
+-- only reindexes posts and images that are missing or were indexed with another language.
+
+-- Serializes concurrent starts, released when the implicit transaction ends
+SELECT pg_advisory_xact_lock(hashtext('post_search'));
+
+CREATE TABLE IF NOT EXISTS post_search (
+    post_id  uuid PRIMARY KEY REFERENCES posts (id) ON DELETE CASCADE,
+    language regconfig NOT NULL,
+    document tsvector NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS post_search_document_idx ON post_search USING GIN (document);
+
+CREATE OR REPLACE FUNCTION post_search_language() RETURNS regconfig
+LANGUAGE sql IMMUTABLE AS $$ SELECT '{{language}}'::regconfig $$;
+
+-- Titles weigh most, then tags, then the excerpt and body. Posts stored before the plain
+-- text was kept fall back to their Markdown.
+CREATE OR REPLACE FUNCTION post_search_refresh(id uuid) RETURNS void
+LANGUAGE sql AS $$
+    INSERT INTO post_search (post_id, language, document)
+    SELECT p.id, post_search_language(),
+        setweight(to_tsvector(post_search_language(), p.title), 'A') ||
+        setweight(to_tsvector(post_search_language(), coalesce(string_agg(t.name, ' '), '')), 'B') ||
+        setweight(to_tsvector(post_search_language(), p.excerpt || ' ' || CASE WHEN p.text = '' THEN p.body ELSE p.text END), 'C')
+    FROM posts p
+    LEFT JOIN tag_posts tp ON tp.post_id = p.id
+    LEFT JOIN tags t ON t.id = tp.tag_id
+    WHERE p.id = $1
+    GROUP BY p.id
+    ON CONFLICT (post_id) DO UPDATE SET language = EXCLUDED.language, document = EXCLUDED.document
+$$;
+
+CREATE OR REPLACE FUNCTION post_search_on_post() RETURNS trigger
+LANGUAGE plpgsql AS $$
+BEGIN
+    PERFORM post_search_refresh(NEW.id);
+    RETURN NULL;
+END
+$$;
+
+DROP TRIGGER IF EXISTS post_search_on_post ON posts;
+CREATE TRIGGER post_search_on_post AFTER INSERT OR UPDATE OF title, excerpt, body, text ON posts
+    FOR EACH ROW EXECUTE FUNCTION post_search_on_post();
+
+CREATE OR REPLACE FUNCTION post_search_on_tag_post() RETURNS trigger
+LANGUAGE plpgsql AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        PERFORM post_search_refresh(OLD.post_id);
+    ELSE
+        PERFORM post_search_refresh(NEW.post_id);
+    END IF;
+    RETURN NULL;
+END
+$$;
+
+DROP TRIGGER IF EXISTS post_search_on_tag_post ON tag_posts;
+CREATE TRIGGER post_search_on_tag_post AFTER INSERT OR DELETE ON tag_posts
+    FOR EACH ROW EXECUTE FUNCTION post_search_on_tag_post();
+
+CREATE OR REPLACE FUNCTION post_search_on_tag() RETURNS trigger
+LANGUAGE plpgsql AS $$
+BEGIN
+    PERFORM post_search_refresh(tp.post_id) FROM tag_posts tp WHERE tp.tag_id = NEW.id;
+    RETURN NULL;
+END
+$$;
+
+DROP TRIGGER IF EXISTS post_search_on_tag ON tags;
+CREATE TRIGGER post_search_on_tag AFTER UPDATE OF name ON tags
+    FOR EACH ROW EXECUTE FUNCTION post_search_on_tag();
+
+CREATE TABLE IF NOT EXISTS image_search (
+    image_id uuid PRIMARY KEY REFERENCES images (id) ON DELETE CASCADE,
+    language regconfig NOT NULL,
+    document tsvector NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS image_search_document_idx ON image_search USING GIN (document);
+
+-- The caption is the title of an image, the alternative texts of every locale its description
+CREATE OR REPLACE FUNCTION image_search_alt(alt jsonb) RETURNS text
+LANGUAGE sql IMMUTABLE AS $$
+    SELECT coalesce(string_agg(value, ' ' ORDER BY key), '') FROM jsonb_each_text(alt)
+$$;
+
+CREATE OR REPLACE FUNCTION image_search_refresh(id uuid) RETURNS void
+LANGUAGE sql AS $$
+    INSERT INTO image_search (image_id, language, document)
+    SELECT i.id, post_search_language(),
+        setweight(to_tsvector(post_search_language(), i.caption), 'A') ||
+        setweight(to_tsvector(post_search_language(), coalesce(string_agg(t.name, ' '), '')), 'B') ||
+        setweight(to_tsvector(post_search_language(), image_search_alt(i.alt)), 'C')
+    FROM images i
+    LEFT JOIN tag_images ti ON ti.image_id = i.id
+    LEFT JOIN tags t ON t.id = ti.tag_id
+    WHERE i.id = $1
+    GROUP BY i.id
+    ON CONFLICT (image_id) DO UPDATE SET language = EXCLUDED.language, document = EXCLUDED.document
+$$;
+
+CREATE OR REPLACE FUNCTION image_search_on_image() RETURNS trigger
+LANGUAGE plpgsql AS $$
+BEGIN
+    PERFORM image_search_refresh(NEW.id);
+    RETURN NULL;
+END
+$$;
+
+DROP TRIGGER IF EXISTS image_search_on_image ON images;
+CREATE TRIGGER image_search_on_image AFTER INSERT OR UPDATE OF caption, alt ON images
+    FOR EACH ROW EXECUTE FUNCTION image_search_on_image();
+
+CREATE OR REPLACE FUNCTION image_search_on_tag_image() RETURNS trigger
+LANGUAGE plpgsql AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        PERFORM image_search_refresh(OLD.image_id);
+    ELSE
+        PERFORM image_search_refresh(NEW.image_id);
+    END IF;
+    RETURN NULL;
+END
+$$;
+
+DROP TRIGGER IF EXISTS image_search_on_tag_image ON tag_images;
+CREATE TRIGGER image_search_on_tag_image AFTER INSERT OR DELETE ON tag_images
+    FOR EACH ROW EXECUTE FUNCTION image_search_on_tag_image();
+
+CREATE OR REPLACE FUNCTION image_search_on_tag() RETURNS trigger
+LANGUAGE plpgsql AS $$
+BEGIN
+    PERFORM image_search_refresh(ti.image_id) FROM tag_images ti WHERE ti.tag_id = NEW.id;
+    RETURN NULL;
+END
+$$;
+
+DROP TRIGGER IF EXISTS image_search_on_tag ON tags;
+CREATE TRIGGER image_search_on_tag AFTER UPDATE OF name ON tags
+    FOR EACH ROW EXECUTE FUNCTION image_search_on_tag();
+
+SELECT post_search_refresh(p.id)
+FROM posts p
+LEFT JOIN post_search s ON s.post_id = p.id
+WHERE s.post_id IS NULL OR s.language <> post_search_language();
+
+SELECT image_search_refresh(i.id)
+FROM images i
+LEFT JOIN image_search s ON s.image_id = i.id
+WHERE s.image_id IS NULL OR s.language <> post_search_language();
@@ -0,0 +1,163 @@
+package postgres
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/image"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/post"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+//go:embed search.sql
+var searchMigration string
+
+// languagePattern is what a text search configuration name looks like, e.g. english or simple
+var languagePattern = regexp.MustCompile(`^[a-z_]+$`)
+
+// ts_headline options, the matches are marked like the memory repository marks them
+var (
+	titleHeadline   = fmt.Sprintf(`HighlightAll=true, StartSel="%s", StopSel="%s"`, domain.HighlightStart, domain.HighlightStop)
+	snippetHeadline = fmt.Sprintf(`MaxFragments=2, MinWords=8, MaxWords=24, FragmentDelimiter=" … ", StartSel="%s", StopSel="%s"`, domain.HighlightStart, domain.HighlightStop)
+)
+
+// The page is chosen before ts_headline runs, so only the returned posts and images are highlighted.
+// Images have no publication date, they rank by upload date among posts of the same rank.
+const searchQuery = `
+WITH q AS (SELECT to_tsquery(post_search_language(), $1) AS query),
+hit AS (
+    SELECT m.kind, m.id, m.rank, m.at, count(*) OVER () AS total
+    FROM (
+        SELECT 'post' AS kind, s.post_id AS id, ts_rank(s.document, q.query) AS rank, p.published_at AS at
+        FROM post_search s
+        JOIN posts p ON p.id = s.post_id
+        CROSS JOIN q
+        WHERE s.document @@ q.query AND p.status = 'published'
+        UNION ALL
+        SELECT 'image', s.image_id, ts_rank(s.document, q.query), i.created_at
+        FROM image_search s
+        JOIN images i ON i.id = s.image_id
+        CROSS JOIN q
+        WHERE s.document @@ q.query AND i.visibility = 'public'
+    ) m
+    ORDER BY m.rank DESC, m.at DESC
+    LIMIT $2 OFFSET $3
+)
+SELECT hit.kind, hit.id,
+    ts_headline(post_search_language(), coalesce(p.title, i.caption), q.query, $4),
+    ts_headline(post_search_language(), CASE
+        WHEN hit.kind = 'image' THEN image_search_alt(i.alt)
+        WHEN p.text = '' THEN p.body
+        ELSE p.text
+    END, q.query, $5),
+    hit.total
+FROM hit
+CROSS JOIN q
+LEFT JOIN posts p ON hit.kind = 'post' AND p.id = hit.id
+LEFT JOIN images i ON hit.kind = 'image' AND i.id = hit.id
+WHERE p.id IS NOT NULL OR i.id IS NOT NULL
+ORDER BY hit.rank DESC, hit.at DESC`
+
+type PostgresSearchRepository struct {
+	client *ent.Client
+}
+
+var _ outports.SearchRepository = (*PostgresSearchRepository)(nil)
+
+func NewSearchRepository(client *ent.Client) *PostgresSearchRepository {
+	return &PostgresSearchRepository{client: client}
+}
+
+// MigrateSearch creates or updates the search index and the triggers maintaining it.
+// language is a Postgres text search configuration, changing it reindexes every post and image.
+func MigrateSearch(ctx context.Context, client *ent.Client, language string) error {
+	if !languagePattern.MatchString(language) {
+		return fmt.Errorf("invalid text search configuration %q", language)
+	}
+	_, err := client.ExecContext(ctx, strings.ReplaceAll(searchMigration, "{{language}}", language))
+	return err
+}
+
+func (r *PostgresSearchRepository) Search(ctx context.Context, query domain.SearchQuery) (*domain.SearchResults, error) {
+	// Terms are letters and digits only, safe to pass to to_tsquery as they are
+	terms := make([]string, len(query.Terms))
+	for i, term := range query.Terms {
+		terms[i] = term + ":*"
+	}
+
+	rows, err := r.client.QueryContext(ctx, searchQuery, strings.Join(terms, " & "), query.Limit, query.Offset, titleHeadline, snippetHeadline)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := &domain.SearchResults{Hits: []domain.SearchHit{}}
+	var kinds []string
+	var ids, postIDs, imageIDs []uuid.UUID
+	for rows.Next() {
+		var hit domain.SearchHit
+		var kind string
+		var id uuid.UUID
+		if err := rows.Scan(&kind, &id, &hit.Title, &hit.Snippet, &results.Total); err != nil {
+			return nil, err
+		}
+		if kind == "post" {
+			postIDs = append(postIDs, id)
+		} else {
+			imageIDs = append(imageIDs, id)
+		}
+		kinds = append(kinds, kind)
+		ids = append(ids, id)
+		results.Hits = append(results.Hits, hit)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return results, nil
+	}
+
+	posts, err := r.client.Post.Query().
+		Where(post.IDIn(postIDs...)).
+		WithTags().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	postsByID := make(map[uuid.UUID]*domain.Post, len(posts))
+	for _, p := range posts {
+		postsByID[p.ID] = toDomainPost(p)
+	}
+	images, err := r.client.Image.Query().
+		Where(image.IDIn(imageIDs...)).
+		WithTags().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	imagesByID := make(map[uuid.UUID]*domain.Image, len(images))
+	for _, img := range images {
+		imagesByID[img.ID] = toDomainImage(img)
+	}
+
+	// A post or image deleted in between is left out of the page
+	hits := results.Hits[:0]
+	for i, id := range ids {
+		if kinds[i] == "post" {
+			results.Hits[i].Post = postsByID[id]
+		} else {
+			results.Hits[i].Image = imagesByID[id]
+		}
+		if results.Hits[i].Post != nil || results.Hits[i].Image != nil {
+			hits = append(hits, results.Hits[i])
+		}
+	}
+	results.Hits = hits
+	return results, nil
+}
@@ -0,0 +1,191 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/image"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/post"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/tag"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+type PostgresTagRepository struct {
+	client *ent.Client
+}
+
+var _ outports.TagRepository = (*PostgresTagRepository)(nil)
+
+func NewTagRepository(client *ent.Client) *PostgresTagRepository {
+	return &PostgresTagRepository{client: client}
+}
+
+func (r *PostgresTagRepository) FindBySlug(ctx context.Context, slug string) (domain.Tag, error) {
+	t, err := r.client.Tag.Query().
+		Where(tag.Slug(slug)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return domain.Tag{}, domain.ErrTagNotFound
+	}
+	if err != nil {
+		return domain.Tag{}, err
+	}
+	return toDomainTag(t), nil
+}
+
+func (r *PostgresTagRepository) Counts(ctx context.Context) ([]domain.TagCount, error) {
+	tags, err := r.client.Tag.Query().
+		WithPosts(func(q *ent.PostQuery) {
+			q.Where(post.Status(string(domain.PostPublished))).Select(post.FieldID)
+		}).
+		WithImages(func(q *ent.ImageQuery) {
+			q.Where(image.Visibility(string(domain.VisibilityPublic))).Select(image.FieldID)
+		}).
+		Order(ent.Asc(tag.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	counts := make([]domain.TagCount, len(tags))
+	for i, t := range tags {
+		counts[i] = domain.TagCount{
+			Tag:    toDomainTag(t),
+			Posts:  len(t.Edges.Posts),
+			Images: len(t.Edges.Images),
+		}
+	}
+	return counts, nil
+}
+
+func (r *PostgresTagRepository) Rename(ctx context.Context, slug string, t domain.Tag) error {
+	n, err := r.client.Tag.Update().
+		Where(tag.Slug(slug)).
+		SetSlug(t.Slug).
+		SetName(t.Name).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return domain.ErrTagExists
+	}
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domain.ErrTagNotFound
+	}
+	return nil
+}
+
+func (r *PostgresTagRepository) Merge(ctx context.Context, sources []string, target string) error {
+	return withTx(ctx, r.client, func(tx *ent.Tx) error {
+		into, err := tx.Tag.Query().
+			Where(tag.Slug(target)).
+			WithPosts(func(q *ent.PostQuery) { q.Select(post.FieldID) }).
+			WithImages(func(q *ent.ImageQuery) { q.Select(image.FieldID) }).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return domain.ErrTagNotFound
+		}
+		if err != nil {
+			return err
+		}
+		posts := make(map[uuid.UUID]bool)
+		for _, p := range into.Edges.Posts {
+			posts[p.ID] = true
+		}
+		images := make(map[uuid.UUID]bool)
+		for _, img := range into.Edges.Images {
+			images[img.ID] = true
+		}
+
+		for _, slug := range sources {
+			from, err := tx.Tag.Query().
+				Where(tag.Slug(slug)).
+				WithPosts(func(q *ent.PostQuery) { q.Select(post.FieldID) }).
+				WithImages(func(q *ent.ImageQuery) { q.Select(image.FieldID) }).
+				Only(ctx)
+			if ent.IsNotFound(err) {
+				return domain.ErrTagNotFound
+			}
+			if err != nil {
+				return err
+			}
+
+			// Only references the target lacks, the join tables reject duplicates
+			update := tx.Tag.UpdateOne(into)
+			for _, p := range from.Edges.Posts {
+				if !posts[p.ID] {
+					posts[p.ID] = true
+					update.AddPostIDs(p.ID)
+				}
+			}
+			for _, img := range from.Edges.Images {
+				if !images[img.ID] {
+					images[img.ID] = true
+					update.AddImageIDs(img.ID)
+				}
+			}
+			if err := update.Exec(ctx); err != nil {
+				return err
+			}
+			// The join rows of the source go with it
+			if err := tx.Tag.DeleteOne(from).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// resolveTags returns the IDs of the tags, creating the missing ones. Existing tags keep their name.
+func resolveTags(ctx context.Context, tx *ent.Tx, tags []domain.Tag) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(tags))
+	for _, t := range tags {
+		id, err := tx.Tag.Query().Where(tag.Slug(t.Slug)).OnlyID(ctx)
+		if ent.IsNotFound(err) {
+			var created *ent.Tag
+			created, err = tx.Tag.Create().SetSlug(t.Slug).SetName(t.Name).Save(ctx)
+			if created != nil {
+				id = created.ID
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// withTx runs fn in a transaction, committed when fn returns nil and rolled back otherwise
+func withTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+func toDomainTag(t *ent.Tag) domain.Tag {
+	return domain.Tag{Slug: t.Slug, Name: t.Name}
+}
+
+// toDomainTags converts loaded tag edges, sorted by slug like domain.NewTags
+func toDomainTags(tags []*ent.Tag) []domain.Tag {
+	result := make([]domain.Tag, len(tags))
+	for i, t := range tags {
+		result[i] = toDomainTag(t)
+	}
+	slices.SortFunc(result, func(a, b domain.Tag) int { return strings.Compare(a.Slug, b.Slug) })
+	return result
+}
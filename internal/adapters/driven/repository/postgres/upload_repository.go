@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/upload"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+type PostgresUploadRepository struct {
+	client *ent.Client
+}
+
+var _ outports.UploadRepository = (*PostgresUploadRepository)(nil)
+
+func NewUploadRepository(client *ent.Client) *PostgresUploadRepository {
+	return &PostgresUploadRepository{client: client}
+}
+
+func (r *PostgresUploadRepository) Save(ctx context.Context, u *domain.Upload) error {
+	_, err := r.client.Upload.Create().
+		SetID(u.ID).
+		SetOwnerID(u.OwnerID).
+		SetOriginalName(u.OriginalName).
+		SetObjectName(u.ObjectName).
+		SetContentType(u.ContentType).
+		SetSize(u.Size).
+		SetChecksum(u.Checksum).
+		SetExpiresAt(u.ExpiresAt).
+		SetCreatedAt(u.CreatedAt).
+		Save(ctx)
+	return err
+}
+
+func (r *PostgresUploadRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Upload, error) {
+	u, err := r.client.Upload.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, domain.ErrUploadNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainUpload(u), nil
+}
+
+func (r *PostgresUploadRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.client.Upload.DeleteOneID(id).Exec(ctx)
+}
+
+func (r *PostgresUploadRepository) DeleteExpired(ctx context.Context, t time.Time) (int, error) {
+	return r.client.Upload.Delete().
+		Where(upload.ExpiresAtLT(t)).
+		Exec(ctx)
+}
+
+func toDomainUpload(u *ent.Upload) *domain.Upload {
+	return &domain.Upload{
+		ID:           u.ID,
+		OwnerID:      u.OwnerID,
+		OriginalName: u.OriginalName,
+		ObjectName:   u.ObjectName,
+		ContentType:  u.ContentType,
+		Size:         u.Size,
+		Checksum:     u.Checksum,
+		ExpiresAt:    u.ExpiresAt,
+		CreatedAt:    u.CreatedAt,
+	}
+}
@@ -26,15 +26,38 @@ func (r *PostgresUserRepository) Save(ctx context.Context, u *domain.User) error
 		SetEmail(u.Email).
 		SetPasswordHash(u.PasswordHash).
 		SetRole(string(u.Role)).
+		SetMaxFileSize(u.Limits.MaxFileSize).
+		SetMaxTotalBytes(u.Limits.MaxTotalBytes).
+		SetMaxFiles(u.Limits.MaxFiles).
+		SetAllowedTypes(u.Limits.AllowedTypes).
 		SetCreatedAt(u.CreatedAt).
 		Save(ctx)
 	return err
 }
 
+func (r *PostgresUserRepository) Update(ctx context.Context, u *domain.User) error {
+	_, err := r.client.User.UpdateOneID(u.ID).
+		SetEmail(u.Email).
+		SetPasswordHash(u.PasswordHash).
+		SetRole(string(u.Role)).
+		SetMaxFileSize(u.Limits.MaxFileSize).
+		SetMaxTotalBytes(u.Limits.MaxTotalBytes).
+		SetMaxFiles(u.Limits.MaxFiles).
+		SetAllowedTypes(u.Limits.AllowedTypes).
+		Save(ctx)
+	if ent.IsNotFound(err) {
+		return domain.ErrUserNotFound
+	}
+	return err
+}
+
 func (r *PostgresUserRepository) FindByEmail(ctx context.Context, email string) (*domain.User, error) {
 	u, err := r.client.User.Query().
 		Where(user.Email(email)).
 		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, domain.ErrUserNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -45,6 +68,9 @@ func (r *PostgresUserRepository) FindByID(ctx context.Context, id uuid.UUID) (*d
 	u, err := r.client.User.Query().
 		Where(user.ID(id)).
 		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, domain.ErrUserNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -61,6 +87,12 @@ func toDomainUser(u *ent.User) *domain.User {
 		Email:        u.Email,
 		PasswordHash: u.PasswordHash,
 		Role:         domain.UserRole(u.Role),
-		CreatedAt:    u.CreatedAt,
+		Limits: domain.UploadLimits{
+			MaxFileSize:   u.MaxFileSize,
+			MaxTotalBytes: u.MaxTotalBytes,
+			MaxFiles:      u.MaxFiles,
+			AllowedTypes:  u.AllowedTypes,
+		},
+		CreatedAt: u.CreatedAt,
 	}
 }
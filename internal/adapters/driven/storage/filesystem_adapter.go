@@ -0,0 +1,343 @@
+package storage
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+var (
+	_ outports.ObjectStore      = (*FilesystemAdapter)(nil)
+	_ outports.MultipartStorage = (*FilesystemAdapter)(nil)
+)
+
+// multipartDir holds the parts of unfinished multipart uploads, object names can never start with a dot
+const multipartDir = ".multipart"
+
+// FilesystemAdapter stores objects as files under a root directory.
+// Objects under public/ are served by PublicHandler, everything else only through signed URLs (see ServeHTTP).
+type FilesystemAdapter struct {
+	root   string
+	signer urlSigner
+}
+
+// NewFilesystemAdapter stores files under root, baseURL is where the router serves the storage routes,
+// e.g. http://localhost:8080/storage
+func NewFilesystemAdapter(root, baseURL string, signingKey []byte) (*FilesystemAdapter, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Join(root, "public"), 0o755); err != nil {
+		return nil, err
+	}
+	return &FilesystemAdapter{
+		root:   root,
+		signer: newURLSigner(baseURL, signingKey),
+	}, nil
+}
+
+// path maps an object name to a file under the root
+func (a *FilesystemAdapter) path(name string) (string, error) {
+	if !validObjectName(name) {
+		return "", outports.ErrInvalidObjectName
+	}
+	return filepath.Join(a.root, filepath.FromSlash(name)), nil
+}
+
+func (a *FilesystemAdapter) Save(ctx context.Context, file io.Reader, meta outports.FileMetadata) (string, error) {
+	p, err := a.path(meta.Name)
+	if err != nil {
+		return "", err
+	}
+	if err := writeFile(p, file); err != nil {
+		return "", err
+	}
+	return a.signer.url(meta.Name), nil
+}
+
+// writeFile writes through a temporary file so readers never see a partial object
+func writeFile(p string, r io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(p), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // no-op once renamed
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), p)
+}
+
+func (a *FilesystemAdapter) Open(ctx context.Context, name string) (io.ReadCloser, outports.FileMetadata, error) {
+	f, meta, err := a.open(name)
+	if err != nil {
+		return nil, outports.FileMetadata{}, err
+	}
+	return f, meta, nil
+}
+
+func (a *FilesystemAdapter) open(name string) (*os.File, outports.FileMetadata, error) {
+	p, err := a.path(name)
+	if err != nil {
+		return nil, outports.FileMetadata{}, err
+	}
+	f, err := os.Open(p)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, outports.FileMetadata{}, outports.ErrFileNotFound
+	}
+	if err != nil {
+		return nil, outports.FileMetadata{}, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, outports.FileMetadata{}, err
+	}
+	if info.IsDir() {
+		f.Close()
+		return nil, outports.FileMetadata{}, outports.ErrFileNotFound
+	}
+	return f, fileMetadata(name, info), nil
+}
+
+func fileMetadata(name string, info fs.FileInfo) outports.FileMetadata {
+	contentType := mime.TypeByExtension(path.Ext(name))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	return outports.FileMetadata{
+		Name:        name,
+		Size:        info.Size(),
+		ContentType: contentType,
+		ETag:        fmt.Sprintf("%x-%x", info.ModTime().UnixNano(), info.Size()),
+	}
+}
+
+// Delete follows object storage semantics, deleting a missing object is not an error
+func (a *FilesystemAdapter) Delete(ctx context.Context, name string) error {
+	p, err := a.path(name)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(p); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (a *FilesystemAdapter) URL(name string) string {
+	return a.signer.url(name)
+}
+
+func (a *FilesystemAdapter) List(ctx context.Context, prefix, startAfter string, fn func(outports.ObjectInfo) error) error {
+	var objects []outports.ObjectInfo
+	err := filepath.WalkDir(a.root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		// Hidden entries are multipart staging and temporary files, never objects
+		if p != a.root && strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			return ctx.Err()
+		}
+		rel, err := filepath.Rel(a.root, p)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+		if !strings.HasPrefix(name, prefix) || name <= startAfter {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		objects = append(objects, outports.ObjectInfo{Name: name, Size: info.Size(), LastModified: info.ModTime()})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// WalkDir orders by directory, "a/b" comes before "a-b" although it sorts after it
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Name < objects[j].Name })
+	for _, obj := range objects {
+		if err := fn(obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *FilesystemAdapter) PresignPut(ctx context.Context, name string, expiry time.Duration) (string, error) {
+	return a.presign(http.MethodPut, name, expiry)
+}
+
+func (a *FilesystemAdapter) PresignGet(ctx context.Context, name string, expiry time.Duration) (string, error) {
+	return a.presign(http.MethodGet, name, expiry)
+}
+
+func (a *FilesystemAdapter) presign(method, name string, expiry time.Duration) (string, error) {
+	if _, err := a.path(name); err != nil {
+		return "", err
+	}
+	return a.signer.presign(method, name, expiry), nil
+}
+
+// PublicHandler serves the objects under public/, the request path is the name below it
+func (a *FilesystemAdapter) PublicHandler() http.Handler {
+	return publicHandler(a.serve)
+}
+
+// ServeHTTP serves the URLs made by PresignGet and PresignPut, the request path is the object name
+func (a *FilesystemAdapter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	name, method, ok := a.signer.verify(r)
+	if !ok {
+		http.Error(w, "invalid or expired signature", http.StatusForbidden)
+		return
+	}
+
+	switch method {
+	case http.MethodGet:
+		a.serve(w, r, name)
+	case http.MethodPut:
+		p, err := a.path(name)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		// Same ceiling as every other upload path, the image service validates the rest
+		if err := writeFile(p, http.MaxBytesReader(w, r.Body, domain.MaxImageSize)); err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+				return
+			}
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// serve writes an object through http.ServeContent, so range and conditional requests work
+func (a *FilesystemAdapter) serve(w http.ResponseWriter, r *http.Request, name string) {
+	f, meta, err := a.open(name)
+	if errors.Is(err, outports.ErrFileNotFound) || errors.Is(err, outports.ErrInvalidObjectName) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", meta.ContentType)
+	w.Header().Set("ETag", `"`+meta.ETag+`"`)
+	http.ServeContent(w, r, "", info.ModTime(), f)
+}
+
+func (a *FilesystemAdapter) CreateMultipart(ctx context.Context, name, contentType string) (string, error) {
+	if _, err := a.path(name); err != nil {
+		return "", err
+	}
+	id := uuid.NewString()
+	if err := os.MkdirAll(a.multipartPath(id), 0o755); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+func (a *FilesystemAdapter) multipartPath(multipartID string) string {
+	return filepath.Join(a.root, multipartDir, multipartID)
+}
+
+func (a *FilesystemAdapter) partPath(multipartID string, number int) (string, error) {
+	if uuid.Validate(multipartID) != nil || number < 1 {
+		return "", outports.ErrInvalidObjectName
+	}
+	return filepath.Join(a.multipartPath(multipartID), strconv.Itoa(number)), nil
+}
+
+func (a *FilesystemAdapter) UploadPart(ctx context.Context, name, multipartID string, number int, part io.Reader, size int64) (domain.UploadPart, error) {
+	p, err := a.partPath(multipartID, number)
+	if err != nil {
+		return domain.UploadPart{}, err
+	}
+	hash := md5.New()
+	if err := writeFile(p, io.TeeReader(part, hash)); err != nil {
+		return domain.UploadPart{}, err
+	}
+	return domain.UploadPart{Number: number, ETag: hex.EncodeToString(hash.Sum(nil)), Size: size}, nil
+}
+
+func (a *FilesystemAdapter) CompleteMultipart(ctx context.Context, name, multipartID string, parts []domain.UploadPart) error {
+	p, err := a.path(name)
+	if err != nil {
+		return err
+	}
+
+	readers := make([]io.Reader, 0, len(parts))
+	for _, part := range parts {
+		pp, err := a.partPath(multipartID, part.Number)
+		if err != nil {
+			return err
+		}
+		f, err := os.Open(pp)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		readers = append(readers, f)
+	}
+
+	if err := writeFile(p, io.MultiReader(readers...)); err != nil {
+		return err
+	}
+	return os.RemoveAll(a.multipartPath(multipartID))
+}
+
+func (a *FilesystemAdapter) AbortMultipart(ctx context.Context, name, multipartID string) error {
+	if uuid.Validate(multipartID) != nil {
+		return outports.ErrInvalidObjectName
+	}
+	return os.RemoveAll(a.multipartPath(multipartID))
+}
@@ -0,0 +1,117 @@
+package storage_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/llascola/web-backend/internal/adapters/driven/storage"
+	"github.com/llascola/web-backend/internal/app/outports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newFilesystemAdapter(t *testing.T) *storage.FilesystemAdapter {
+	a, err := storage.NewFilesystemAdapter(t.TempDir(), "http://localhost/storage", []byte("secret"))
+	require.NoError(t, err)
+	return a
+}
+
+func TestFilesystemAdapterRejectsUnsafeNames(t *testing.T) {
+	a := newFilesystemAdapter(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"", "../escape", "/etc/passwd", "public/../../x", "a//b", ".multipart/x", "a\\b", "public/"} {
+		_, err := a.Save(ctx, strings.NewReader("x"), outports.FileMetadata{Name: name, Size: 1})
+		assert.ErrorIs(t, err, outports.ErrInvalidObjectName, name)
+	}
+}
+
+func TestFilesystemAdapterSaveOpenDelete(t *testing.T) {
+	a := newFilesystemAdapter(t)
+	ctx := context.Background()
+
+	url, err := a.Save(ctx, strings.NewReader("hello"), outports.FileMetadata{Name: "public/a.png", Size: 5})
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost/storage/public/a.png", url)
+
+	f, meta, err := a.Open(ctx, "public/a.png")
+	require.NoError(t, err)
+	data, _ := io.ReadAll(f)
+	f.Close()
+	assert.Equal(t, "hello", string(data))
+	assert.Equal(t, "image/png", meta.ContentType)
+	assert.Equal(t, int64(5), meta.Size)
+
+	assert.NoError(t, a.Delete(ctx, "public/a.png"))
+	assert.NoError(t, a.Delete(ctx, "public/a.png"))
+	_, _, err = a.Open(ctx, "public/a.png")
+	assert.ErrorIs(t, err, outports.ErrFileNotFound)
+}
+
+func TestFilesystemAdapterSignedURLs(t *testing.T) {
+	a := newFilesystemAdapter(t)
+	ctx := context.Background()
+	server := httptest.NewServer(http.StripPrefix("/storage/signed", a))
+	defer server.Close()
+	local := func(raw string) string {
+		u, _ := url.Parse(raw)
+		return server.URL + u.RequestURI()
+	}
+
+	putURL, err := a.PresignPut(ctx, "private/b.jpg", time.Minute)
+	require.NoError(t, err)
+	req, _ := http.NewRequest(http.MethodPut, local(putURL), strings.NewReader("0123456789"))
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	getURL, err := a.PresignGet(ctx, "private/b.jpg", time.Minute)
+	require.NoError(t, err)
+	req, _ = http.NewRequest(http.MethodGet, local(getURL), nil)
+	req.Header.Set("Range", "bytes=2-4")
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusPartialContent, resp.StatusCode)
+	assert.Equal(t, "234", string(body))
+
+	// The PUT signature does not allow downloads
+	resp, err = http.Get(local(putURL))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func TestFilesystemAdapterList(t *testing.T) {
+	a := newFilesystemAdapter(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"public/a-b.png", "public/a/b.png", "private/c.png"} {
+		_, err := a.Save(ctx, strings.NewReader(name), outports.FileMetadata{Name: name})
+		require.NoError(t, err)
+	}
+	_, err := a.CreateMultipart(ctx, "public/d.png", "image/png")
+	require.NoError(t, err)
+
+	var names []string
+	err = a.List(ctx, "public/", "", func(obj outports.ObjectInfo) error {
+		names = append(names, obj.Name)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"public/a-b.png", "public/a/b.png"}, names)
+
+	names = nil
+	err = a.List(ctx, "", "public/a-b.png", func(obj outports.ObjectInfo) error {
+		names = append(names, obj.Name)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"public/a/b.png"}, names)
+}
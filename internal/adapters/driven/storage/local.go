@@ -0,0 +1,98 @@
+package storage
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The filesystem and memory adapters are served by this process instead of an object store.
+// They share the object name rules and the signed URL scheme that stands in for presigned URLs.
+
+// validObjectName reports whether name is safe to use as a key. Names are slash separated and must stay
+// local: no absolute paths, no "..", no empty or hidden segments and nothing that cleaning would change.
+func validObjectName(name string) bool {
+	if name == "" || strings.ContainsAny(name, "\\\x00") || path.Clean(name) != name || !filepath.IsLocal(filepath.FromSlash(name)) {
+		return false
+	}
+	for _, segment := range strings.Split(name, "/") {
+		if segment == "" || strings.HasPrefix(segment, ".") {
+			return false
+		}
+	}
+	return true
+}
+
+// urlSigner builds the URLs of stored objects under baseURL, where the router mounts the storage routes
+type urlSigner struct {
+	baseURL string
+	key     []byte
+}
+
+func newURLSigner(baseURL string, key []byte) urlSigner {
+	return urlSigner{baseURL: strings.TrimRight(baseURL, "/"), key: key}
+}
+
+func (s urlSigner) url(name string) string {
+	return s.baseURL + "/" + escapePath(name)
+}
+
+func (s urlSigner) presign(method, name string, expiry time.Duration) string {
+	expires := strconv.FormatInt(time.Now().Add(expiry).Unix(), 10)
+	query := url.Values{
+		"expires":   {expires},
+		"signature": {s.sign(method, name, expires)},
+	}
+	return s.baseURL + "/signed/" + escapePath(name) + "?" + query.Encode()
+}
+
+func (s urlSigner) sign(method, name, expires string) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(method + "\n" + name + "\n" + expires))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// verify checks a request to a signed URL, the request path is the object name.
+// HEAD is accepted with a GET signature.
+func (s urlSigner) verify(r *http.Request) (name, method string, ok bool) {
+	name = strings.TrimPrefix(r.URL.Path, "/")
+	method = r.Method
+	if method == http.MethodHead {
+		method = http.MethodGet
+	}
+
+	expires := r.URL.Query().Get("expires")
+	deadline, err := strconv.ParseInt(expires, 10, 64)
+	signature := r.URL.Query().Get("signature")
+	if err != nil || time.Now().Unix() > deadline || len(s.key) == 0 ||
+		!hmac.Equal([]byte(signature), []byte(s.sign(method, name, expires))) {
+		return "", "", false
+	}
+	return name, method, true
+}
+
+// publicHandler serves the objects under public/ without a signature, the request path is the name below it
+func publicHandler(serve func(w http.ResponseWriter, r *http.Request, name string)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		serve(w, r, "public/"+strings.TrimPrefix(r.URL.Path, "/"))
+	})
+}
+
+func escapePath(name string) string {
+	segments := strings.Split(name, "/")
+	for i, s := range segments {
+		segments[i] = url.PathEscape(s)
+	}
+	return strings.Join(segments, "/")
+}
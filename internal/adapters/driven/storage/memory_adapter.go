@@ -0,0 +1,279 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+var (
+	_ outports.ObjectStore      = (*MemoryAdapter)(nil)
+	_ outports.MultipartStorage = (*MemoryAdapter)(nil)
+)
+
+type memoryObject struct {
+	data    []byte
+	meta    outports.FileMetadata
+	modTime time.Time
+}
+
+// MemoryAdapter keeps objects in memory, for development and tests. Everything is lost on restart.
+// It serves its objects like FilesystemAdapter: public/ through PublicHandler, the rest through signed URLs.
+type MemoryAdapter struct {
+	mu      sync.RWMutex
+	objects map[string]memoryObject
+	// multiparts holds the parts of unfinished multipart uploads by upload id and part number
+	multiparts map[string]map[int][]byte
+	signer     urlSigner
+}
+
+func NewMemoryAdapter(baseURL string, signingKey []byte) *MemoryAdapter {
+	return &MemoryAdapter{
+		objects:    make(map[string]memoryObject),
+		multiparts: make(map[string]map[int][]byte),
+		signer:     newURLSigner(baseURL, signingKey),
+	}
+}
+
+func (a *MemoryAdapter) Save(ctx context.Context, file io.Reader, meta outports.FileMetadata) (string, error) {
+	if !validObjectName(meta.Name) {
+		return "", outports.ErrInvalidObjectName
+	}
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return "", err
+	}
+	a.put(meta.Name, data, meta.ContentType)
+	return a.signer.url(meta.Name), nil
+}
+
+// put takes ownership of data, callers must not modify it afterwards
+func (a *MemoryAdapter) put(name string, data []byte, contentType string) {
+	if contentType == "" {
+		contentType = mime.TypeByExtension(path.Ext(name))
+	}
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	sum := md5.Sum(data)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.objects[name] = memoryObject{
+		data: data,
+		meta: outports.FileMetadata{
+			Name:        name,
+			Size:        int64(len(data)),
+			ContentType: contentType,
+			ETag:        hex.EncodeToString(sum[:]),
+		},
+		modTime: time.Now(),
+	}
+}
+
+func (a *MemoryAdapter) Open(ctx context.Context, name string) (io.ReadCloser, outports.FileMetadata, error) {
+	obj, err := a.get(name)
+	if err != nil {
+		return nil, outports.FileMetadata{}, err
+	}
+	return io.NopCloser(bytes.NewReader(obj.data)), obj.meta, nil
+}
+
+func (a *MemoryAdapter) get(name string) (memoryObject, error) {
+	if !validObjectName(name) {
+		return memoryObject{}, outports.ErrInvalidObjectName
+	}
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	obj, ok := a.objects[name]
+	if !ok {
+		return memoryObject{}, outports.ErrFileNotFound
+	}
+	return obj, nil
+}
+
+// Delete follows object storage semantics, deleting a missing object is not an error
+func (a *MemoryAdapter) Delete(ctx context.Context, name string) error {
+	if !validObjectName(name) {
+		return outports.ErrInvalidObjectName
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	delete(a.objects, name)
+	return nil
+}
+
+func (a *MemoryAdapter) URL(name string) string {
+	return a.signer.url(name)
+}
+
+func (a *MemoryAdapter) List(ctx context.Context, prefix, startAfter string, fn func(outports.ObjectInfo) error) error {
+	a.mu.RLock()
+	var objects []outports.ObjectInfo
+	for name, obj := range a.objects {
+		if strings.HasPrefix(name, prefix) && name > startAfter {
+			objects = append(objects, outports.ObjectInfo{Name: name, Size: obj.meta.Size, LastModified: obj.modTime})
+		}
+	}
+	a.mu.RUnlock()
+
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Name < objects[j].Name })
+	for _, obj := range objects {
+		if err := fn(obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *MemoryAdapter) PresignPut(ctx context.Context, name string, expiry time.Duration) (string, error) {
+	return a.presign(http.MethodPut, name, expiry)
+}
+
+func (a *MemoryAdapter) PresignGet(ctx context.Context, name string, expiry time.Duration) (string, error) {
+	return a.presign(http.MethodGet, name, expiry)
+}
+
+func (a *MemoryAdapter) presign(method, name string, expiry time.Duration) (string, error) {
+	if !validObjectName(name) {
+		return "", outports.ErrInvalidObjectName
+	}
+	return a.signer.presign(method, name, expiry), nil
+}
+
+// PublicHandler serves the objects under public/, the request path is the name below it
+func (a *MemoryAdapter) PublicHandler() http.Handler {
+	return publicHandler(a.serve)
+}
+
+// ServeHTTP serves the URLs made by PresignGet and PresignPut, the request path is the object name
+func (a *MemoryAdapter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	name, method, ok := a.signer.verify(r)
+	if !ok {
+		http.Error(w, "invalid or expired signature", http.StatusForbidden)
+		return
+	}
+
+	switch method {
+	case http.MethodGet:
+		a.serve(w, r, name)
+	case http.MethodPut:
+		if !validObjectName(name) {
+			http.Error(w, outports.ErrInvalidObjectName.Error(), http.StatusBadRequest)
+			return
+		}
+		// Same ceiling as every other upload path, the image service validates the rest
+		data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, domain.MaxImageSize))
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+				return
+			}
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		a.put(name, data, r.Header.Get("Content-Type"))
+		w.WriteHeader(http.StatusOK)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (a *MemoryAdapter) serve(w http.ResponseWriter, r *http.Request, name string) {
+	obj, err := a.get(name)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", obj.meta.ContentType)
+	w.Header().Set("ETag", `"`+obj.meta.ETag+`"`)
+	http.ServeContent(w, r, "", obj.modTime, bytes.NewReader(obj.data))
+}
+
+func (a *MemoryAdapter) CreateMultipart(ctx context.Context, name, contentType string) (string, error) {
+	if !validObjectName(name) {
+		return "", outports.ErrInvalidObjectName
+	}
+	id := uuid.NewString()
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.multiparts[id] = make(map[int][]byte)
+	return id, nil
+}
+
+func (a *MemoryAdapter) UploadPart(ctx context.Context, name, multipartID string, number int, part io.Reader, size int64) (domain.UploadPart, error) {
+	if number < 1 {
+		return domain.UploadPart{}, outports.ErrInvalidObjectName
+	}
+	data, err := io.ReadAll(part)
+	if err != nil {
+		return domain.UploadPart{}, err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	parts, ok := a.multiparts[multipartID]
+	if !ok {
+		return domain.UploadPart{}, outports.ErrFileNotFound
+	}
+	parts[number] = data
+	sum := md5.Sum(data)
+	return domain.UploadPart{Number: number, ETag: hex.EncodeToString(sum[:]), Size: size}, nil
+}
+
+func (a *MemoryAdapter) CompleteMultipart(ctx context.Context, name, multipartID string, parts []domain.UploadPart) error {
+	if !validObjectName(name) {
+		return outports.ErrInvalidObjectName
+	}
+
+	buf, err := a.assemble(multipartID, parts)
+	if err != nil {
+		return err
+	}
+	a.put(name, buf.Bytes(), "")
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	delete(a.multiparts, multipartID)
+	return nil
+}
+
+func (a *MemoryAdapter) assemble(multipartID string, parts []domain.UploadPart) (*bytes.Buffer, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	stored, ok := a.multiparts[multipartID]
+	if !ok {
+		return nil, outports.ErrFileNotFound
+	}
+	var buf bytes.Buffer
+	for _, part := range parts {
+		data, ok := stored[part.Number]
+		if !ok {
+			return nil, outports.ErrFileNotFound
+		}
+		buf.Write(data)
+	}
+	return &buf, nil
+}
+
+func (a *MemoryAdapter) AbortMultipart(ctx context.Context, name, multipartID string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	delete(a.multiparts, multipartID)
+	return nil
+}
@@ -0,0 +1,53 @@
+package storage_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/llascola/web-backend/internal/adapters/driven/storage"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryAdapterMultipart(t *testing.T) {
+	a := storage.NewMemoryAdapter("http://localhost/storage", []byte("secret"))
+	ctx := context.Background()
+
+	id, err := a.CreateMultipart(ctx, "public/c.png", "image/png")
+	require.NoError(t, err)
+	var parts []domain.UploadPart
+	for i, chunk := range []string{"hello ", "world"} {
+		part, err := a.UploadPart(ctx, "public/c.png", id, i+1, strings.NewReader(chunk), int64(len(chunk)))
+		require.NoError(t, err)
+		parts = append(parts, part)
+	}
+	require.NoError(t, a.CompleteMultipart(ctx, "public/c.png", id, parts))
+
+	f, meta, err := a.Open(ctx, "public/c.png")
+	require.NoError(t, err)
+	data, _ := io.ReadAll(f)
+	assert.Equal(t, "hello world", string(data))
+	assert.Equal(t, "image/png", meta.ContentType)
+
+	w := httptest.NewRecorder()
+	http.StripPrefix("/storage/public", a.PublicHandler()).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/storage/public/c.png", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "hello world", w.Body.String())
+
+	// Only public/ is reachable without a signature
+	_, err = a.Save(ctx, strings.NewReader("secret"), outports.FileMetadata{Name: "private/d.png"})
+	require.NoError(t, err)
+	w = httptest.NewRecorder()
+	http.StripPrefix("/storage/public", a.PublicHandler()).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/storage/public/../private/d.png", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	assert.NoError(t, a.Delete(ctx, "public/c.png"))
+	_, _, err = a.Open(ctx, "public/c.png")
+	assert.ErrorIs(t, err, outports.ErrFileNotFound)
+}
@@ -5,19 +5,28 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"time"
 
+	"github.com/llascola/web-backend/internal/app/domain"
 	"github.com/llascola/web-backend/internal/app/outports"
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
 )
 
+var (
+	_ outports.ObjectStore      = (*MinIOAdapter)(nil)
+	_ outports.MultipartStorage = (*MinIOAdapter)(nil)
+)
+
 type MinIOAdapter struct {
 	client *minio.Client
-	bucket string
-	host   string
+	// presigner signs URLs for the host clients reach, which can differ from the internal endpoint
+	presigner *minio.Client
+	bucket    string
+	host      string
 }
 
-func NewMinIOAdapter(endpoint, accessKey, secretKey, bucket, policyTemplate string) *MinIOAdapter {
+func NewMinIOAdapter(endpoint, publicEndpoint, accessKey, secretKey, bucket, policyTemplate string) *MinIOAdapter {
 	minioClient, err := minio.New(endpoint, &minio.Options{
 		Creds:  credentials.NewStaticV4(accessKey, secretKey, ""),
 		Secure: false,
@@ -34,11 +43,26 @@ func NewMinIOAdapter(endpoint, accessKey, secretKey, bucket, policyTemplate stri
 		log.Printf("Created bucket: %s", bucket)
 	}
 
-	// Force Public Policy
-	policy := fmt.Sprintf(policyTemplate, bucket)
-	minioClient.SetBucketPolicy(ctx, bucket, policy)
+	// Only what the policy grants is readable anonymously, by default the public/ prefix
+	if policyTemplate != "" {
+		policy := fmt.Sprintf(policyTemplate, bucket)
+		minioClient.SetBucketPolicy(ctx, bucket, policy)
+	}
+
+	presigner := minioClient
+	if publicEndpoint != "" {
+		// A fixed region keeps presigning offline, the public host may not be reachable from here
+		presigner, err = minio.New(publicEndpoint, &minio.Options{
+			Creds:  credentials.NewStaticV4(accessKey, secretKey, ""),
+			Secure: false,
+			Region: "us-east-1",
+		})
+		if err != nil {
+			log.Fatalf("MinIO Presigner Failed: %v", err)
+		}
+	}
 
-	return &MinIOAdapter{client: minioClient, bucket: bucket, host: endpoint}
+	return &MinIOAdapter{client: minioClient, presigner: presigner, bucket: bucket, host: endpoint}
 }
 
 func (a *MinIOAdapter) Save(ctx context.Context, file io.Reader, meta outports.FileMetadata) (string, error) {
@@ -48,5 +72,99 @@ func (a *MinIOAdapter) Save(ctx context.Context, file io.Reader, meta outports.F
 	if err != nil {
 		return "", err
 	}
-	return fmt.Sprintf("http://%s/%s/%s", a.host, a.bucket, meta.Name), nil
+	return a.URL(meta.Name), nil
+}
+
+func (a *MinIOAdapter) Open(ctx context.Context, name string) (io.ReadCloser, outports.FileMetadata, error) {
+	obj, err := a.client.GetObject(ctx, a.bucket, name, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, outports.FileMetadata{}, err
+	}
+
+	info, err := obj.Stat()
+	if err != nil {
+		obj.Close()
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, outports.FileMetadata{}, outports.ErrFileNotFound
+		}
+		return nil, outports.FileMetadata{}, err
+	}
+
+	return obj, outports.FileMetadata{
+		Name:        name,
+		Size:        info.Size,
+		ContentType: info.ContentType,
+		ETag:        info.ETag,
+	}, nil
+}
+
+func (a *MinIOAdapter) Delete(ctx context.Context, name string) error {
+	return a.client.RemoveObject(ctx, a.bucket, name, minio.RemoveObjectOptions{})
+}
+
+func (a *MinIOAdapter) URL(name string) string {
+	return fmt.Sprintf("http://%s/%s/%s", a.host, a.bucket, name)
+}
+
+func (a *MinIOAdapter) List(ctx context.Context, prefix, startAfter string, fn func(outports.ObjectInfo) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel() // stops the listing goroutine when fn fails
+	for obj := range a.client.ListObjects(ctx, a.bucket, minio.ListObjectsOptions{
+		Prefix:     prefix,
+		StartAfter: startAfter,
+		Recursive:  true,
+	}) {
+		if obj.Err != nil {
+			return obj.Err
+		}
+		if err := fn(outports.ObjectInfo{Name: obj.Key, Size: obj.Size, LastModified: obj.LastModified}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *MinIOAdapter) PresignPut(ctx context.Context, name string, expiry time.Duration) (string, error) {
+	u, err := a.presigner.PresignedPutObject(ctx, a.bucket, name, expiry)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
+func (a *MinIOAdapter) PresignGet(ctx context.Context, name string, expiry time.Duration) (string, error) {
+	u, err := a.presigner.PresignedGetObject(ctx, a.bucket, name, expiry, nil)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
+func (a *MinIOAdapter) CreateMultipart(ctx context.Context, name, contentType string) (string, error) {
+	core := minio.Core{Client: a.client}
+	return core.NewMultipartUpload(ctx, a.bucket, name, minio.PutObjectOptions{ContentType: contentType})
+}
+
+func (a *MinIOAdapter) UploadPart(ctx context.Context, name, multipartID string, number int, part io.Reader, size int64) (domain.UploadPart, error) {
+	core := minio.Core{Client: a.client}
+	p, err := core.PutObjectPart(ctx, a.bucket, name, multipartID, number, part, size, minio.PutObjectPartOptions{})
+	if err != nil {
+		return domain.UploadPart{}, err
+	}
+	return domain.UploadPart{Number: p.PartNumber, ETag: p.ETag, Size: size}, nil
+}
+
+func (a *MinIOAdapter) CompleteMultipart(ctx context.Context, name, multipartID string, parts []domain.UploadPart) error {
+	core := minio.Core{Client: a.client}
+	completed := make([]minio.CompletePart, len(parts))
+	for i, p := range parts {
+		completed[i] = minio.CompletePart{PartNumber: p.Number, ETag: p.ETag}
+	}
+	_, err := core.CompleteMultipartUpload(ctx, a.bucket, name, multipartID, completed, minio.PutObjectOptions{})
+	return err
+}
+
+func (a *MinIOAdapter) AbortMultipart(ctx context.Context, name, multipartID string) error {
+	core := minio.Core{Client: a.client}
+	return core.AbortMultipartUpload(ctx, a.bucket, name, multipartID)
 }
@@ -0,0 +1,58 @@
+// Package jobs runs use cases on a schedule alongside the REST server
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/inports"
+)
+
+// Every runs fn each interval until ctx is done, starting one interval from now.
+// A failed run is logged and the next tick tries again.
+func Every(ctx context.Context, name string, interval time.Duration, fn func(context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := fn(ctx); err != nil {
+				log.Printf("job %s failed: %v", name, err)
+			}
+		}
+	}
+}
+
+// StorageGC deletes orphaned objects and expired uploads
+func StorageGC(service inports.StorageService, gracePeriod time.Duration) func(context.Context) error {
+	return func(ctx context.Context) error {
+		report, err := service.CollectGarbage(ctx, domain.GCOptions{GracePeriod: gracePeriod})
+		if err != nil {
+			return err
+		}
+		log.Printf("storage gc: scanned %d objects, deleted %d of %d orphans (%d bytes), %d missing, %d expired uploads",
+			report.Scanned, report.Deleted, len(report.Orphans), report.DeletedBytes, len(report.Missing), report.ExpiredUploads)
+		for _, name := range report.Missing {
+			log.Printf("storage gc: missing %s", name)
+		}
+		for _, f := range report.Failures {
+			log.Printf("storage gc: failed %s: %v", f.Name, f.Err)
+		}
+		return nil
+	}
+}
+
+// PublishScheduledPosts publishes the scheduled posts that are due
+func PublishScheduledPosts(service inports.PostService) func(context.Context) error {
+	return func(ctx context.Context) error {
+		published, err := service.PublishDue(ctx)
+		if published > 0 {
+			log.Printf("post scheduler: published %d posts", published)
+		}
+		return err
+	}
+}
@@ -0,0 +1,95 @@
+package feed
+
+import (
+	"encoding/xml"
+	"time"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+const atomNS = "http://www.w3.org/2005/Atom"
+
+// Atom 1.0, see RFC 4287
+type atomFeed struct {
+	XMLName   xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID        string      `xml:"id"`
+	Title     string      `xml:"title"`
+	Subtitle  string      `xml:"subtitle,omitempty"`
+	Updated   string      `xml:"updated"`
+	Author    atomPerson  `xml:"author"`
+	Generator string      `xml:"generator"`
+	Links     []atomLink  `xml:"link"`
+	Entries   []atomEntry `xml:"entry"`
+}
+
+type atomPerson struct {
+	Name string `xml:"name"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr"`
+	Href string `xml:"href,attr"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Link       atomLink       `xml:"link"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Summary    atomText       `xml:"summary"`
+	Content    *atomText      `xml:"content,omitempty"`
+	Categories []atomCategory `xml:"category"`
+}
+
+type atomText struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+type atomCategory struct {
+	Term   string `xml:"term,attr"`
+	Label  string `xml:"label,attr"`
+	Scheme string `xml:"scheme,attr"`
+}
+
+// Atom encodes the feed, self is the URL it is served at
+func Atom(f *domain.Feed, self string) ([]byte, error) {
+	author := f.Author
+	if author == "" {
+		// Every entry needs an author, the feed's stands for all of them
+		author = f.Title
+	}
+	doc := atomFeed{
+		ID:        self,
+		Title:     f.Title,
+		Subtitle:  f.Description,
+		Updated:   f.Updated.UTC().Format(time.RFC3339),
+		Author:    atomPerson{Name: author},
+		Generator: generator,
+		Links: []atomLink{
+			{Rel: "alternate", Href: f.SiteURL + "/", Type: "text/html"},
+			{Rel: "self", Href: self, Type: "application/atom+xml"},
+		},
+		Entries: make([]atomEntry, len(f.Entries)),
+	}
+	for i, e := range f.Entries {
+		entry := atomEntry{
+			ID:        entryID(e),
+			Title:     e.Title,
+			Link:      atomLink{Rel: "alternate", Href: e.URL, Type: "text/html"},
+			Published: e.Published.UTC().Format(time.RFC3339),
+			Updated:   e.Updated.UTC().Format(time.RFC3339),
+			Summary:   atomText{Type: "text", Value: e.Summary},
+		}
+		if e.HTML != "" {
+			entry.Content = &atomText{Type: "html", Value: e.HTML}
+		}
+		for _, tag := range e.Tags {
+			entry.Categories = append(entry.Categories, atomCategory{Term: tag.Slug, Label: tag.Name, Scheme: f.SiteURL + "/tags"})
+		}
+		doc.Entries[i] = entry
+	}
+	return marshalXML(doc)
+}
@@ -0,0 +1,29 @@
+// Package feed encodes domain.Feed as RSS 2.0, Atom 1.0 and JSON Feed 1.1
+package feed
+
+import (
+	"encoding/xml"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+const (
+	RSSContentType  = "application/rss+xml; charset=utf-8"
+	AtomContentType = "application/atom+xml; charset=utf-8"
+	JSONContentType = "application/feed+json; charset=utf-8"
+)
+
+const generator = "web-backend"
+
+// entryID is the same in every format and survives slug changes
+func entryID(e domain.FeedEntry) string {
+	return "urn:uuid:" + e.ID.String()
+}
+
+func marshalXML(v any) ([]byte, error) {
+	body, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
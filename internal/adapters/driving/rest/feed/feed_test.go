@@ -0,0 +1,185 @@
+package feed_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"net/mail"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driving/rest/feed"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const self = "https://example.com/feed"
+
+func testFeed(full bool) *domain.Feed {
+	published := time.Date(2024, 3, 1, 10, 0, 0, 0, time.FixedZone("ART", -3*3600))
+	entry := domain.FeedEntry{
+		ID:        uuid.MustParse("6f1c2a4e-8a57-4d3c-9f0e-2b1d7c3a5e90"),
+		Title:     "Fish & <Chips>",
+		URL:       "https://example.com/posts/fish-chips",
+		Summary:   "A summary with <angle brackets>",
+		Tags:      []domain.Tag{{Slug: "food", Name: "Food"}},
+		Published: published,
+		Updated:   published.Add(time.Hour),
+	}
+	if full {
+		entry.HTML = "<p>Body with <em>markup</em> and ]]> in it</p>"
+	}
+	return &domain.Feed{
+		Title:       "Blog",
+		SiteURL:     "https://example.com",
+		FullContent: full,
+		Updated:     entry.Updated,
+		Entries:     []domain.FeedEntry{entry},
+	}
+}
+
+func TestRSS(t *testing.T) {
+	body, err := feed.RSS(testFeed(true), self)
+	require.NoError(t, err)
+
+	var doc struct {
+		Version string `xml:"version,attr"`
+		Channel struct {
+			// Before Link, which would match atom:link as well
+			Self struct {
+				Rel  string `xml:"rel,attr"`
+				Href string `xml:"href,attr"`
+			} `xml:"http://www.w3.org/2005/Atom link"`
+			Title         string `xml:"title"`
+			Link          string `xml:"link"`
+			Description   string `xml:"description"`
+			LastBuildDate string `xml:"lastBuildDate"`
+			Items         []struct {
+				Title string `xml:"title"`
+				Link  string `xml:"link"`
+				GUID  struct {
+					IsPermaLink string `xml:"isPermaLink,attr"`
+					Value       string `xml:",chardata"`
+				} `xml:"guid"`
+				PubDate     string   `xml:"pubDate"`
+				Description string   `xml:"description"`
+				Content     string   `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+				Categories  []string `xml:"category"`
+			} `xml:"item"`
+		} `xml:"channel"`
+	}
+	require.NoError(t, xml.Unmarshal(body, &doc), string(body))
+
+	// Required channel elements
+	assert.Equal(t, "2.0", doc.Version)
+	assert.Equal(t, "Blog", doc.Channel.Title)
+	assert.Equal(t, "https://example.com/", doc.Channel.Link)
+	assert.NotEmpty(t, doc.Channel.Description)
+	assert.Equal(t, "self", doc.Channel.Self.Rel)
+	assert.Equal(t, self, doc.Channel.Self.Href)
+	// Dates are RFC 822, which net/mail parses
+	_, err = mail.ParseDate(doc.Channel.LastBuildDate)
+	assert.NoError(t, err)
+
+	require.Len(t, doc.Channel.Items, 1)
+	item := doc.Channel.Items[0]
+	assert.Equal(t, "Fish & <Chips>", item.Title)
+	assert.Equal(t, "https://example.com/posts/fish-chips", item.Link)
+	assert.Equal(t, "false", item.GUID.IsPermaLink)
+	assert.Equal(t, "urn:uuid:6f1c2a4e-8a57-4d3c-9f0e-2b1d7c3a5e90", item.GUID.Value)
+	pubDate, err := mail.ParseDate(item.PubDate)
+	require.NoError(t, err)
+	assert.True(t, pubDate.Equal(testFeed(true).Entries[0].Published))
+	assert.Equal(t, "A summary with <angle brackets>", item.Description)
+	assert.Equal(t, "<p>Body with <em>markup</em> and ]]> in it</p>", item.Content)
+	assert.Equal(t, []string{"Food"}, item.Categories)
+
+	body, err = feed.RSS(testFeed(false), self)
+	require.NoError(t, err)
+	assert.NotContains(t, string(body), "content:encoded")
+}
+
+func TestAtom(t *testing.T) {
+	body, err := feed.Atom(testFeed(true), self)
+	require.NoError(t, err)
+
+	type link struct {
+		Rel  string `xml:"rel,attr"`
+		Href string `xml:"href,attr"`
+	}
+	type text struct {
+		Type  string `xml:"type,attr"`
+		Value string `xml:",chardata"`
+	}
+	var doc struct {
+		XMLName xml.Name `xml:"http://www.w3.org/2005/Atom feed"`
+		ID      string   `xml:"id"`
+		Title   string   `xml:"title"`
+		Updated string   `xml:"updated"`
+		Author  struct {
+			Name string `xml:"name"`
+		} `xml:"author"`
+		Links   []link `xml:"link"`
+		Entries []struct {
+			ID        string `xml:"id"`
+			Title     string `xml:"title"`
+			Updated   string `xml:"updated"`
+			Published string `xml:"published"`
+			Link      link   `xml:"link"`
+			Summary   text   `xml:"summary"`
+			Content   text   `xml:"content"`
+			Category  struct {
+				Term string `xml:"term,attr"`
+			} `xml:"category"`
+		} `xml:"entry"`
+	}
+	require.NoError(t, xml.Unmarshal(body, &doc), string(body))
+
+	// RFC 4287: a feed has an id, title, updated and an author when entries have none
+	assert.Equal(t, self, doc.ID)
+	assert.Equal(t, "Blog", doc.Title)
+	_, err = time.Parse(time.RFC3339, doc.Updated)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, doc.Author.Name)
+	assert.Contains(t, doc.Links, link{Rel: "self", Href: self})
+	assert.Contains(t, doc.Links, link{Rel: "alternate", Href: "https://example.com/"})
+
+	require.Len(t, doc.Entries, 1)
+	entry := doc.Entries[0]
+	assert.Equal(t, "urn:uuid:6f1c2a4e-8a57-4d3c-9f0e-2b1d7c3a5e90", entry.ID)
+	assert.Equal(t, "Fish & <Chips>", entry.Title)
+	assert.Equal(t, "2024-03-01T14:00:00Z", entry.Updated)
+	assert.Equal(t, "2024-03-01T13:00:00Z", entry.Published)
+	assert.Equal(t, link{Rel: "alternate", Href: "https://example.com/posts/fish-chips"}, entry.Link)
+	assert.Equal(t, text{Type: "text", Value: "A summary with <angle brackets>"}, entry.Summary)
+	assert.Equal(t, text{Type: "html", Value: "<p>Body with <em>markup</em> and ]]> in it</p>"}, entry.Content)
+	assert.Equal(t, "food", entry.Category.Term)
+}
+
+func TestJSON(t *testing.T) {
+	body, err := feed.JSON(testFeed(false), self)
+	require.NoError(t, err)
+
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(body, &doc))
+	// Required by JSON Feed 1.1
+	assert.Equal(t, "https://jsonfeed.org/version/1.1", doc["version"])
+	assert.Equal(t, "Blog", doc["title"])
+	assert.Equal(t, self, doc["feed_url"])
+
+	items := doc["items"].([]any)
+	require.Len(t, items, 1)
+	item := items[0].(map[string]any)
+	assert.Equal(t, "urn:uuid:6f1c2a4e-8a57-4d3c-9f0e-2b1d7c3a5e90", item["id"])
+	assert.Equal(t, "A summary with <angle brackets>", item["content_text"])
+	assert.NotContains(t, item, "content_html")
+	assert.Equal(t, "2024-03-01T13:00:00Z", item["date_published"])
+	assert.Equal(t, []any{"Food"}, item["tags"])
+
+	body, err = feed.JSON(testFeed(true), self)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(body, &doc))
+	item = doc["items"].([]any)[0].(map[string]any)
+	assert.Equal(t, "<p>Body with <em>markup</em> and ]]> in it</p>", item["content_html"])
+}
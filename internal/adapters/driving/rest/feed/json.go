@@ -0,0 +1,71 @@
+package feed
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+// JSON Feed 1.1, see https://www.jsonfeed.org/version/1.1/
+type jsonFeed struct {
+	Version     string       `json:"version"`
+	Title       string       `json:"title"`
+	HomePageURL string       `json:"home_page_url"`
+	FeedURL     string       `json:"feed_url"`
+	Description string       `json:"description,omitempty"`
+	Authors     []jsonAuthor `json:"authors,omitempty"`
+	Items       []jsonItem   `json:"items"`
+}
+
+type jsonAuthor struct {
+	Name string `json:"name"`
+}
+
+type jsonItem struct {
+	ID            string   `json:"id"`
+	URL           string   `json:"url"`
+	Title         string   `json:"title"`
+	ContentHTML   string   `json:"content_html,omitempty"`
+	ContentText   string   `json:"content_text,omitempty"`
+	Summary       string   `json:"summary,omitempty"`
+	DatePublished string   `json:"date_published"`
+	DateModified  string   `json:"date_modified"`
+	Tags          []string `json:"tags,omitempty"`
+}
+
+// JSON encodes the feed, self is the URL it is served at
+func JSON(f *domain.Feed, self string) ([]byte, error) {
+	doc := jsonFeed{
+		Version:     "https://jsonfeed.org/version/1.1",
+		Title:       f.Title,
+		HomePageURL: f.SiteURL + "/",
+		FeedURL:     self,
+		Description: f.Description,
+		Items:       make([]jsonItem, len(f.Entries)),
+	}
+	if f.Author != "" {
+		doc.Authors = []jsonAuthor{{Name: f.Author}}
+	}
+	for i, e := range f.Entries {
+		item := jsonItem{
+			ID:            entryID(e),
+			URL:           e.URL,
+			Title:         e.Title,
+			Summary:       e.Summary,
+			DatePublished: e.Published.UTC().Format(time.RFC3339),
+			DateModified:  e.Updated.UTC().Format(time.RFC3339),
+		}
+		// Items need either content, the summary stands in for it in excerpt mode
+		if e.HTML != "" {
+			item.ContentHTML = e.HTML
+		} else {
+			item.ContentText = e.Summary
+		}
+		for _, tag := range e.Tags {
+			item.Tags = append(item.Tags, tag.Name)
+		}
+		doc.Items[i] = item
+	}
+	return json.MarshalIndent(doc, "", "  ")
+}
@@ -0,0 +1,91 @@
+package feed
+
+import (
+	"encoding/xml"
+	"time"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+// RSS 2.0, see https://www.rssboard.org/rss-specification
+type rss struct {
+	XMLName   xml.Name   `xml:"rss"`
+	Version   string     `xml:"version,attr"`
+	AtomNS    string     `xml:"xmlns:atom,attr"`
+	ContentNS string     `xml:"xmlns:content,attr"`
+	Channel   rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Generator     string    `xml:"generator"`
+	Self          atomLink  `xml:"atom:link"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string        `xml:"title"`
+	Link        string        `xml:"link"`
+	GUID        rssGUID       `xml:"guid"`
+	PubDate     string        `xml:"pubDate"`
+	Description string        `xml:"description"`
+	Content     *cdata        `xml:"content:encoded,omitempty"`
+	Categories  []rssCategory `xml:"category"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type rssCategory struct {
+	Domain string `xml:"domain,attr"`
+	Value  string `xml:",chardata"`
+}
+
+type cdata struct {
+	Value string `xml:",cdata"`
+}
+
+// RSS encodes the feed, self is the URL it is served at
+func RSS(f *domain.Feed, self string) ([]byte, error) {
+	description := f.Description
+	if description == "" {
+		// Required by the specification
+		description = f.Title
+	}
+	doc := rss{
+		Version:   "2.0",
+		AtomNS:    atomNS,
+		ContentNS: "http://purl.org/rss/1.0/modules/content/",
+		Channel: rssChannel{
+			Title:         f.Title,
+			Link:          f.SiteURL + "/",
+			Description:   description,
+			LastBuildDate: f.Updated.UTC().Format(time.RFC1123Z),
+			Generator:     generator,
+			Self:          atomLink{Rel: "self", Href: self, Type: "application/rss+xml"},
+			Items:         make([]rssItem, len(f.Entries)),
+		},
+	}
+	for i, e := range f.Entries {
+		item := rssItem{
+			Title:       e.Title,
+			Link:        e.URL,
+			GUID:        rssGUID{Value: entryID(e)},
+			PubDate:     e.Published.UTC().Format(time.RFC1123Z),
+			Description: e.Summary,
+		}
+		if e.HTML != "" {
+			item.Content = &cdata{Value: e.HTML}
+		}
+		for _, tag := range e.Tags {
+			item.Categories = append(item.Categories, rssCategory{Domain: f.SiteURL + "/tags", Value: tag.Name})
+		}
+		doc.Channel.Items[i] = item
+	}
+	return marshalXML(doc)
+}
@@ -0,0 +1,250 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driving/rest/openapi"
+	"github.com/llascola/web-backend/internal/app/domain"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (h *Handler) ListAlbums(ctx *gin.Context) {
+	userID, ok := currentUserID(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	albums, err := h.albumService.ListAlbums(ctx, userID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp := make([]gin.H, len(albums))
+	for i, album := range albums {
+		resp[i] = albumResponse(album)
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) CreateAlbum(ctx *gin.Context) {
+	userID, ok := currentUserID(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req openapi.CreateAlbumJSONBody
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var slug, description string
+	var visibility domain.Visibility
+	if req.Slug != nil {
+		slug = *req.Slug
+	}
+	if req.Description != nil {
+		description = *req.Description
+	}
+	if req.Visibility != nil {
+		visibility = domain.Visibility(*req.Visibility)
+	}
+
+	album, err := h.albumService.CreateAlbum(ctx, userID, req.Title, slug, description, visibility)
+	if err != nil {
+		albumError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, albumResponse(album))
+}
+
+func (h *Handler) GetAlbum(ctx *gin.Context, id openapi_types.UUID) {
+	userID, _ := currentUserID(ctx)
+
+	album, err := h.albumService.GetAlbum(ctx, id, userID, currentRole(ctx))
+	if err != nil {
+		albumError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, albumResponse(album))
+}
+
+func (h *Handler) UpdateAlbum(ctx *gin.Context, id openapi_types.UUID) {
+	userID, _ := currentUserID(ctx)
+
+	body, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	var req openapi.UpdateAlbumJSONBody
+	// The raw fields tell an explicit null cover_image_id apart from an omitted one
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := json.Unmarshal(body, &fields); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	changes := domain.AlbumChanges{
+		Title:       req.Title,
+		Slug:        req.Slug,
+		Description: req.Description,
+	}
+	if req.Visibility != nil {
+		visibility := domain.Visibility(*req.Visibility)
+		changes.Visibility = &visibility
+	}
+	if _, ok := fields["cover_image_id"]; ok {
+		cover := uuid.Nil
+		if req.CoverImageId != nil {
+			cover = *req.CoverImageId
+		}
+		changes.CoverImageID = &cover
+	}
+
+	album, err := h.albumService.UpdateAlbum(ctx, id, userID, currentRole(ctx), changes)
+	if err != nil {
+		albumError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, albumResponse(album))
+}
+
+func (h *Handler) DeleteAlbum(ctx *gin.Context, id openapi_types.UUID) {
+	userID, _ := currentUserID(ctx)
+
+	if err := h.albumService.DeleteAlbum(ctx, id, userID, currentRole(ctx)); err != nil {
+		albumError(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+func (h *Handler) AddAlbumImages(ctx *gin.Context, id openapi_types.UUID) {
+	userID, _ := currentUserID(ctx)
+
+	var req openapi.AlbumImageIDs
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	album, err := h.albumService.AddImages(ctx, id, userID, currentRole(ctx), req.ImageIds)
+	if err != nil {
+		albumError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, albumResponse(album))
+}
+
+func (h *Handler) ReorderAlbumImages(ctx *gin.Context, id openapi_types.UUID) {
+	userID, _ := currentUserID(ctx)
+
+	var req openapi.AlbumImageIDs
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	album, err := h.albumService.ReorderImages(ctx, id, userID, currentRole(ctx), req.ImageIds)
+	if err != nil {
+		albumError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, albumResponse(album))
+}
+
+func (h *Handler) RemoveAlbumImage(ctx *gin.Context, id openapi_types.UUID, imageId openapi_types.UUID) {
+	userID, _ := currentUserID(ctx)
+
+	album, err := h.albumService.RemoveImage(ctx, id, imageId, userID, currentRole(ctx))
+	if err != nil {
+		albumError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, albumResponse(album))
+}
+
+func (h *Handler) GetPublicAlbum(ctx *gin.Context, slug string) {
+	page, err := h.albumService.GetPublicAlbum(ctx, slug)
+	if err != nil {
+		albumError(ctx, err)
+		return
+	}
+
+	images := make([]gin.H, len(page.Images))
+	for i, img := range page.Images {
+		images[i] = galleryImageResponse(img)
+	}
+	var cover gin.H
+	if page.Cover != nil {
+		cover = galleryImageResponse(*page.Cover)
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"slug":        page.Album.Slug,
+		"title":       page.Album.Title,
+		"description": page.Album.Description,
+		"cover":       cover,
+		"images":      images,
+	})
+}
+
+func albumError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domain.ErrAlbumNotFound),
+		errors.Is(err, domain.ErrImageNotFound),
+		errors.Is(err, domain.ErrImageNotInAlbum):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, domain.ErrSlugTaken):
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, domain.ErrInvalidAlbumTitle),
+		errors.Is(err, domain.ErrInvalidSlug),
+		errors.Is(err, domain.ErrInvalidVisibility),
+		errors.Is(err, domain.ErrInvalidAlbumOrder),
+		errors.Is(err, domain.ErrAlbumFull):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+func albumResponse(album *domain.Album) gin.H {
+	var cover *uuid.UUID
+	if id := album.Cover(); id != uuid.Nil {
+		cover = &id
+	}
+	return gin.H{
+		"id":             album.ID,
+		"slug":           album.Slug,
+		"title":          album.Title,
+		"description":    album.Description,
+		"visibility":     album.Visibility,
+		"cover_image_id": cover,
+		"image_ids":      album.ImageIDs,
+		"created_at":     album.CreatedAt,
+		"updated_at":     album.UpdatedAt,
+	}
+}
+
+func galleryImageResponse(img domain.GalleryImage) gin.H {
+	variants := make([]gin.H, len(img.Variants))
+	for i, v := range img.Variants {
+		variants[i] = gin.H{"width": v.Width, "height": v.Height, "url": v.URL}
+	}
+	resp := imageResponse(img.Image)
+	resp["variants"] = variants
+	return resp
+}
@@ -0,0 +1,167 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driving/rest/openapi"
+	"github.com/llascola/web-backend/internal/app/domain"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (h *Handler) ListPostComments(ctx *gin.Context, slug string) {
+	threads, err := h.commentService.ListComments(ctx, slug)
+	if err != nil {
+		commentError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, commentThreadsResponse(threads))
+}
+
+func (h *Handler) CreateComment(ctx *gin.Context, slug string) {
+	var req openapi.CreateCommentJSONBody
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Set by the optional auth middleware when a token was sent
+	var author domain.CommentAuthor
+	author.UserID, _ = currentUserID(ctx)
+	if req.AuthorName != nil {
+		author.Name = *req.AuthorName
+	}
+	if req.AuthorEmail != nil {
+		author.Email = *req.AuthorEmail
+	}
+	var parentID uuid.UUID
+	if req.ParentId != nil {
+		parentID = *req.ParentId
+	}
+	var honeypot string
+	if req.Website != nil {
+		honeypot = *req.Website
+	}
+
+	comment, err := h.commentService.AddComment(ctx, slug, parentID, author, req.Body, honeypot)
+	if err != nil {
+		commentError(ctx, err)
+		return
+	}
+	status := comment.Status
+	if status == domain.CommentSpam {
+		// Bots are not told they were caught
+		status = domain.CommentPending
+	}
+	ctx.JSON(http.StatusAccepted, gin.H{
+		"id":          comment.ID,
+		"parent_id":   optionalID(comment.ParentID),
+		"author_name": comment.AuthorName,
+		"body":        comment.Body,
+		"status":      status,
+		"created_at":  comment.CreatedAt,
+	})
+}
+
+func (h *Handler) ListComments(ctx *gin.Context, params openapi.ListCommentsParams) {
+	page := pageFilter(params.Limit, params.Offset, nil)
+	filter := domain.CommentFilter{Limit: page.Limit, Offset: page.Offset}
+	if params.Status != nil {
+		filter.Status = domain.CommentStatus(*params.Status)
+	}
+
+	list, err := h.commentService.ListModerationQueue(ctx, filter)
+	if err != nil {
+		commentError(ctx, err)
+		return
+	}
+	comments := make([]gin.H, len(list.Comments))
+	for i, c := range list.Comments {
+		comments[i] = commentResponse(c)
+	}
+	ctx.JSON(http.StatusOK, gin.H{"comments": comments, "total": list.Total})
+}
+
+func (h *Handler) ModerateComment(ctx *gin.Context, id openapi_types.UUID) {
+	var req openapi.ModerateCommentJSONBody
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	comment, err := h.commentService.ModerateComment(ctx, id, domain.CommentStatus(req.Status))
+	if err != nil {
+		commentError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, commentResponse(comment))
+}
+
+func commentError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domain.ErrPostNotFound),
+		errors.Is(err, domain.ErrCommentNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, domain.ErrUserNotFound):
+		// A token that outlived its account
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+	case errors.Is(err, domain.ErrCommentsClosed):
+		ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, domain.ErrInvalidTransition):
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, domain.ErrInvalidComment),
+		errors.Is(err, domain.ErrCommentTooLong),
+		errors.Is(err, domain.ErrInvalidReply),
+		errors.Is(err, domain.ErrInvalidEmail),
+		errors.Is(err, domain.ErrInvalidCommentStatus):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+func commentResponse(c *domain.Comment) gin.H {
+	return gin.H{
+		"id":           c.ID,
+		"post_id":      c.PostID,
+		"parent_id":    optionalID(c.ParentID),
+		"author_id":    optionalID(c.AuthorID),
+		"author_name":  c.AuthorName,
+		"author_email": c.AuthorEmail,
+		"body":         c.Body,
+		"status":       c.Status,
+		"created_at":   c.CreatedAt,
+		"updated_at":   c.UpdatedAt,
+	}
+}
+
+// commentThreadsResponse leaves out the author and body of hidden comments
+func commentThreadsResponse(threads []*domain.CommentThread) []gin.H {
+	resp := make([]gin.H, len(threads))
+	for i, t := range threads {
+		thread := gin.H{
+			"id":          t.Comment.ID,
+			"author_name": nil,
+			"body":        nil,
+			"hidden":      t.Hidden,
+			"created_at":  t.Comment.CreatedAt,
+			"replies":     commentThreadsResponse(t.Replies),
+		}
+		if !t.Hidden {
+			thread["author_name"] = t.Comment.AuthorName
+			thread["body"] = t.Comment.Body
+		}
+		resp[i] = thread
+	}
+	return resp
+}
+
+// optionalID renders uuid.Nil as null
+func optionalID(id uuid.UUID) *uuid.UUID {
+	if id == uuid.Nil {
+		return nil
+	}
+	return &id
+}
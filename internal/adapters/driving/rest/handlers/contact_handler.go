@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/llascola/web-backend/internal/adapters/driving/rest/openapi"
+	"github.com/llascola/web-backend/internal/app/domain"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (h *Handler) SendContactMessage(ctx *gin.Context) {
+	var req openapi.SendContactMessageJSONBody
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	form := domain.ContactForm{Name: req.Name, Email: req.Email, Message: req.Message}
+	if req.Subject != nil {
+		form.Subject = *req.Subject
+	}
+	var captchaToken, honeypot string
+	if req.CaptchaToken != nil {
+		captchaToken = *req.CaptchaToken
+	}
+	if req.Website != nil {
+		honeypot = *req.Website
+	}
+
+	if err := h.contactService.Submit(ctx, form, captchaToken, ctx.ClientIP(), honeypot); err != nil {
+		contactError(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusAccepted)
+}
+
+func (h *Handler) ListContactMessages(ctx *gin.Context, params openapi.ListContactMessagesParams) {
+	page := pageFilter(params.Limit, params.Offset, nil)
+	list, err := h.contactService.ListMessages(ctx, page.Limit, page.Offset)
+	if err != nil {
+		contactError(ctx, err)
+		return
+	}
+	messages := make([]gin.H, len(list.Messages))
+	for i, m := range list.Messages {
+		messages[i] = gin.H{
+			"id":         m.ID,
+			"name":       m.Name,
+			"email":      m.Email,
+			"subject":    m.Subject,
+			"message":    m.Message,
+			"created_at": m.CreatedAt,
+		}
+	}
+	ctx.JSON(http.StatusOK, gin.H{"messages": messages, "total": list.Total})
+}
+
+func (h *Handler) DeleteContactMessage(ctx *gin.Context, id openapi_types.UUID) {
+	if err := h.contactService.DeleteMessage(ctx, id); err != nil {
+		contactError(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+func contactError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domain.ErrContactMessageNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, domain.ErrInvalidContact),
+		errors.Is(err, domain.ErrContactTooLong),
+		errors.Is(err, domain.ErrInvalidEmail),
+		errors.Is(err, domain.ErrCaptchaFailed):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
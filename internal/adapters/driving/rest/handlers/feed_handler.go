@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/llascola/web-backend/internal/adapters/driving/rest/feed"
+	"github.com/llascola/web-backend/internal/adapters/driving/rest/openapi"
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+// documentMaxAge is how long shared caches may keep generated documents such as feeds
+const documentMaxAge = "public, max-age=300"
+
+func (h *Handler) GetRSSFeed(ctx *gin.Context, params openapi.GetRSSFeedParams) {
+	h.serveFeed(ctx, params.Tag, feed.RSSContentType, feed.RSS)
+}
+
+func (h *Handler) GetAtomFeed(ctx *gin.Context, params openapi.GetAtomFeedParams) {
+	h.serveFeed(ctx, params.Tag, feed.AtomContentType, feed.Atom)
+}
+
+func (h *Handler) GetJSONFeed(ctx *gin.Context, params openapi.GetJSONFeedParams) {
+	h.serveFeed(ctx, params.Tag, feed.JSONContentType, feed.JSON)
+}
+
+func (h *Handler) serveFeed(ctx *gin.Context, tag *string, contentType string, encode func(*domain.Feed, string) ([]byte, error)) {
+	var slug string
+	if tag != nil {
+		slug = *tag
+	}
+
+	f, err := h.feedService.Feed(ctx, slug)
+	switch {
+	case errors.Is(err, domain.ErrTagNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	case err != nil:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	// The feed's own address only keeps the tag, so tracking parameters don't change its id or ETag
+	self := url.URL{Path: ctx.Request.URL.Path}
+	if slug != "" {
+		self.RawQuery = url.Values{"tag": {slug}}.Encode()
+	}
+	body, err := encode(f, f.SiteURL+self.String())
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	serveDocument(ctx, contentType, f.Updated, body)
+}
+
+// serveDocument writes a generated document with validators, answering conditional
+// requests with 304 Not Modified. The ETag is derived from the content.
+func serveDocument(ctx *gin.Context, contentType string, modified time.Time, body []byte) {
+	sum := sha256.Sum256(body)
+	ctx.Header("Content-Type", contentType)
+	ctx.Header("Cache-Control", documentMaxAge)
+	ctx.Header("ETag", `"`+hex.EncodeToString(sum[:16])+`"`)
+	http.ServeContent(ctx.Writer, ctx.Request, "", modified, bytes.NewReader(body))
+}
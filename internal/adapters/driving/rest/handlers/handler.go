@@ -1,20 +1,64 @@
 package handlers
 
 import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/llascola/web-backend/internal/app"
+	"github.com/llascola/web-backend/internal/app/domain"
 	"github.com/llascola/web-backend/internal/app/inports"
 )
 
 type Handler struct {
-	authService  inports.AuthService
-	imageService inports.ImageService
-	userService  inports.UserService
+	albumService           inports.AlbumService
+	authService            inports.AuthService
+	commentService         inports.CommentService
+	contactService         inports.ContactService
+	feedService            inports.FeedService
+	imageService           inports.ImageService
+	postService            inports.PostService
+	redirectService        inports.RedirectService
+	resumableUploadService inports.ResumableUploadService
+	searchService          inports.SearchService
+	sitemapService         inports.SitemapService
+	tagService             inports.TagService
+	userService            inports.UserService
 }
 
 func NewHandler(app *app.Application) *Handler {
 	return &Handler{
-		authService:  app.Service.AuthService,
-		imageService: app.Service.ImageService,
-		userService:  app.Service.UserService,
+		albumService:           app.Service.AlbumService,
+		authService:            app.Service.AuthService,
+		commentService:         app.Service.CommentService,
+		contactService:         app.Service.ContactService,
+		feedService:            app.Service.FeedService,
+		imageService:           app.Service.ImageService,
+		postService:            app.Service.PostService,
+		redirectService:        app.Service.RedirectService,
+		resumableUploadService: app.Service.ResumableUploadService,
+		searchService:          app.Service.SearchService,
+		sitemapService:         app.Service.SitemapService,
+		tagService:             app.Service.TagService,
+		userService:            app.Service.UserService,
 	}
 }
+
+// currentRole returns the role set by the auth middleware, empty for anonymous requests
+func currentRole(ctx *gin.Context) domain.UserRole {
+	role, _ := ctx.Get("role")
+	roleStr, _ := role.(string)
+	return domain.UserRole(roleStr)
+}
+
+// currentUserID returns the authenticated user set by the auth middleware
+func currentUserID(ctx *gin.Context) (uuid.UUID, bool) {
+	sub, exists := ctx.Get("userID")
+	if !exists {
+		return uuid.Nil, false
+	}
+	subStr, _ := sub.(string)
+	id, err := uuid.Parse(subStr)
+	if err != nil {
+		return uuid.Nil, false
+	}
+	return id, true
+}
@@ -1,13 +1,20 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driving/rest/openapi"
+	"github.com/llascola/web-backend/internal/app/domain"
 	"github.com/llascola/web-backend/internal/app/outports"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 func (h *Handler) UploadImage(ctx *gin.Context) {
+	userID, _ := currentUserID(ctx)
+
 	fileHeader, err := ctx.FormFile("file")
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": "file is required"})
@@ -21,15 +28,230 @@ func (h *Handler) UploadImage(ctx *gin.Context) {
 	}
 	defer file.Close()
 
-	url, err := h.imageService.UploadImage(ctx, file, outports.FileMetadata{
+	img, err := h.imageService.UploadImage(ctx, file, outports.FileMetadata{
 		Name:        fileHeader.Filename,
 		Size:        fileHeader.Size,
 		ContentType: fileHeader.Header.Get("Content-Type"),
+	}, domain.UploadOptions{
+		UserID:       userID,
+		Role:         currentRole(ctx),
+		KeepMetadata: ctx.PostForm("keep_metadata") == "true",
+		Visibility:   domain.Visibility(ctx.PostForm("visibility")),
 	})
+	if err != nil {
+		if limitError(ctx, err) {
+			return
+		}
+		switch {
+		case errors.Is(err, domain.ErrImageTooLarge):
+			ctx.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": err.Error()})
+		case errors.Is(err, domain.ErrInvalidFormat),
+			errors.Is(err, domain.ErrInvalidVisibility),
+			errors.Is(err, domain.ErrImageTooManyPixels),
+			errors.Is(err, domain.ErrSuspiciousImage):
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, imageResponse(img))
+}
+
+func (h *Handler) DeleteImage(ctx *gin.Context, id openapi_types.UUID) {
+	userID, _ := currentUserID(ctx)
+
+	err := h.imageService.DeleteImage(ctx, id, userID, currentRole(ctx))
+	if errors.Is(err, domain.ErrImageNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (h *Handler) UpdateImage(ctx *gin.Context, id openapi_types.UUID) {
+	userID, _ := currentUserID(ctx)
+
+	var req openapi.UpdateImageJSONBody
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	changes := domain.ImageDescriptionChanges{
+		Caption: req.Caption,
+		Credit:  req.Credit,
+		License: req.License,
+		Tags:    req.Tags,
+	}
+	if req.Alt != nil {
+		changes.Alt = *req.Alt
+	}
+
+	img, err := h.imageService.UpdateImage(ctx, id, userID, currentRole(ctx), changes)
+	switch {
+	case errors.Is(err, domain.ErrImageNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, domain.ErrInvalidLocale), errors.Is(err, domain.ErrDescriptionTooLong),
+		errors.Is(err, domain.ErrInvalidTag), errors.Is(err, domain.ErrTooManyTags):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case err != nil:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusOK, imageResponse(img))
+	}
+}
+
+// GetPublicImage serves anonymous visitors, private images do not exist for them
+func (h *Handler) GetPublicImage(ctx *gin.Context, id openapi_types.UUID) {
+	img, err := h.imageService.GetImage(ctx, id, uuid.Nil, "")
+	if errors.Is(err, domain.ErrImageNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, imageResponse(img))
+}
+
+func (h *Handler) GetImageURL(ctx *gin.Context, id openapi_types.UUID) {
+	userID, _ := currentUserID(ctx)
+
+	url, expiresAt, err := h.imageService.ImageURL(ctx, id, userID, currentRole(ctx))
+	if errors.Is(err, domain.ErrImageNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	ctx.JSON(http.StatusOK, gin.H{"url": url})
+	resp := gin.H{"url": url, "expires_at": nil}
+	if !expiresAt.IsZero() {
+		resp["expires_at"] = expiresAt
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) GetImageFile(ctx *gin.Context, id openapi_types.UUID) {
+	userID, _ := currentUserID(ctx)
+
+	body, meta, err := h.imageService.OpenImage(ctx, id, userID, currentRole(ctx))
+	if errors.Is(err, domain.ErrImageNotFound) || errors.Is(err, outports.ErrFileNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": domain.ErrImageNotFound.Error()})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	defer body.Close()
+
+	// Private content must not end up in shared caches
+	ctx.Header("Cache-Control", "private, no-store")
+	ctx.DataFromReader(http.StatusOK, meta.Size, meta.ContentType, body, nil)
+}
+
+func (h *Handler) GetTransformedImage(ctx *gin.Context, id openapi_types.UUID, params openapi.GetTransformedImageParams) {
+	var t domain.ImageTransform
+	if params.W != nil {
+		t.Width = *params.W
+	}
+	if params.H != nil {
+		t.Height = *params.H
+	}
+	if params.Fit != nil {
+		t.Fit = domain.ImageFit(*params.Fit)
+	}
+	if params.Fmt != nil {
+		t.Format = string(*params.Fmt)
+	}
+	if params.Q != nil {
+		t.Quality = *params.Q
+	}
+	var signature string
+	if params.S != nil {
+		signature = *params.S
+	}
+
+	body, meta, err := h.imageService.TransformImage(ctx, id, t, signature)
+	switch {
+	case errors.Is(err, domain.ErrImageNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	case errors.Is(err, domain.ErrInvalidTransform):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	case errors.Is(err, domain.ErrTransformForbidden):
+		ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	case err != nil:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	defer body.Close()
+
+	// Variants never change once generated
+	ctx.Header("Cache-Control", "public, max-age=31536000, immutable")
+	ctx.Header("ETag", meta.ETag)
+
+	if ctx.GetHeader("If-None-Match") == meta.ETag {
+		ctx.Status(http.StatusNotModified)
+		return
+	}
+
+	ctx.DataFromReader(http.StatusOK, meta.Size, meta.ContentType, body, nil)
+}
+
+// limitError writes the structured response of an upload rejected by the account limits.
+// It reports false, writing nothing, for any other error.
+func limitError(ctx *gin.Context, err error) bool {
+	var le *domain.LimitError
+	if !errors.As(err, &le) {
+		return false
+	}
+
+	status := http.StatusUnprocessableEntity
+	if errors.Is(err, domain.ErrImageTooLarge) || errors.Is(err, domain.ErrQuotaExceeded) {
+		status = http.StatusRequestEntityTooLarge
+	}
+	ctx.JSON(status, gin.H{
+		"error": le.Error(),
+		"limit": le.Limit,
+		"max":   le.Max,
+		"value": le.Value,
+	})
+	return true
+}
+
+func imageResponse(img *domain.Image) gin.H {
+	alt := img.Description.Alt
+	if alt == nil {
+		alt = map[string]string{}
+	}
+	return gin.H{
+		"id":             img.ID,
+		"url":            img.URL,
+		"visibility":     img.Visibility,
+		"content_type":   img.ContentType,
+		"size":           img.Size,
+		"width":          img.Preview.Width,
+		"height":         img.Preview.Height,
+		"blur_hash":      img.Preview.BlurHash,
+		"dominant_color": img.Preview.DominantColor,
+		"alt":            alt,
+		"caption":        img.Description.Caption,
+		"credit":         img.Description.Credit,
+		"license":        img.Description.License,
+		"tags":           tagsResponse(img.Tags),
+		"created_at":     img.CreatedAt,
+	}
 }
@@ -0,0 +1,248 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driving/rest/openapi"
+	"github.com/llascola/web-backend/internal/app/domain"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (h *Handler) ListPosts(ctx *gin.Context, params openapi.ListPostsParams) {
+	filter := pageFilter(params.Limit, params.Offset, params.Tag)
+	if params.Status != nil {
+		filter.Status = domain.PostStatus(*params.Status)
+	}
+
+	list, err := h.postService.ListPosts(ctx, filter)
+	if err != nil {
+		postError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, postListResponse(list, postResponse))
+}
+
+func (h *Handler) CreatePost(ctx *gin.Context) {
+	userID, ok := currentUserID(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req openapi.CreatePostJSONBody
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var slug, body, excerpt string
+	var status domain.PostStatus
+	var publishAt time.Time
+	var tags []string
+	if req.Slug != nil {
+		slug = *req.Slug
+	}
+	if req.Body != nil {
+		body = *req.Body
+	}
+	if req.Excerpt != nil {
+		excerpt = *req.Excerpt
+	}
+	if req.Status != nil {
+		status = domain.PostStatus(*req.Status)
+	}
+	if req.PublishAt != nil {
+		publishAt = *req.PublishAt
+	}
+	if req.Tags != nil {
+		tags = *req.Tags
+	}
+
+	post, err := h.postService.CreatePost(ctx, userID, req.Title, slug, body, excerpt, status, publishAt, tags)
+	if err != nil {
+		postError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, postResponse(post))
+}
+
+func (h *Handler) GetPost(ctx *gin.Context, id openapi_types.UUID) {
+	post, err := h.postService.GetPost(ctx, id)
+	if err != nil {
+		postError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, postResponse(post))
+}
+
+func (h *Handler) UpdatePost(ctx *gin.Context, id openapi_types.UUID) {
+	var req openapi.UpdatePostJSONBody
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	changes := domain.PostChanges{
+		Title:     req.Title,
+		Slug:      req.Slug,
+		Body:      req.Body,
+		Excerpt:   req.Excerpt,
+		PublishAt: req.PublishAt,
+		Tags:      req.Tags,
+	}
+	if req.Status != nil {
+		status := domain.PostStatus(*req.Status)
+		changes.Status = &status
+	}
+
+	userID, _ := currentUserID(ctx)
+	post, err := h.postService.UpdatePost(ctx, id, userID, changes)
+	if err != nil {
+		postError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, postResponse(post))
+}
+
+func (h *Handler) DeletePost(ctx *gin.Context, id openapi_types.UUID) {
+	if err := h.postService.DeletePost(ctx, id); err != nil {
+		postError(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+func (h *Handler) PreviewPost(ctx *gin.Context, id openapi_types.UUID) {
+	preview, err := h.postService.PreviewPost(ctx, id)
+	if err != nil {
+		postError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, gin.H{
+		"url":        preview.URL,
+		"token":      preview.Token,
+		"expires_at": preview.ExpiresAt,
+	})
+}
+
+func (h *Handler) ListPublishedPosts(ctx *gin.Context, params openapi.ListPublishedPostsParams) {
+	list, err := h.postService.ListPublishedPosts(ctx, pageFilter(params.Limit, params.Offset, params.Tag))
+	if err != nil {
+		postError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, postListResponse(list, publicPostResponse))
+}
+
+func (h *Handler) GetPublishedPost(ctx *gin.Context, slug string, params openapi.GetPublishedPostParams) {
+	var token string
+	if params.Preview != nil {
+		token = *params.Preview
+	}
+
+	post, err := h.postService.GetPublishedPost(ctx, slug, token)
+	if err != nil {
+		postError(ctx, err)
+		return
+	}
+	if post.Slug != slug {
+		// Renamed, links to the old slug keep working
+		location := "/posts/" + post.Slug
+		if ctx.Request.URL.RawQuery != "" {
+			location += "?" + ctx.Request.URL.RawQuery
+		}
+		ctx.Redirect(http.StatusMovedPermanently, location)
+		return
+	}
+	if !post.IsPublic() {
+		// Shown through a preview link, keep it out of caches and search engines
+		ctx.Header("Cache-Control", "private, no-store")
+		ctx.Header("X-Robots-Tag", "noindex")
+	}
+	ctx.JSON(http.StatusOK, publicPostResponse(post))
+}
+
+// pageFilter reads the query parameters shared by post listings, the service applies the defaults
+func pageFilter(limit, offset *int, tag *string) domain.PostFilter {
+	var filter domain.PostFilter
+	if tag != nil {
+		filter.Tag = *tag
+	}
+	if limit != nil {
+		filter.Limit = *limit
+	}
+	if offset != nil {
+		filter.Offset = *offset
+	}
+	return filter
+}
+
+func postError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domain.ErrPostNotFound),
+		errors.Is(err, domain.ErrRevisionNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, domain.ErrSlugTaken):
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, domain.ErrInvalidPostTitle),
+		errors.Is(err, domain.ErrInvalidSlug),
+		errors.Is(err, domain.ErrInvalidPostStatus),
+		errors.Is(err, domain.ErrExcerptTooLong),
+		errors.Is(err, domain.ErrPublishAtRequired),
+		errors.Is(err, domain.ErrInvalidTag),
+		errors.Is(err, domain.ErrTooManyTags):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+func postListResponse(list *domain.PostList, response func(*domain.Post) gin.H) gin.H {
+	posts := make([]gin.H, len(list.Posts))
+	for i, post := range list.Posts {
+		posts[i] = response(post)
+	}
+	return gin.H{"posts": posts, "total": list.Total}
+}
+
+func postResponse(post *domain.Post) gin.H {
+	var author *uuid.UUID
+	if post.AuthorID != uuid.Nil {
+		author = &post.AuthorID
+	}
+	resp := publicPostResponse(post)
+	resp["id"] = post.ID
+	resp["author_id"] = author
+	resp["excerpt"] = post.Excerpt
+	resp["status"] = post.Status
+	resp["publish_at"] = optionalTime(post.PublishAt)
+	resp["published_at"] = optionalTime(post.PublishedAt)
+	resp["created_at"] = post.CreatedAt
+	return resp
+}
+
+// publicPostResponse leaves out what visitors need not know, such as the author account
+func publicPostResponse(post *domain.Post) gin.H {
+	return gin.H{
+		"slug":         post.Slug,
+		"title":        post.Title,
+		"body":         post.Body,
+		"html":         post.HTML,
+		"summary":      post.Summary,
+		"tags":         tagsResponse(post.Tags),
+		"published_at": optionalTime(post.PublishedAt),
+		"updated_at":   post.UpdatedAt,
+	}
+}
+
+// optionalTime renders a zero time as null
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/llascola/web-backend/internal/adapters/driving/rest/openapi"
+	"github.com/llascola/web-backend/internal/app/domain"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (h *Handler) ListRedirects(ctx *gin.Context, params openapi.ListRedirectsParams) {
+	page := pageFilter(params.Limit, params.Offset, nil)
+	list, err := h.redirectService.ListRedirects(ctx, page.Limit, page.Offset)
+	if err != nil {
+		redirectError(ctx, err)
+		return
+	}
+	redirects := make([]gin.H, len(list.Redirects))
+	for i, r := range list.Redirects {
+		redirects[i] = redirectResponse(r)
+	}
+	ctx.JSON(http.StatusOK, gin.H{"redirects": redirects, "total": list.Total})
+}
+
+func (h *Handler) CreateRedirect(ctx *gin.Context) {
+	var req openapi.CreateRedirectJSONBody
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	var code int
+	if req.Code != nil {
+		code = int(*req.Code)
+	}
+
+	redirect, err := h.redirectService.CreateRedirect(ctx, req.From, req.To, code)
+	if err != nil {
+		redirectError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, redirectResponse(redirect))
+}
+
+func (h *Handler) DeleteRedirect(ctx *gin.Context, id openapi_types.UUID) {
+	if err := h.redirectService.DeleteRedirect(ctx, id); err != nil {
+		redirectError(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+func redirectError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domain.ErrRedirectNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, domain.ErrRedirectExists),
+		errors.Is(err, domain.ErrRedirectLoop):
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, domain.ErrInvalidRedirect),
+		errors.Is(err, domain.ErrReservedPath),
+		errors.Is(err, domain.ErrInvalidRedirectCode):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+func redirectResponse(r *domain.Redirect) gin.H {
+	return gin.H{
+		"id":         r.ID,
+		"from":       r.From,
+		"to":         r.To,
+		"code":       r.Code,
+		"created_at": r.CreatedAt,
+	}
+}
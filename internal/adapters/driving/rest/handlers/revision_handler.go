@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/llascola/web-backend/internal/adapters/driving/rest/openapi"
+	"github.com/llascola/web-backend/internal/app/domain"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (h *Handler) ListPostRevisions(ctx *gin.Context, id openapi_types.UUID, params openapi.ListPostRevisionsParams) {
+	page := pageFilter(params.Limit, params.Offset, nil)
+	list, err := h.postService.ListRevisions(ctx, id, page.Limit, page.Offset)
+	if err != nil {
+		postError(ctx, err)
+		return
+	}
+	revisions := make([]gin.H, len(list.Revisions))
+	for i, rev := range list.Revisions {
+		revisions[i] = revisionResponse(rev)
+		delete(revisions[i], "body")
+	}
+	ctx.JSON(http.StatusOK, gin.H{"revisions": revisions, "total": list.Total})
+}
+
+func (h *Handler) GetPostRevision(ctx *gin.Context, id openapi_types.UUID, number int) {
+	rev, err := h.postService.GetRevision(ctx, id, number)
+	if err != nil {
+		postError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, revisionResponse(rev))
+}
+
+func (h *Handler) DiffPostRevisions(ctx *gin.Context, id openapi_types.UUID, params openapi.DiffPostRevisionsParams) {
+	diff, err := h.postService.DiffRevisions(ctx, id, params.From, params.To)
+	if err != nil {
+		postError(ctx, err)
+		return
+	}
+	lines := make([]gin.H, len(diff.Lines))
+	for i, l := range diff.Lines {
+		lines[i] = gin.H{
+			"op":       l.Op,
+			"text":     l.Text,
+			"old_line": optionalNumber(l.OldLine),
+			"new_line": optionalNumber(l.NewLine),
+		}
+	}
+	from, to := revisionResponse(diff.From), revisionResponse(diff.To)
+	delete(from, "body")
+	delete(to, "body")
+	ctx.JSON(http.StatusOK, gin.H{"from": from, "to": to, "lines": lines})
+}
+
+func (h *Handler) RestorePostRevision(ctx *gin.Context, id openapi_types.UUID, number int) {
+	userID, _ := currentUserID(ctx)
+	post, err := h.postService.RestoreRevision(ctx, id, userID, number)
+	if err != nil {
+		postError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, postResponse(post))
+}
+
+func revisionResponse(rev *domain.PostRevision) gin.H {
+	return gin.H{
+		"number":        rev.Number,
+		"editor_id":     optionalID(rev.EditorID),
+		"title":         rev.Title,
+		"slug":          rev.Slug,
+		"body":          rev.Body,
+		"excerpt":       rev.Excerpt,
+		"tags":          rev.Tags,
+		"status":        rev.Status,
+		"restored_from": optionalNumber(rev.RestoredFrom),
+		"created_at":    rev.CreatedAt,
+	}
+}
+
+// optionalNumber renders 0 as null
+func optionalNumber(n int) *int {
+	if n == 0 {
+		return nil
+	}
+	return &n
+}
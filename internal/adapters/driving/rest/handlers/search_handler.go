@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/llascola/web-backend/internal/adapters/driving/rest/openapi"
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+func (h *Handler) Search(ctx *gin.Context, params openapi.SearchParams) {
+	page := pageFilter(params.Limit, params.Offset, nil)
+	results, err := h.searchService.Search(ctx, params.Q, page.Limit, page.Offset)
+	switch {
+	case errors.Is(err, domain.ErrInvalidSearchQuery):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	case err != nil:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	hits := make([]gin.H, len(results.Hits))
+	for i, hit := range results.Hits {
+		if hit.Image != nil {
+			hits[i] = gin.H{
+				"type":              "image",
+				"highlighted_title": domain.HighlightHTML(hit.Title),
+				"snippet":           domain.HighlightHTML(hit.Snippet),
+				"image":             imageResponse(hit.Image),
+			}
+			continue
+		}
+		hits[i] = gin.H{
+			"type":              "post",
+			"slug":              hit.Post.Slug,
+			"title":             hit.Post.Title,
+			"highlighted_title": domain.HighlightHTML(hit.Title),
+			"snippet":           domain.HighlightHTML(hit.Snippet),
+			"summary":           hit.Post.Summary,
+			"tags":              tagsResponse(hit.Post.Tags),
+			"published_at":      optionalTime(hit.Post.PublishedAt),
+		}
+	}
+	ctx.JSON(http.StatusOK, gin.H{"results": hits, "total": results.Total})
+}
@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/llascola/web-backend/internal/adapters/driving/rest/openapi"
+	"github.com/llascola/web-backend/internal/adapters/driving/rest/sitemap"
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+func (h *Handler) GetSitemap(ctx *gin.Context, params openapi.GetSitemapParams) {
+	var page int
+	if params.Page != nil {
+		page = *params.Page
+	}
+
+	s, err := h.sitemapService.Sitemap(ctx, page)
+	switch {
+	case errors.Is(err, domain.ErrSitemapNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	case err != nil:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	body, err := sitemap.XML(s)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	serveDocument(ctx, sitemap.XMLContentType, s.Modified, body)
+}
+
+func (h *Handler) GetRobots(ctx *gin.Context) {
+	// Only changes with the configuration, the ETag is enough to revalidate
+	serveDocument(ctx, sitemap.RobotsContentType, time.Time{}, sitemap.Robots(h.sitemapService.Robots()))
+}
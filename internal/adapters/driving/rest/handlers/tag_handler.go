@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/llascola/web-backend/internal/adapters/driving/rest/openapi"
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+func (h *Handler) ListPublicTags(ctx *gin.Context) {
+	h.listTags(ctx, false)
+}
+
+func (h *Handler) ListTags(ctx *gin.Context) {
+	h.listTags(ctx, true)
+}
+
+func (h *Handler) listTags(ctx *gin.Context, includeUnused bool) {
+	counts, err := h.tagService.ListTags(ctx, includeUnused)
+	if err != nil {
+		tagError(ctx, err)
+		return
+	}
+
+	resp := make([]gin.H, len(counts))
+	for i, c := range counts {
+		resp[i] = gin.H{
+			"slug":   c.Slug,
+			"name":   c.Name,
+			"posts":  c.Posts,
+			"images": c.Images,
+		}
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) RenameTag(ctx *gin.Context, slug string) {
+	var req openapi.RenameTagJSONBody
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	tag, err := h.tagService.RenameTag(ctx, slug, req.Name)
+	if err != nil {
+		tagError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"slug": tag.Slug, "name": tag.Name})
+}
+
+func (h *Handler) MergeTags(ctx *gin.Context) {
+	var req openapi.MergeTagsJSONBody
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.tagService.MergeTags(ctx, req.Sources, req.Target); err != nil {
+		tagError(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+func tagError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domain.ErrTagNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, domain.ErrTagExists):
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, domain.ErrInvalidTag),
+		errors.Is(err, domain.ErrInvalidMerge):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+func tagsResponse(tags []domain.Tag) []gin.H {
+	resp := make([]gin.H, len(tags))
+	for i, tag := range tags {
+		resp[i] = gin.H{"slug": tag.Slug, "name": tag.Name}
+	}
+	return resp
+}
@@ -0,0 +1,206 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/llascola/web-backend/internal/adapters/driving/rest/openapi"
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+const (
+	tusVersion    = "1.0.0"
+	tusExtensions = "creation,expiration,termination"
+	tusBasePath   = "/api/images/tus/"
+)
+
+func (h *Handler) TusOptions(ctx *gin.Context) {
+	ctx.Header("Tus-Resumable", tusVersion)
+	ctx.Header("Tus-Version", tusVersion)
+	ctx.Header("Tus-Extension", tusExtensions)
+	ctx.Header("Tus-Max-Size", strconv.Itoa(domain.MaxImageSize))
+	ctx.Status(http.StatusNoContent)
+}
+
+func (h *Handler) TusCreate(ctx *gin.Context, params openapi.TusCreateParams) {
+	if !tusPreamble(ctx, params.TusResumable) {
+		return
+	}
+	userID, ok := currentUserID(ctx)
+	if !ok {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	if params.UploadLength == nil {
+		ctx.String(http.StatusBadRequest, "Upload-Length header required")
+		return
+	}
+
+	var metadata map[string]string
+	if params.UploadMetadata != nil {
+		var err error
+		if metadata, err = parseTusMetadata(*params.UploadMetadata); err != nil {
+			ctx.String(http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	upload, err := h.resumableUploadService.Create(ctx, userID, currentRole(ctx), *params.UploadLength, metadata)
+	if err != nil {
+		tusError(ctx, err)
+		return
+	}
+
+	ctx.Header("Location", tusBasePath+upload.ID.String())
+	ctx.Header("Upload-Expires", upload.ExpiresAt.UTC().Format(http.TimeFormat))
+	ctx.Status(http.StatusCreated)
+}
+
+func (h *Handler) TusHead(ctx *gin.Context, id openapi.TusUploadID, params openapi.TusHeadParams) {
+	if !tusPreamble(ctx, params.TusResumable) {
+		return
+	}
+	userID, ok := currentUserID(ctx)
+	if !ok {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	upload, err := h.resumableUploadService.Get(ctx, id, userID)
+	if err != nil {
+		tusError(ctx, err)
+		return
+	}
+
+	ctx.Header("Cache-Control", "no-store")
+	ctx.Header("Upload-Offset", strconv.FormatInt(upload.Offset, 10))
+	ctx.Header("Upload-Length", strconv.FormatInt(upload.Length, 10))
+	ctx.Header("Upload-Expires", upload.ExpiresAt.UTC().Format(http.TimeFormat))
+	if len(upload.Metadata) > 0 {
+		ctx.Header("Upload-Metadata", formatTusMetadata(upload.Metadata))
+	}
+	if upload.Completed() {
+		ctx.Header("Image-Id", upload.ImageID.String())
+	}
+	ctx.Status(http.StatusOK)
+}
+
+func (h *Handler) TusPatch(ctx *gin.Context, id openapi.TusUploadID, params openapi.TusPatchParams) {
+	if !tusPreamble(ctx, params.TusResumable) {
+		return
+	}
+	userID, ok := currentUserID(ctx)
+	if !ok {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	if ctx.ContentType() != "application/offset+octet-stream" {
+		ctx.String(http.StatusUnsupportedMediaType, "Content-Type must be application/offset+octet-stream")
+		return
+	}
+	if params.UploadOffset == nil {
+		ctx.String(http.StatusBadRequest, "Upload-Offset header required")
+		return
+	}
+
+	upload, err := h.resumableUploadService.Append(ctx, id, *params.UploadOffset, ctx.Request.Body, domain.UploadOptions{
+		UserID: userID,
+		Role:   currentRole(ctx),
+	})
+	if err != nil {
+		tusError(ctx, err)
+		return
+	}
+
+	ctx.Header("Upload-Offset", strconv.FormatInt(upload.Offset, 10))
+	ctx.Header("Upload-Expires", upload.ExpiresAt.UTC().Format(http.TimeFormat))
+	if upload.Completed() {
+		ctx.Header("Image-Id", upload.ImageID.String())
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+func (h *Handler) TusDelete(ctx *gin.Context, id openapi.TusUploadID, params openapi.TusDeleteParams) {
+	if !tusPreamble(ctx, params.TusResumable) {
+		return
+	}
+	userID, ok := currentUserID(ctx)
+	if !ok {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	if err := h.resumableUploadService.Terminate(ctx, id, userID); err != nil {
+		tusError(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// tusPreamble sets the protocol header and rejects clients speaking another version
+func tusPreamble(ctx *gin.Context, version *string) bool {
+	ctx.Header("Tus-Resumable", tusVersion)
+	if version == nil || *version != tusVersion {
+		ctx.Header("Tus-Version", tusVersion)
+		ctx.AbortWithStatus(http.StatusPreconditionFailed)
+		return false
+	}
+	return true
+}
+
+func tusError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domain.ErrUploadNotFound):
+		ctx.String(http.StatusNotFound, err.Error())
+	case errors.Is(err, domain.ErrUploadExpired):
+		ctx.String(http.StatusGone, err.Error())
+	case errors.Is(err, domain.ErrOffsetMismatch):
+		ctx.String(http.StatusConflict, err.Error())
+	case errors.Is(err, domain.ErrImageTooLarge),
+		errors.Is(err, domain.ErrQuotaExceeded),
+		errors.Is(err, domain.ErrUploadOverflow):
+		ctx.String(http.StatusRequestEntityTooLarge, err.Error())
+	case errors.Is(err, domain.ErrFileCountExceeded), errors.Is(err, domain.ErrContentTypeNotAllowed):
+		ctx.String(http.StatusUnprocessableEntity, err.Error())
+	case errors.Is(err, domain.ErrInvalidUploadLength),
+		errors.Is(err, domain.ErrInvalidFormat),
+		errors.Is(err, domain.ErrInvalidVisibility),
+		errors.Is(err, domain.ErrImageTooManyPixels),
+		errors.Is(err, domain.ErrSuspiciousImage):
+		ctx.String(http.StatusBadRequest, err.Error())
+	default:
+		ctx.String(http.StatusInternalServerError, err.Error())
+	}
+}
+
+// parseTusMetadata decodes "key base64value,key2 base64value2", values are optional
+func parseTusMetadata(header string) (map[string]string, error) {
+	metadata := make(map[string]string)
+	for _, pair := range strings.Split(header, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		key, encoded, _ := strings.Cut(pair, " ")
+		value, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, errors.New("invalid Upload-Metadata encoding")
+		}
+		metadata[key] = string(value)
+	}
+	return metadata, nil
+}
+
+func formatTusMetadata(metadata map[string]string) string {
+	pairs := make([]string, 0, len(metadata))
+	for key, value := range metadata {
+		pairs = append(pairs, key+" "+base64.StdEncoding.EncodeToString([]byte(value)))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
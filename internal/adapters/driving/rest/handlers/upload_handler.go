@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/llascola/web-backend/internal/adapters/driving/rest/openapi"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (h *Handler) CreateUpload(ctx *gin.Context) {
+	userID, ok := currentUserID(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req openapi.CreateUploadJSONBody
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	upload, url, err := h.imageService.CreateUpload(ctx, outports.FileMetadata{
+		Name:        req.Filename,
+		Size:        req.Size,
+		ContentType: string(req.ContentType),
+	}, req.Checksum, userID, currentRole(ctx))
+	if err != nil {
+		if limitError(ctx, err) {
+			return
+		}
+		switch {
+		case errors.Is(err, domain.ErrImageTooLarge),
+			errors.Is(err, domain.ErrInvalidFormat),
+			errors.Is(err, domain.ErrInvalidChecksum):
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"id":         upload.ID,
+		"upload_url": url,
+		"expires_at": upload.ExpiresAt,
+	})
+}
+
+func (h *Handler) CompleteUpload(ctx *gin.Context, id openapi_types.UUID) {
+	userID, ok := currentUserID(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	// The body is optional
+	var req openapi.CompleteUploadJSONBody
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	opts := domain.UploadOptions{
+		UserID:       userID,
+		Role:         currentRole(ctx),
+		KeepMetadata: req.KeepMetadata != nil && *req.KeepMetadata,
+	}
+	if req.Visibility != nil {
+		opts.Visibility = domain.Visibility(*req.Visibility)
+	}
+
+	img, err := h.imageService.CompleteUpload(ctx, id, opts)
+	if err != nil {
+		if limitError(ctx, err) {
+			return
+		}
+		switch {
+		case errors.Is(err, domain.ErrUploadNotFound):
+			ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case errors.Is(err, domain.ErrUploadNotReceived):
+			ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		case errors.Is(err, domain.ErrUploadExpired):
+			ctx.JSON(http.StatusGone, gin.H{"error": err.Error()})
+		case errors.Is(err, domain.ErrUploadMismatch):
+			ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		case errors.Is(err, domain.ErrImageTooLarge):
+			ctx.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": err.Error()})
+		case errors.Is(err, domain.ErrInvalidFormat),
+			errors.Is(err, domain.ErrInvalidVisibility),
+			errors.Is(err, domain.ErrImageTooManyPixels),
+			errors.Is(err, domain.ErrSuspiciousImage):
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, imageResponse(img))
+}
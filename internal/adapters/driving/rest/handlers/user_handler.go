@@ -1,10 +1,13 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driving/rest/openapi"
+	"github.com/llascola/web-backend/internal/app/domain"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
@@ -30,11 +33,22 @@ func (h *Handler) GetProfile(ctx *gin.Context) {
 		return
 	}
 
+	quota, err := h.imageService.Quota(ctx, userID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
 	// Don't return password hash
 	ctx.JSON(http.StatusOK, gin.H{
-		"id":    user.ID,
-		"email": user.Email,
-		"role":  user.Role,
+		"id":     user.ID,
+		"email":  user.Email,
+		"role":   user.Role,
+		"limits": limitsResponse(quota.Limits),
+		"usage": gin.H{
+			"bytes": quota.Usage.Bytes,
+			"files": quota.Usage.Files,
+		},
 	})
 }
 
@@ -46,3 +60,54 @@ func (h *Handler) DeleteUser(ctx *gin.Context, id openapi_types.UUID) {
 
 	ctx.JSON(http.StatusOK, gin.H{"message": "User deleted"})
 }
+
+func (h *Handler) SetUserLimits(ctx *gin.Context, id openapi_types.UUID) {
+	var req openapi.SetUserLimitsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var limits domain.UploadLimits
+	if req.MaxFileSize != nil {
+		limits.MaxFileSize = *req.MaxFileSize
+	}
+	if req.MaxTotalBytes != nil {
+		limits.MaxTotalBytes = *req.MaxTotalBytes
+	}
+	if req.MaxFiles != nil {
+		limits.MaxFiles = *req.MaxFiles
+	}
+	if req.AllowedTypes != nil {
+		limits.AllowedTypes = *req.AllowedTypes
+	}
+
+	user, err := h.userService.SetUploadLimits(ctx, id, limits)
+	if errors.Is(err, domain.ErrInvalidLimits) || errors.Is(err, domain.ErrInvalidFormat) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if errors.Is(err, domain.ErrUserNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, limitsResponse(user.Limits))
+}
+
+func limitsResponse(limits domain.UploadLimits) gin.H {
+	allowedTypes := limits.AllowedTypes
+	if allowedTypes == nil {
+		allowedTypes = []string{}
+	}
+	return gin.H{
+		"max_file_size":   limits.MaxFileSize,
+		"max_total_bytes": limits.MaxTotalBytes,
+		"max_files":       limits.MaxFiles,
+		"allowed_types":   allowedTypes,
+	}
+}
@@ -0,0 +1,537 @@
+package rest_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/llascola/web-backend/internal/adapters/driven/captcha"
+	"github.com/llascola/web-backend/internal/adapters/driving/rest"
+	"github.com/llascola/web-backend/internal/app"
+	"github.com/llascola/web-backend/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryRouter is the router of an application in memory mode, with an admin@example.com admin
+type memoryRouter struct {
+	t      *testing.T
+	app    *app.Application
+	router *gin.Engine
+}
+
+func newMemoryRouter(t *testing.T) *memoryRouter {
+	cfg := &config.Config{
+		Mode:        config.ModeMemory,
+		SiteURL:     "https://example.com",
+		Storage:     config.StorageConfig{BaseURL: "http://localhost/storage", SigningKey: []byte("storage-secret")},
+		Posts:       config.PostConfig{PreviewKey: []byte("preview-secret"), PreviewTTL: time.Hour},
+		Comments:    config.CommentConfig{RateLimit: 3, RateWindow: time.Hour},
+		Contact:     config.ContactConfig{RateLimit: 3, RateWindow: time.Hour},
+		Captcha:     config.CaptchaConfig{Provider: "fake"},
+		Redirects:   config.RedirectConfig{CacheTTL: time.Hour},
+		JWTKeys:     map[string]config.JWTKey{"test": {Secret: []byte("jwt-secret"), Algorithm: "HS256"}},
+		ActiveKeyID: "test",
+	}
+	application := app.NewApplication(cfg)
+	require.NoError(t, application.Service.AuthService.RegisterAdmin(t.Context(), "admin@example.com", "password123"))
+	return &memoryRouter{t: t, app: application, router: rest.NewRouter(application, cfg)}
+}
+
+// do sends a JSON request, with the token as bearer when not empty
+func (m *memoryRouter) do(method, target string, body []byte, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	m.router.ServeHTTP(w, req)
+	return w
+}
+
+func (m *memoryRouter) decode(w *httptest.ResponseRecorder) map[string]any {
+	var body map[string]any
+	require.NoError(m.t, json.Unmarshal(w.Body.Bytes(), &body), w.Body.String())
+	return body
+}
+
+// TestMemoryModeUpload runs the direct upload flow end to end without Postgres or MinIO
+func TestMemoryModeUpload(t *testing.T) {
+	s := newMemoryRouter(t)
+	requestURI := func(raw string) string {
+		u, err := url.Parse(raw)
+		require.NoError(t, err)
+		return u.RequestURI()
+	}
+
+	credentials := []byte(`{"email":"member@example.com","password":"password123"}`)
+	require.Equal(t, http.StatusCreated, s.do(http.MethodPost, "/auth/register", credentials, "").Code)
+	w := s.do(http.MethodPost, "/auth/login", credentials, "")
+	require.Equal(t, http.StatusOK, w.Code)
+	memberToken := s.decode(w)["token"].(string)
+	w = s.do(http.MethodPost, "/auth/login", []byte(`{"email":"admin@example.com","password":"password123"}`), "")
+	require.Equal(t, http.StatusOK, w.Code)
+	token := s.decode(w)["token"].(string)
+
+	var file bytes.Buffer
+	img := image.NewRGBA(image.Rect(0, 0, 8, 8))
+	img.Set(1, 1, color.RGBA{R: 255, A: 255})
+	require.NoError(t, png.Encode(&file, img))
+	sum := sha256.Sum256(file.Bytes())
+
+	createBody, _ := json.Marshal(map[string]any{
+		"filename":     "dot.png",
+		"content_type": "image/png",
+		"size":         file.Len(),
+		"checksum":     hex.EncodeToString(sum[:]),
+	})
+	w = s.do(http.MethodPost, "/api/admin/images/uploads", createBody, memberToken)
+	require.Equal(t, http.StatusForbidden, w.Code, "presigned uploads are for admins")
+	w = s.do(http.MethodPost, "/api/admin/images/uploads", createBody, token)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	upload := s.decode(w)
+
+	w = s.do(http.MethodPut, requestURI(upload["upload_url"].(string)), file.Bytes(), "")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	w = s.do(http.MethodPost, "/api/admin/images/uploads/"+upload["id"].(string)+"/complete", nil, token)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	stored := s.decode(w)
+	assert.Equal(t, float64(8), stored["width"])
+
+	w = s.do(http.MethodGet, requestURI(stored["url"].(string)), nil, "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "image/png", w.Header().Get("Content-Type"))
+
+	imagePath := "/images/" + stored["id"].(string)
+	w = s.do(http.MethodPatch, "/api"+imagePath, []byte(`{"alt":{"en":"A red dot"},"license":"CC0-1.0"}`), token)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	w = s.do(http.MethodGet, imagePath, nil, "")
+	require.Equal(t, http.StatusOK, w.Code)
+	described := s.decode(w)
+	assert.Equal(t, map[string]any{"en": "A red dot"}, described["alt"])
+	assert.Equal(t, "CC0-1.0", described["license"])
+
+	w = s.do(http.MethodGet, "/search?q=red+dot", nil, "")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	results := s.decode(w)
+	require.Equal(t, float64(1), results["total"])
+	hit := results["results"].([]any)[0].(map[string]any)
+	assert.Equal(t, "image", hit["type"])
+	assert.Equal(t, "A <mark>red</mark> <mark>dot</mark>", hit["snippet"])
+	assert.Equal(t, stored["id"], hit["image"].(map[string]any)["id"])
+
+	w = s.do(http.MethodGet, "/api/profile", nil, token)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, float64(1), s.decode(w)["usage"].(map[string]any)["files"])
+}
+
+// TestMemoryModeUploadKeepMetadata checks that admins keeping the metadata still do not store data appended
+// to the image, and that the preview describes the image upright
+func TestMemoryModeUploadKeepMetadata(t *testing.T) {
+	s := newMemoryRouter(t)
+
+	w := s.do(http.MethodPost, "/auth/login", []byte(`{"email":"admin@example.com","password":"password123"}`), "")
+	require.Equal(t, http.StatusOK, w.Code)
+	token := s.decode(w)["token"].(string)
+
+	var encoded bytes.Buffer
+	require.NoError(t, jpeg.Encode(&encoded, image.NewRGBA(image.Rect(0, 0, 16, 8)), nil))
+	photo := withOrientation(encoded.Bytes(), 6)
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	require.NoError(t, form.WriteField("keep_metadata", "true"))
+	part, err := form.CreateFormFile("file", "motion.jpg")
+	require.NoError(t, err)
+	part.Write(photo)
+	part.Write([]byte("\x00\x00\x00\x18ftypmp42 video data"))
+	require.NoError(t, form.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/api/admin/upload-image", &body)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	req.Header.Set("Authorization", "Bearer "+token)
+	w = httptest.NewRecorder()
+	s.router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	stored := s.decode(w)
+	assert.Equal(t, float64(len(photo)), stored["size"])
+	// Rotated a quarter turn by its EXIF orientation
+	assert.Equal(t, float64(8), stored["width"])
+	assert.Equal(t, float64(16), stored["height"])
+
+	u, err := url.Parse(stored["url"].(string))
+	require.NoError(t, err)
+	w = s.do(http.MethodGet, u.RequestURI(), nil, "")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, photo, w.Body.Bytes())
+}
+
+// withOrientation inserts an EXIF segment holding only the orientation after the SOI marker
+func withOrientation(data []byte, orientation byte) []byte {
+	tiff := []byte{'I', 'I', 0x2A, 0, 8, 0, 0, 0, 1, 0, 0x12, 0x01, 3, 0, 1, 0, 0, 0, orientation, 0, 0, 0, 0, 0, 0, 0}
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	segment := append([]byte{0xFF, 0xE1, 0, byte(len(payload) + 2)}, payload...)
+	return append(append(append([]byte{}, data[:2]...), segment...), data[2:]...)
+}
+
+// TestMemoryModePosts checks that unpublished posts only reach visitors through preview links
+func TestMemoryModePosts(t *testing.T) {
+	s := newMemoryRouter(t)
+
+	w := s.do(http.MethodPost, "/auth/login", []byte(`{"email":"admin@example.com","password":"password123"}`), "")
+	require.Equal(t, http.StatusOK, w.Code)
+	token := s.decode(w)["token"].(string)
+
+	w = s.do(http.MethodPost, "/api/admin/posts", []byte(`{"title":"Hello","body":"Hi <script>x()</script>*there*"}`), token)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	draft := s.decode(w)
+	assert.Equal(t, "draft", draft["status"])
+
+	assert.Equal(t, http.StatusNotFound, s.do(http.MethodGet, "/posts/hello", nil, "").Code)
+	assert.Equal(t, http.StatusNotFound, s.do(http.MethodGet, "/posts/hello?preview=forged", nil, "").Code)
+	w = s.do(http.MethodGet, "/posts", nil, "")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, float64(0), s.decode(w)["total"])
+
+	w = s.do(http.MethodPost, "/api/admin/posts/"+draft["id"].(string)+"/preview", nil, token)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	previewURL, err := url.Parse(s.decode(w)["url"].(string))
+	require.NoError(t, err)
+	assert.Equal(t, "example.com", previewURL.Host)
+	w = s.do(http.MethodGet, previewURL.RequestURI(), nil, "")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, "private, no-store", w.Header().Get("Cache-Control"))
+	assert.Equal(t, "<p>Hi <em>there</em></p>\n", s.decode(w)["html"])
+
+	// Scheduled in the past, so due at once
+	publishAt := time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+	w = s.do(http.MethodPost, "/api/admin/posts", []byte(`{"title":"Soon","status":"scheduled","publish_at":"`+publishAt+`"}`), token)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	assert.Equal(t, "published", s.decode(w)["status"])
+
+	w = s.do(http.MethodPatch, "/api/admin/posts/"+draft["id"].(string), []byte(`{"status":"scheduled","publish_at":"2999-01-01T00:00:00Z"}`), token)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, "scheduled", s.decode(w)["status"])
+	published, err := s.app.Service.PostService.PublishDue(t.Context())
+	require.NoError(t, err)
+	assert.Zero(t, published)
+
+	w = s.do(http.MethodGet, "/posts", nil, "")
+	require.Equal(t, http.StatusOK, w.Code)
+	list := s.decode(w)
+	assert.Equal(t, float64(1), list["total"])
+	assert.Equal(t, "soon", list["posts"].([]any)[0].(map[string]any)["slug"])
+	assert.Equal(t, http.StatusOK, s.do(http.MethodGet, "/posts/soon", nil, "").Code)
+
+	// Tags of the draft stay out of the tag cloud
+	w = s.do(http.MethodPatch, "/api/admin/posts/"+draft["id"].(string), []byte(`{"tags":["Go","Draft notes"]}`), token)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	w = s.do(http.MethodPost, "/api/admin/posts", []byte(`{"title":"Tagged","status":"published","tags":["golang","Photos"]}`), token)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	w = s.do(http.MethodGet, "/posts?tag=golang", nil, "")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, float64(1), s.decode(w)["total"])
+
+	w = s.do(http.MethodPost, "/api/admin/tags/merge", []byte(`{"sources":["golang","golang"],"target":"go"}`), token)
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+	w = s.do(http.MethodPatch, "/api/admin/tags/photos", []byte(`{"name":"Go"}`), token)
+	assert.Equal(t, http.StatusConflict, w.Code, w.Body.String())
+	w = s.do(http.MethodPatch, "/api/admin/tags/photos", []byte(`{"name":"Photography"}`), token)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	w = s.do(http.MethodGet, "/tags", nil, "")
+	require.Equal(t, http.StatusOK, w.Code)
+	var cloud []map[string]any
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &cloud))
+	assert.Equal(t, []map[string]any{
+		{"slug": "go", "name": "Go", "posts": float64(1), "images": float64(0)},
+		{"slug": "photography", "name": "Photography", "posts": float64(1), "images": float64(0)},
+	}, cloud)
+
+	w = s.do(http.MethodGet, "/feed.xml?tag=go", nil, "")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, "application/rss+xml; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Body.String(), "<link>https://example.com/posts/tagged</link>")
+	assert.NotContains(t, w.Body.String(), "hello")
+	req := httptest.NewRequest(http.MethodGet, "/feed.xml?tag=go", nil)
+	req.Header.Set("If-None-Match", w.Header().Get("ETag"))
+	notModified := httptest.NewRecorder()
+	s.router.ServeHTTP(notModified, req)
+	assert.Equal(t, http.StatusNotModified, notModified.Code)
+	tracked := s.do(http.MethodGet, "/feed.xml?utm_source=mail&tag=go", nil, "")
+	assert.Equal(t, w.Header().Get("ETag"), tracked.Header().Get("ETag"))
+	assert.Equal(t, http.StatusNotFound, s.do(http.MethodGet, "/atom.xml?tag=unknown", nil, "").Code)
+
+	w = s.do(http.MethodGet, "/sitemap.xml", nil, "")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Contains(t, w.Body.String(), "<loc>https://example.com/posts/tagged</loc>")
+	assert.NotContains(t, w.Body.String(), "hello")
+	assert.Equal(t, http.StatusNotFound, s.do(http.MethodGet, "/sitemap.xml?page=2", nil, "").Code)
+	w = s.do(http.MethodGet, "/search?q=TAG", nil, "")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	results := s.decode(w)
+	require.Equal(t, float64(1), results["total"])
+	assert.Equal(t, "<mark>Tagged</mark>", results["results"].([]any)[0].(map[string]any)["highlighted_title"])
+	assert.Equal(t, "post", results["results"].([]any)[0].(map[string]any)["type"])
+	w = s.do(http.MethodGet, "/search?q=there", nil, "")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, float64(0), s.decode(w)["total"], "drafts are not searchable")
+	assert.Equal(t, http.StatusBadRequest, s.do(http.MethodGet, "/search?q=%21%21", nil, "").Code)
+
+	w = s.do(http.MethodGet, "/robots.txt", nil, "")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "Sitemap: https://example.com/sitemap.xml\n")
+}
+
+// TestMemoryModeComments checks that comments only show once approved and that bots are held back
+func TestMemoryModeComments(t *testing.T) {
+	s := newMemoryRouter(t)
+	threads := func() []any {
+		w := s.do(http.MethodGet, "/posts/hello/comments", nil, "")
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var threads []any
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &threads))
+		return threads
+	}
+
+	w := s.do(http.MethodPost, "/auth/login", []byte(`{"email":"admin@example.com","password":"password123"}`), "")
+	require.Equal(t, http.StatusOK, w.Code)
+	token := s.decode(w)["token"].(string)
+	w = s.do(http.MethodPost, "/api/admin/posts", []byte(`{"title":"Hello","status":"published"}`), token)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	w = s.do(http.MethodPost, "/posts/hello/comments", []byte(`{"body":"Buy now","website":"https://spam.example"}`), "")
+	require.Equal(t, http.StatusAccepted, w.Code, w.Body.String())
+	assert.Equal(t, "pending", s.decode(w)["status"])
+	w = s.do(http.MethodPost, "/posts/hello/comments", []byte(`{"body":"Nice post","author_name":"Ana","author_email":"ana@example.com"}`), "")
+	require.Equal(t, http.StatusAccepted, w.Code, w.Body.String())
+	comment := s.decode(w)
+	assert.Empty(t, threads())
+
+	w = s.do(http.MethodGet, "/api/admin/comments?status=pending", nil, token)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	queue := s.decode(w)
+	require.Equal(t, float64(1), queue["total"], "the honeypot files bots as spam")
+	assert.Equal(t, "ana@example.com", queue["comments"].([]any)[0].(map[string]any)["author_email"])
+
+	w = s.do(http.MethodPatch, "/api/admin/comments/"+comment["id"].(string), []byte(`{"status":"approved"}`), token)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	w = s.do(http.MethodPost, "/posts/hello/comments", []byte(`{"body":"Thanks!","parent_id":"`+comment["id"].(string)+`"}`), token)
+	require.Equal(t, http.StatusAccepted, w.Code, w.Body.String())
+	assert.Equal(t, "approved", s.decode(w)["status"])
+
+	list := threads()
+	require.Len(t, list, 1)
+	thread := list[0].(map[string]any)
+	assert.Equal(t, "Nice post", thread["body"])
+	assert.Equal(t, "Thanks!", thread["replies"].([]any)[0].(map[string]any)["body"])
+	assert.NotContains(t, thread, "author_email")
+
+	w = s.do(http.MethodPatch, "/api/admin/comments/"+comment["id"].(string), []byte(`{"status":"deleted"}`), token)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	w = s.do(http.MethodPatch, "/api/admin/comments/"+comment["id"].(string), []byte(`{"status":"approved"}`), token)
+	assert.Equal(t, http.StatusConflict, w.Code)
+	thread = threads()[0].(map[string]any)
+	assert.Equal(t, true, thread["hidden"])
+	assert.Nil(t, thread["body"])
+
+	w = s.do(http.MethodPost, "/posts/hello/comments", []byte(`{"body":"One too many"}`), "")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.NotEmpty(t, w.Header().Get("Retry-After"))
+}
+
+// TestMemoryModeCommentFromDeletedAccount checks that a token outliving its account is unauthorized
+func TestMemoryModeCommentFromDeletedAccount(t *testing.T) {
+	s := newMemoryRouter(t)
+
+	credentials := []byte(`{"email":"member@example.com","password":"password123"}`)
+	require.Equal(t, http.StatusCreated, s.do(http.MethodPost, "/auth/register", credentials, "").Code)
+	w := s.do(http.MethodPost, "/auth/login", credentials, "")
+	require.Equal(t, http.StatusOK, w.Code)
+	memberToken := s.decode(w)["token"].(string)
+	w = s.do(http.MethodGet, "/api/profile", nil, memberToken)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	memberID := s.decode(w)["id"].(string)
+
+	w = s.do(http.MethodPost, "/auth/login", []byte(`{"email":"admin@example.com","password":"password123"}`), "")
+	require.Equal(t, http.StatusOK, w.Code)
+	token := s.decode(w)["token"].(string)
+	w = s.do(http.MethodPost, "/api/admin/posts", []byte(`{"title":"Hello","status":"published"}`), token)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	w = s.do(http.MethodDelete, "/api/admin/users/"+memberID, nil, token)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	w = s.do(http.MethodPost, "/posts/hello/comments", []byte(`{"body":"Still here"}`), memberToken)
+	assert.Equal(t, http.StatusUnauthorized, w.Code, w.Body.String())
+}
+
+func TestMemoryModeContact(t *testing.T) {
+	s := newMemoryRouter(t)
+
+	w := s.do(http.MethodPost, "/contact", []byte(`{"name":"Ana","email":"ana@example.com","message":"Hi","captcha_token":"forged"}`), "")
+	require.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+	w = s.do(http.MethodPost, "/contact", []byte(`{"name":"Bot","email":"bot@example.com","message":"Buy now","website":"https://spam.example"}`), "")
+	require.Equal(t, http.StatusAccepted, w.Code, w.Body.String())
+	w = s.do(http.MethodPost, "/contact", []byte(`{"name":"Ana","email":"ana@example.com","subject":"Hello","message":"Hi","captcha_token":"`+captcha.FakeToken+`"}`), "")
+	require.Equal(t, http.StatusAccepted, w.Code, w.Body.String())
+	w = s.do(http.MethodPost, "/contact", []byte(`{"name":"Ana","email":"ana@example.com","message":"Again","captcha_token":"`+captcha.FakeToken+`"}`), "")
+	require.Equal(t, http.StatusTooManyRequests, w.Code, w.Body.String())
+	// Without TRUSTED_PROXIES a forged X-Forwarded-For does not make a new client
+	req := httptest.NewRequest(http.MethodPost, "/contact", bytes.NewReader([]byte(`{"name":"Ana","email":"ana@example.com","message":"Again","captcha_token":"`+captcha.FakeToken+`"}`)))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Forwarded-For", "203.0.113.7")
+	w = httptest.NewRecorder()
+	s.router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusTooManyRequests, w.Code, w.Body.String())
+
+	w = s.do(http.MethodGet, "/api/admin/contact", nil, "")
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+	w = s.do(http.MethodPost, "/auth/login", []byte(`{"email":"admin@example.com","password":"password123"}`), "")
+	require.Equal(t, http.StatusOK, w.Code)
+	token := s.decode(w)["token"].(string)
+
+	w = s.do(http.MethodGet, "/api/admin/contact", nil, token)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	inbox := s.decode(w)
+	require.Equal(t, float64(1), inbox["total"], "the honeypot drops bots")
+	message := inbox["messages"].([]any)[0].(map[string]any)
+	assert.Equal(t, "Hello", message["subject"])
+
+	w = s.do(http.MethodDelete, "/api/admin/contact/"+message["id"].(string), nil, token)
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+	w = s.do(http.MethodDelete, "/api/admin/contact/"+message["id"].(string), nil, token)
+	require.Equal(t, http.StatusNotFound, w.Code, w.Body.String())
+}
+
+func TestMemoryModePostRevisions(t *testing.T) {
+	s := newMemoryRouter(t)
+
+	w := s.do(http.MethodPost, "/auth/login", []byte(`{"email":"admin@example.com","password":"password123"}`), "")
+	require.Equal(t, http.StatusOK, w.Code)
+	token := s.decode(w)["token"].(string)
+	w = s.do(http.MethodPost, "/api/admin/posts", []byte(`{"title":"Hello","body":"one\ntwo\nthree","tags":["go"],"status":"published"}`), token)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	base := "/api/admin/posts/" + s.decode(w)["id"].(string)
+
+	w = s.do(http.MethodPatch, base, []byte(`{"title":"Hello again","body":"one\n2\nthree","tags":[],"slug":"hello-again"}`), token)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	w = s.do(http.MethodGet, base+"/revisions", nil, token)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	list := s.decode(w)
+	require.Equal(t, float64(2), list["total"])
+	latest := list["revisions"].([]any)[0].(map[string]any)
+	assert.Equal(t, float64(2), latest["number"])
+	assert.Equal(t, "Hello again", latest["title"])
+	assert.NotContains(t, latest, "body")
+	assert.NotNil(t, latest["editor_id"])
+
+	w = s.do(http.MethodGet, base+"/revisions/diff?from=1&to=2", nil, token)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var ops []string
+	for _, line := range s.decode(w)["lines"].([]any) {
+		l := line.(map[string]any)
+		ops = append(ops, l["op"].(string)+" "+l["text"].(string))
+	}
+	assert.Equal(t, []string{"equal one", "delete two", "insert 2", "equal three"}, ops)
+
+	w = s.do(http.MethodPost, base+"/revisions/1/restore", nil, token)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	post := s.decode(w)
+	assert.Equal(t, "Hello", post["title"])
+	assert.Equal(t, "one\ntwo\nthree", post["body"])
+	assert.Equal(t, "hello-again", post["slug"], "restoring keeps the slug")
+	assert.Len(t, post["tags"], 1)
+
+	w = s.do(http.MethodGet, base+"/revisions/3", nil, token)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	restored := s.decode(w)
+	assert.Equal(t, float64(1), restored["restored_from"])
+	assert.Equal(t, "one\ntwo\nthree", restored["body"])
+
+	w = s.do(http.MethodGet, base+"/revisions/9", nil, token)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	w = s.do(http.MethodGet, base+"/revisions/diff?from=1", nil, token)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestMemoryModeRedirects(t *testing.T) {
+	s := newMemoryRouter(t)
+
+	w := s.do(http.MethodPost, "/auth/login", []byte(`{"email":"admin@example.com","password":"password123"}`), "")
+	require.Equal(t, http.StatusOK, w.Code)
+	token := s.decode(w)["token"].(string)
+
+	// Renamed posts stay reachable at their old slugs
+	w = s.do(http.MethodPost, "/api/admin/posts", []byte(`{"title":"Hello","status":"published"}`), token)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	id := s.decode(w)["id"].(string)
+	w = s.do(http.MethodPatch, "/api/admin/posts/"+id, []byte(`{"slug":"hello-world"}`), token)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	w = s.do(http.MethodPatch, "/api/admin/posts/"+id, []byte(`{"slug":"hello-again"}`), token)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	for _, slug := range []string{"hello", "hello-world"} {
+		w = s.do(http.MethodGet, "/posts/"+slug+"?utm=x", nil, "")
+		require.Equal(t, http.StatusMovedPermanently, w.Code, w.Body.String())
+		assert.Equal(t, "/posts/hello-again?utm=x", w.Header().Get("Location"))
+	}
+	w = s.do(http.MethodGet, "/posts/hello-again", nil, "")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	// A new post taking an old slug wins over the redirect
+	w = s.do(http.MethodPost, "/api/admin/posts", []byte(`{"title":"Hello","status":"published"}`), token)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	w = s.do(http.MethodGet, "/posts/hello", nil, "")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	// Unpublished posts do not give their new slug away
+	w = s.do(http.MethodPatch, "/api/admin/posts/"+id, []byte(`{"status":"draft"}`), token)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	w = s.do(http.MethodGet, "/posts/hello-world", nil, "")
+	require.Equal(t, http.StatusNotFound, w.Code, w.Body.String())
+
+	w = s.do(http.MethodPost, "/api/admin/redirects", []byte(`{"from":"/blog/2019/hello.html","to":"/posts/hello"}`), token)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	redirect := s.decode(w)
+	w = s.do(http.MethodPost, "/api/admin/redirects", []byte(`{"from":"/blog/2019/hello.html/","to":"/"}`), token)
+	require.Equal(t, http.StatusConflict, w.Code, w.Body.String())
+	w = s.do(http.MethodPost, "/api/admin/redirects", []byte(`{"from":"/posts/hello","to":"/blog/2019/hello.html"}`), token)
+	require.Equal(t, http.StatusConflict, w.Code, "the target redirects back")
+	w = s.do(http.MethodPost, "/api/admin/redirects", []byte(`{"from":"/api/admin/posts","to":"/"}`), token)
+	require.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+	w = s.do(http.MethodPost, "/api/admin/redirects", []byte(`{"from":"/feed","to":"/feed.xml","code":308}`), token)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	w = s.do(http.MethodGet, "/blog/2019/hello.html?ref=old", nil, "")
+	require.Equal(t, http.StatusMovedPermanently, w.Code, w.Body.String())
+	assert.Equal(t, "/posts/hello?ref=old", w.Header().Get("Location"))
+	w = s.do(http.MethodHead, "/feed", nil, "")
+	require.Equal(t, http.StatusPermanentRedirect, w.Code)
+	assert.Equal(t, "/feed.xml", w.Header().Get("Location"))
+
+	w = s.do(http.MethodGet, "/api/admin/redirects", nil, token)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	list := s.decode(w)
+	require.Equal(t, float64(2), list["total"])
+	assert.Equal(t, "/blog/2019/hello.html", list["redirects"].([]any)[0].(map[string]any)["from"])
+
+	// Deleting applies right away on this instance, whatever the cache TTL
+	w = s.do(http.MethodDelete, "/api/admin/redirects/"+redirect["id"].(string), nil, token)
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+	w = s.do(http.MethodGet, "/blog/2019/hello.html", nil, "")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -13,45 +13,58 @@ import (
 
 func AuthMiddleware(keys map[string]config.JWTKey) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		authHeader := c.GetHeader("Authorization")
-		if authHeader == "" {
+		if c.GetHeader("Authorization") == "" {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Authorization header required"})
 			return
 		}
+		authenticate(c, keys)
+	}
+}
 
-		tokenString := strings.TrimPrefix(authHeader, "Bearer ")
-
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (any, error) {
-			kid, ok := token.Header["kid"].(string)
-			if !ok {
-				return nil, fmt.Errorf("missing key id (kid) in token header")
-			}
-
-			keyConfig, exists := keys[kid]
-			if !exists {
-				return nil, fmt.Errorf("unknown key id: %v", kid)
-			}
+// OptionalAuthMiddleware lets anonymous requests through, a token that is sent must be valid
+func OptionalAuthMiddleware(keys map[string]config.JWTKey) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetHeader("Authorization") == "" {
+			c.Next()
+			return
+		}
+		authenticate(c, keys)
+	}
+}
 
-			if token.Method.Alg() != keyConfig.Algorithm {
-				return nil, fmt.Errorf("unexpected signing method: %v, expected: %v", token.Method.Alg(), keyConfig.Algorithm)
-			}
+func authenticate(c *gin.Context, keys map[string]config.JWTKey) {
+	tokenString := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
 
-			return keyConfig.Secret, nil
-		})
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (any, error) {
+		kid, ok := token.Header["kid"].(string)
+		if !ok {
+			return nil, fmt.Errorf("missing key id (kid) in token header")
+		}
 
-		if err != nil || !token.Valid {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
-			return
+		keyConfig, exists := keys[kid]
+		if !exists {
+			return nil, fmt.Errorf("unknown key id: %v", kid)
 		}
 
-		// Set claims to context so controllers can use it
-		if claims, ok := token.Claims.(jwt.MapClaims); ok {
-			c.Set("userID", claims["sub"])
-			c.Set("role", claims["role"])
+		if token.Method.Alg() != keyConfig.Algorithm {
+			return nil, fmt.Errorf("unexpected signing method: %v, expected: %v", token.Method.Alg(), keyConfig.Algorithm)
 		}
 
-		c.Next()
+		return keyConfig.Secret, nil
+	})
+
+	if err != nil || !token.Valid {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+		return
+	}
+
+	// Set claims to context so controllers can use it
+	if claims, ok := token.Claims.(jwt.MapClaims); ok {
+		c.Set("userID", claims["sub"])
+		c.Set("role", claims["role"])
 	}
+
+	c.Next()
 }
 
 func RequireRole(requiredRole domain.UserRole) gin.HandlerFunc {
@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// rateWindow counts the requests of a client since start
+type rateWindow struct {
+	start time.Time
+	count int
+}
+
+// RateLimit lets each client IP make limit requests per window, further requests get
+// 429 Too Many Requests with a Retry-After. Counters live in memory, so every instance
+// limits on its own. A limit of 0 or less disables it.
+func RateLimit(limit int, window time.Duration) gin.HandlerFunc {
+	if limit <= 0 || window <= 0 {
+		return func(c *gin.Context) { c.Next() }
+	}
+
+	var (
+		mu        sync.Mutex
+		clients   = make(map[string]*rateWindow)
+		lastSweep time.Time
+	)
+	return func(c *gin.Context) {
+		now := time.Now()
+		mu.Lock()
+		// Forget clients whose window is over, at most once per window
+		if now.Sub(lastSweep) > window {
+			for ip, w := range clients {
+				if now.Sub(w.start) >= window {
+					delete(clients, ip)
+				}
+			}
+			lastSweep = now
+		}
+		w, ok := clients[c.ClientIP()]
+		if !ok || now.Sub(w.start) >= window {
+			w = &rateWindow{start: now}
+			clients[c.ClientIP()] = w
+		}
+		w.count++
+		allowed, retryAfter := w.count <= limit, w.start.Add(window).Sub(now)
+		mu.Unlock()
+
+		if !allowed {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"error": "too many requests, try again later"})
+			return
+		}
+		c.Next()
+	}
+}
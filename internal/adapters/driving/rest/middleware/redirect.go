@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/llascola/web-backend/internal/app/inports"
+)
+
+// Redirects answers GET and HEAD requests for the paths of the redirect table, before any
+// route sees them. A failed lookup is logged and the request goes on, a broken table must
+// not take the site down.
+func Redirects(redirects inports.RedirectService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead {
+			c.Next()
+			return
+		}
+		redirect, err := redirects.Resolve(c, c.Request.URL.Path)
+		if err != nil {
+			log.Printf("Resolving redirect of %s: %v", c.Request.URL.Path, err)
+		}
+		if redirect == nil {
+			c.Next()
+			return
+		}
+		c.Redirect(redirect.Code, redirect.Location(c.Request.URL.RawQuery))
+		c.Abort()
+	}
+}
@@ -4,6 +4,8 @@
 package openapi
 
 import (
+	"time"
+
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
@@ -11,11 +13,696 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for CommentStatus.
+const (
+	Approved CommentStatus = "approved"
+	Deleted  CommentStatus = "deleted"
+	Pending  CommentStatus = "pending"
+	Spam     CommentStatus = "spam"
+)
+
+// Defines values for DiffLineOp.
+const (
+	Delete DiffLineOp = "delete"
+	Equal  DiffLineOp = "equal"
+	Insert DiffLineOp = "insert"
+)
+
+// Defines values for LimitErrorLimit.
+const (
+	ContentType LimitErrorLimit = "content_type"
+	FileCount   LimitErrorLimit = "file_count"
+	FileSize    LimitErrorLimit = "file_size"
+	TotalBytes  LimitErrorLimit = "total_bytes"
+)
+
+// Defines values for PostStatus.
+const (
+	Archived  PostStatus = "archived"
+	Draft     PostStatus = "draft"
+	Published PostStatus = "published"
+	Scheduled PostStatus = "scheduled"
+)
+
+// Defines values for SearchHitType.
+const (
+	SearchHitTypeImage SearchHitType = "image"
+	SearchHitTypePost  SearchHitType = "post"
+)
+
+// Defines values for Visibility.
+const (
+	Private Visibility = "private"
+	Public  Visibility = "public"
+)
+
+// Defines values for CreateUploadJSONBodyContentType.
+const (
+	Imagejpeg CreateUploadJSONBodyContentType = "image/jpeg"
+	Imagepng  CreateUploadJSONBodyContentType = "image/png"
+	Imagewebp CreateUploadJSONBodyContentType = "image/webp"
+)
+
+// Defines values for CreateRedirectJSONBodyCode.
+const (
+	N301 CreateRedirectJSONBodyCode = 301
+	N302 CreateRedirectJSONBodyCode = 302
+	N307 CreateRedirectJSONBodyCode = 307
+	N308 CreateRedirectJSONBodyCode = 308
+)
+
+// Defines values for GetTransformedImageParamsFit.
+const (
+	Contain GetTransformedImageParamsFit = "contain"
+	Cover   GetTransformedImageParamsFit = "cover"
+	Fill    GetTransformedImageParamsFit = "fill"
+)
+
+// Defines values for GetTransformedImageParamsFmt.
+const (
+	Jpeg GetTransformedImageParamsFmt = "jpeg"
+	Png  GetTransformedImageParamsFmt = "png"
+)
+
+// Album defines model for Album.
+type Album struct {
+	// CoverImageId Effective cover, the first image unless one was picked
+	CoverImageId *openapi_types.UUID   `json:"cover_image_id"`
+	CreatedAt    *time.Time            `json:"created_at,omitempty"`
+	Description  *string               `json:"description,omitempty"`
+	Id           *openapi_types.UUID   `json:"id,omitempty"`
+	ImageIds     *[]openapi_types.UUID `json:"image_ids,omitempty"`
+	Slug         *string               `json:"slug,omitempty"`
+	Title        *string               `json:"title,omitempty"`
+	UpdatedAt    *time.Time            `json:"updated_at,omitempty"`
+
+	// Visibility Private images are only served through signed URLs or the authenticated proxy
+	Visibility *Visibility `json:"visibility,omitempty"`
+}
+
+// AlbumImageIDs defines model for AlbumImageIDs.
+type AlbumImageIDs struct {
+	ImageIds []openapi_types.UUID `json:"image_ids"`
+}
+
+// Comment defines model for Comment.
+type Comment struct {
+	AuthorEmail *string             `json:"author_email,omitempty"`
+	AuthorId    *openapi_types.UUID `json:"author_id"`
+	AuthorName  *string             `json:"author_name,omitempty"`
+	Body        *string             `json:"body,omitempty"`
+	CreatedAt   *time.Time          `json:"created_at,omitempty"`
+	Id          *openapi_types.UUID `json:"id,omitempty"`
+	ParentId    *openapi_types.UUID `json:"parent_id"`
+	PostId      *openapi_types.UUID `json:"post_id,omitempty"`
+	Status      *CommentStatus      `json:"status,omitempty"`
+	UpdatedAt   *time.Time          `json:"updated_at,omitempty"`
+}
+
+// CommentList defines model for CommentList.
+type CommentList struct {
+	Comments *[]Comment `json:"comments,omitempty"`
+
+	// Total Number of comments in all pages
+	Total *int `json:"total,omitempty"`
+}
+
+// CommentStatus defines model for CommentStatus.
+type CommentStatus string
+
+// CommentThread defines model for CommentThread.
+type CommentThread struct {
+	AuthorName *string    `json:"author_name"`
+	Body       *string    `json:"body"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+
+	// Hidden The comment is no longer shown, it only holds the replies
+	Hidden  *bool               `json:"hidden,omitempty"`
+	Id      *openapi_types.UUID `json:"id,omitempty"`
+	Replies *[]CommentThread    `json:"replies,omitempty"`
+}
+
+// ContactMessage defines model for ContactMessage.
+type ContactMessage struct {
+	CreatedAt *time.Time          `json:"created_at,omitempty"`
+	Email     *string             `json:"email,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+	Message   *string             `json:"message,omitempty"`
+	Name      *string             `json:"name,omitempty"`
+	Subject   *string             `json:"subject,omitempty"`
+}
+
+// ContactMessageList defines model for ContactMessageList.
+type ContactMessageList struct {
+	Messages *[]ContactMessage `json:"messages,omitempty"`
+
+	// Total Number of messages in all pages
+	Total *int `json:"total,omitempty"`
+}
+
+// DiffLine defines model for DiffLine.
+type DiffLine struct {
+	// NewLine Line number in the new body, null for deletions
+	NewLine *int `json:"new_line"`
+
+	// OldLine Line number in the old body, null for insertions
+	OldLine *int        `json:"old_line"`
+	Op      *DiffLineOp `json:"op,omitempty"`
+	Text    *string     `json:"text,omitempty"`
+}
+
+// DiffLineOp defines model for DiffLine.Op.
+type DiffLineOp string
+
 // Error defines model for Error.
 type Error struct {
 	Error *string `json:"error,omitempty"`
 }
 
+// GalleryImage defines model for GalleryImage.
+type GalleryImage struct {
+	// Alt Alternative text by BCP 47 locale
+	Alt *map[string]string `json:"alt,omitempty"`
+
+	// BlurHash BlurHash placeholder, see https://blurha.sh
+	BlurHash    *string    `json:"blur_hash,omitempty"`
+	Caption     *string    `json:"caption,omitempty"`
+	ContentType *string    `json:"content_type,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	Credit      *string    `json:"credit,omitempty"`
+
+	// DominantColor CSS hex color to paint before the image loads
+	DominantColor *string             `json:"dominant_color,omitempty"`
+	Height        *int                `json:"height,omitempty"`
+	Id            *openapi_types.UUID `json:"id,omitempty"`
+	License       *string             `json:"license,omitempty"`
+	Size          *int64              `json:"size,omitempty"`
+	Tags          *[]Tag              `json:"tags,omitempty"`
+
+	// Url Permanent URL, empty for private images
+	Url      *string         `json:"url,omitempty"`
+	Variants *[]ImageVariant `json:"variants,omitempty"`
+
+	// Visibility Private images are only served through signed URLs or the authenticated proxy
+	Visibility *Visibility `json:"visibility,omitempty"`
+	Width      *int        `json:"width,omitempty"`
+}
+
+// Image defines model for Image.
+type Image struct {
+	// Alt Alternative text by BCP 47 locale
+	Alt *map[string]string `json:"alt,omitempty"`
+
+	// BlurHash BlurHash placeholder, see https://blurha.sh
+	BlurHash    *string    `json:"blur_hash,omitempty"`
+	Caption     *string    `json:"caption,omitempty"`
+	ContentType *string    `json:"content_type,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	Credit      *string    `json:"credit,omitempty"`
+
+	// DominantColor CSS hex color to paint before the image loads
+	DominantColor *string             `json:"dominant_color,omitempty"`
+	Height        *int                `json:"height,omitempty"`
+	Id            *openapi_types.UUID `json:"id,omitempty"`
+	License       *string             `json:"license,omitempty"`
+	Size          *int64              `json:"size,omitempty"`
+	Tags          *[]Tag              `json:"tags,omitempty"`
+
+	// Url Permanent URL, empty for private images
+	Url *string `json:"url,omitempty"`
+
+	// Visibility Private images are only served through signed URLs or the authenticated proxy
+	Visibility *Visibility `json:"visibility,omitempty"`
+	Width      *int        `json:"width,omitempty"`
+}
+
+// ImageVariant defines model for ImageVariant.
+type ImageVariant struct {
+	Height *int    `json:"height,omitempty"`
+	Url    *string `json:"url,omitempty"`
+	Width  *int    `json:"width,omitempty"`
+}
+
+// LimitError defines model for LimitError.
+type LimitError struct {
+	Error *string          `json:"error,omitempty"`
+	Limit *LimitErrorLimit `json:"limit,omitempty"`
+	Max   *int64           `json:"max,omitempty"`
+	Value *int64           `json:"value,omitempty"`
+}
+
+// LimitErrorLimit defines model for LimitError.Limit.
+type LimitErrorLimit string
+
+// Post defines model for Post.
+type Post struct {
+	// AuthorId Null once the author was deleted
+	AuthorId *openapi_types.UUID `json:"author_id"`
+
+	// Body Markdown source
+	Body      *string    `json:"body,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	Excerpt   *string    `json:"excerpt,omitempty"`
+
+	// Html Sanitized rendering of the body
+	Html *string             `json:"html,omitempty"`
+	Id   *openapi_types.UUID `json:"id,omitempty"`
+
+	// PublishAt When a scheduled post will be published
+	PublishAt   *time.Time `json:"publish_at"`
+	PublishedAt *time.Time `json:"published_at"`
+	Slug        *string    `json:"slug,omitempty"`
+
+	// Status Drafts are only visible to admins and preview links, scheduled posts are published
+	// at publish_at, archived posts are unlisted but still readable at their URL
+	Status *PostStatus `json:"status,omitempty"`
+
+	// Summary The excerpt, or the start of the body when there is none
+	Summary   *string    `json:"summary,omitempty"`
+	Tags      *[]Tag     `json:"tags,omitempty"`
+	Title     *string    `json:"title,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// PostList defines model for PostList.
+type PostList struct {
+	Posts *[]Post `json:"posts,omitempty"`
+
+	// Total Number of posts in all pages
+	Total *int `json:"total,omitempty"`
+}
+
+// PostPreview defines model for PostPreview.
+type PostPreview struct {
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	Token     *string    `json:"token,omitempty"`
+
+	// Url Page of the post on the site, with the token as preview parameter
+	Url *string `json:"url,omitempty"`
+}
+
+// PostRevision defines model for PostRevision.
+type PostRevision struct {
+	// Body Markdown, left out of listings
+	Body      *string    `json:"body,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// EditorId Who saved the revision, null when unknown
+	EditorId *openapi_types.UUID `json:"editor_id"`
+	Excerpt  *string             `json:"excerpt,omitempty"`
+	Number   *int                `json:"number,omitempty"`
+
+	// RestoredFrom Number of the revision this one restored
+	RestoredFrom *int    `json:"restored_from"`
+	Slug         *string `json:"slug,omitempty"`
+
+	// Status Drafts are only visible to admins and preview links, scheduled posts are published
+	// at publish_at, archived posts are unlisted but still readable at their URL
+	Status *PostStatus `json:"status,omitempty"`
+	Tags   *[]string   `json:"tags,omitempty"`
+	Title  *string     `json:"title,omitempty"`
+}
+
+// PostRevisionList defines model for PostRevisionList.
+type PostRevisionList struct {
+	Revisions *[]PostRevision `json:"revisions,omitempty"`
+
+	// Total Number of revisions in all pages
+	Total *int `json:"total,omitempty"`
+}
+
+// PostStatus Drafts are only visible to admins and preview links, scheduled posts are published
+// at publish_at, archived posts are unlisted but still readable at their URL
+type PostStatus string
+
+// PublicAlbum defines model for PublicAlbum.
+type PublicAlbum struct {
+	Cover       *GalleryImage   `json:"cover"`
+	Description *string         `json:"description,omitempty"`
+	Images      *[]GalleryImage `json:"images,omitempty"`
+	Slug        *string         `json:"slug,omitempty"`
+	Title       *string         `json:"title,omitempty"`
+}
+
+// PublicComment defines model for PublicComment.
+type PublicComment struct {
+	AuthorName *string             `json:"author_name,omitempty"`
+	Body       *string             `json:"body,omitempty"`
+	CreatedAt  *time.Time          `json:"created_at,omitempty"`
+	Id         *openapi_types.UUID `json:"id,omitempty"`
+	ParentId   *openapi_types.UUID `json:"parent_id"`
+	Status     *CommentStatus      `json:"status,omitempty"`
+}
+
+// PublicPost defines model for PublicPost.
+type PublicPost struct {
+	// Body Markdown source
+	Body *string `json:"body,omitempty"`
+
+	// Html Sanitized rendering of the body
+	Html        *string    `json:"html,omitempty"`
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+	Slug        *string    `json:"slug,omitempty"`
+	Summary     *string    `json:"summary,omitempty"`
+	Tags        *[]Tag     `json:"tags,omitempty"`
+	Title       *string    `json:"title,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+// PublicPostList defines model for PublicPostList.
+type PublicPostList struct {
+	Posts *[]PublicPost `json:"posts,omitempty"`
+
+	// Total Number of posts in all pages
+	Total *int `json:"total,omitempty"`
+}
+
+// Redirect defines model for Redirect.
+type Redirect struct {
+	Code      *int                `json:"code,omitempty"`
+	CreatedAt *time.Time          `json:"created_at,omitempty"`
+	From      *string             `json:"from,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+	To        *string             `json:"to,omitempty"`
+}
+
+// RedirectList defines model for RedirectList.
+type RedirectList struct {
+	Redirects *[]Redirect `json:"redirects,omitempty"`
+
+	// Total Number of redirects in all pages
+	Total *int `json:"total,omitempty"`
+}
+
+// RevisionDiff defines model for RevisionDiff.
+type RevisionDiff struct {
+	From  *PostRevision `json:"from,omitempty"`
+	Lines *[]DiffLine   `json:"lines,omitempty"`
+	To    *PostRevision `json:"to,omitempty"`
+}
+
+// SearchHit A post or an image, slug, title, summary, tags and published_at are only set for posts
+type SearchHit struct {
+	// HighlightedTitle Title of the post or caption of the image as HTML with the matches in <mark>
+	HighlightedTitle *string    `json:"highlighted_title,omitempty"`
+	Image            *Image     `json:"image,omitempty"`
+	PublishedAt      *time.Time `json:"published_at,omitempty"`
+	Slug             *string    `json:"slug,omitempty"`
+
+	// Snippet Passages of the body or the alternative texts as HTML with the matches in <mark>
+	Snippet *string        `json:"snippet,omitempty"`
+	Summary *string        `json:"summary,omitempty"`
+	Tags    *[]Tag         `json:"tags,omitempty"`
+	Title   *string        `json:"title,omitempty"`
+	Type    *SearchHitType `json:"type,omitempty"`
+}
+
+// SearchHitType defines model for SearchHit.Type.
+type SearchHitType string
+
+// SearchResults defines model for SearchResults.
+type SearchResults struct {
+	Results *[]SearchHit `json:"results,omitempty"`
+
+	// Total Number of matching posts and images in all pages
+	Total *int `json:"total,omitempty"`
+}
+
+// StorageUsage defines model for StorageUsage.
+type StorageUsage struct {
+	Bytes *int64 `json:"bytes,omitempty"`
+	Files *int   `json:"files,omitempty"`
+}
+
+// Tag defines model for Tag.
+type Tag struct {
+	Name *string `json:"name,omitempty"`
+	Slug *string `json:"slug,omitempty"`
+}
+
+// TagCount defines model for TagCount.
+type TagCount struct {
+	// Images Public images
+	Images *int    `json:"images,omitempty"`
+	Name   *string `json:"name,omitempty"`
+
+	// Posts Published posts
+	Posts *int    `json:"posts,omitempty"`
+	Slug  *string `json:"slug,omitempty"`
+}
+
+// UploadLimits Zero values mean unlimited, an empty allowed_types allows every supported format
+type UploadLimits struct {
+	AllowedTypes  *[]string `json:"allowed_types,omitempty"`
+	MaxFileSize   *int64    `json:"max_file_size,omitempty"`
+	MaxFiles      *int      `json:"max_files,omitempty"`
+	MaxTotalBytes *int64    `json:"max_total_bytes,omitempty"`
+}
+
+// Visibility Private images are only served through signed URLs or the authenticated proxy
+type Visibility string
+
+// Limit defines model for Limit.
+type Limit = int
+
+// Offset defines model for Offset.
+type Offset = int
+
+// TagFilter defines model for TagFilter.
+type TagFilter = string
+
+// TusResumable defines model for TusResumable.
+type TusResumable = string
+
+// TusUploadID defines model for TusUploadID.
+type TusUploadID = openapi_types.UUID
+
+// ListCommentsParams defines parameters for ListComments.
+type ListCommentsParams struct {
+	// Status Only list comments in this status, e.g. pending for the moderation queue
+	Status *CommentStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Page size
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of items to skip
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ModerateCommentJSONBody defines parameters for ModerateComment.
+type ModerateCommentJSONBody struct {
+	Status CommentStatus `json:"status"`
+}
+
+// ListContactMessagesParams defines parameters for ListContactMessages.
+type ListContactMessagesParams struct {
+	// Limit Page size
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of items to skip
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// CreateUploadJSONBody defines parameters for CreateUpload.
+type CreateUploadJSONBody struct {
+	// Checksum Hex encoded SHA-256 of the file
+	Checksum    string                          `json:"checksum"`
+	ContentType CreateUploadJSONBodyContentType `json:"content_type"`
+	Filename    string                          `json:"filename"`
+	Size        int64                           `json:"size"`
+}
+
+// CreateUploadJSONBodyContentType defines parameters for CreateUpload.
+type CreateUploadJSONBodyContentType string
+
+// CompleteUploadJSONBody defines parameters for CompleteUpload.
+type CompleteUploadJSONBody struct {
+	// KeepMetadata Keep EXIF/XMP/ICC metadata, only honored for admins
+	KeepMetadata *bool `json:"keep_metadata,omitempty"`
+
+	// Visibility Private images are only served through signed URLs or the authenticated proxy
+	Visibility *Visibility `json:"visibility,omitempty"`
+}
+
+// ListPostsParams defines parameters for ListPosts.
+type ListPostsParams struct {
+	// Status Only list posts in this status
+	Status *PostStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Tag Only list posts with the tag of this slug
+	Tag *TagFilter `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// Limit Page size
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of items to skip
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// CreatePostJSONBody defines parameters for CreatePost.
+type CreatePostJSONBody struct {
+	// Body Markdown source
+	Body    *string `json:"body,omitempty"`
+	Excerpt *string `json:"excerpt,omitempty"`
+
+	// PublishAt Required when scheduled, a time already past publishes the post right away
+	PublishAt *time.Time `json:"publish_at,omitempty"`
+	Slug      *string    `json:"slug,omitempty"`
+
+	// Status Drafts are only visible to admins and preview links, scheduled posts are published
+	// at publish_at, archived posts are unlisted but still readable at their URL
+	Status *PostStatus `json:"status,omitempty"`
+
+	// Tags Tag names, missing tags are created
+	Tags  *[]string `json:"tags,omitempty"`
+	Title string    `json:"title"`
+}
+
+// UpdatePostJSONBody defines parameters for UpdatePost.
+type UpdatePostJSONBody struct {
+	Body    *string `json:"body,omitempty"`
+	Excerpt *string `json:"excerpt,omitempty"`
+
+	// PublishAt Only kept while the post is scheduled
+	PublishAt *time.Time `json:"publish_at,omitempty"`
+	Slug      *string    `json:"slug,omitempty"`
+
+	// Status Drafts are only visible to admins and preview links, scheduled posts are published
+	// at publish_at, archived posts are unlisted but still readable at their URL
+	Status *PostStatus `json:"status,omitempty"`
+
+	// Tags Tag names replacing the current tags, missing tags are created
+	Tags  *[]string `json:"tags,omitempty"`
+	Title *string   `json:"title,omitempty"`
+}
+
+// ListPostRevisionsParams defines parameters for ListPostRevisions.
+type ListPostRevisionsParams struct {
+	// Limit Page size
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of items to skip
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// DiffPostRevisionsParams defines parameters for DiffPostRevisions.
+type DiffPostRevisionsParams struct {
+	// From Number of the old revision
+	From int `form:"from" json:"from"`
+
+	// To Number of the new revision
+	To int `form:"to" json:"to"`
+}
+
+// ListRedirectsParams defines parameters for ListRedirects.
+type ListRedirectsParams struct {
+	// Limit Page size
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of items to skip
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// CreateRedirectJSONBody defines parameters for CreateRedirect.
+type CreateRedirectJSONBody struct {
+	Code *CreateRedirectJSONBodyCode `json:"code,omitempty"`
+
+	// From Path of the site, e.g. /blog/2019/hello.html
+	From string `json:"from"`
+
+	// To Path of the site or http(s) URL
+	To string `json:"to"`
+}
+
+// CreateRedirectJSONBodyCode defines parameters for CreateRedirect.
+type CreateRedirectJSONBodyCode int
+
+// MergeTagsJSONBody defines parameters for MergeTags.
+type MergeTagsJSONBody struct {
+	// Sources Slugs of the tags to merge
+	Sources []string `json:"sources"`
+
+	// Target Slug of the tag to keep
+	Target string `json:"target"`
+}
+
+// RenameTagJSONBody defines parameters for RenameTag.
+type RenameTagJSONBody struct {
+	Name string `json:"name"`
+}
+
+// CreateAlbumJSONBody defines parameters for CreateAlbum.
+type CreateAlbumJSONBody struct {
+	Description *string `json:"description,omitempty"`
+	Slug        *string `json:"slug,omitempty"`
+	Title       string  `json:"title"`
+
+	// Visibility Private images are only served through signed URLs or the authenticated proxy
+	Visibility *Visibility `json:"visibility,omitempty"`
+}
+
+// UpdateAlbumJSONBody defines parameters for UpdateAlbum.
+type UpdateAlbumJSONBody struct {
+	CoverImageId *openapi_types.UUID `json:"cover_image_id"`
+	Description  *string             `json:"description,omitempty"`
+	Slug         *string             `json:"slug,omitempty"`
+	Title        *string             `json:"title,omitempty"`
+
+	// Visibility Private images are only served through signed URLs or the authenticated proxy
+	Visibility *Visibility `json:"visibility,omitempty"`
+}
+
+// TusCreateParams defines parameters for TusCreate.
+type TusCreateParams struct {
+	// TusResumable Protocol version, must be 1.0.0
+	TusResumable *TusResumable `json:"Tus-Resumable,omitempty"`
+
+	// UploadLength Total size of the upload in bytes
+	UploadLength *int64 `json:"Upload-Length,omitempty"`
+
+	// UploadMetadata Comma separated key and base64 value pairs
+	UploadMetadata *string `json:"Upload-Metadata,omitempty"`
+}
+
+// TusDeleteParams defines parameters for TusDelete.
+type TusDeleteParams struct {
+	// TusResumable Protocol version, must be 1.0.0
+	TusResumable *TusResumable `json:"Tus-Resumable,omitempty"`
+}
+
+// TusHeadParams defines parameters for TusHead.
+type TusHeadParams struct {
+	// TusResumable Protocol version, must be 1.0.0
+	TusResumable *TusResumable `json:"Tus-Resumable,omitempty"`
+}
+
+// TusPatchParams defines parameters for TusPatch.
+type TusPatchParams struct {
+	// TusResumable Protocol version, must be 1.0.0
+	TusResumable *TusResumable `json:"Tus-Resumable,omitempty"`
+
+	// UploadOffset Offset the chunk starts at
+	UploadOffset *int64 `json:"Upload-Offset,omitempty"`
+}
+
+// UpdateImageJSONBody defines parameters for UpdateImage.
+type UpdateImageJSONBody struct {
+	// Alt Alternative text by BCP 47 locale
+	Alt     *map[string]*string `json:"alt,omitempty"`
+	Caption *string             `json:"caption,omitempty"`
+	Credit  *string             `json:"credit,omitempty"`
+	License *string             `json:"license,omitempty"`
+
+	// Tags Tag names, missing tags are created
+	Tags *[]string `json:"tags,omitempty"`
+}
+
+// GetAtomFeedParams defines parameters for GetAtomFeed.
+type GetAtomFeedParams struct {
+	// Tag Only posts with the tag of this slug
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+}
+
 // LoginJSONBody defines parameters for Login.
 type LoginJSONBody struct {
 	Email    openapi_types.Email `json:"email"`
@@ -28,16 +715,180 @@ type RegisterJSONBody struct {
 	Password string              `json:"password"`
 }
 
+// SendContactMessageJSONBody defines parameters for SendContactMessage.
+type SendContactMessageJSONBody struct {
+	// CaptchaToken Token of the captcha widget, not needed when the captcha is disabled
+	CaptchaToken *string `json:"captcha_token,omitempty"`
+
+	// Email Where the reply goes
+	Email string `json:"email"`
+
+	// Message Plain text
+	Message string  `json:"message"`
+	Name    string  `json:"name"`
+	Subject *string `json:"subject,omitempty"`
+
+	// Website Honeypot, a form hides it from people and it must stay empty
+	Website *string `json:"website,omitempty"`
+}
+
+// GetJSONFeedParams defines parameters for GetJSONFeed.
+type GetJSONFeedParams struct {
+	// Tag Only posts with the tag of this slug
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+}
+
+// GetRSSFeedParams defines parameters for GetRSSFeed.
+type GetRSSFeedParams struct {
+	// Tag Only posts with the tag of this slug
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+}
+
 // UploadImageMultipartBody defines parameters for UploadImage.
 type UploadImageMultipartBody struct {
 	File *openapi_types.File `json:"file,omitempty"`
+
+	// KeepMetadata Keep EXIF/XMP/ICC metadata, only honored for admins
+	KeepMetadata *bool `json:"keep_metadata,omitempty"`
+
+	// Visibility Private images are only served through signed URLs or the authenticated proxy
+	Visibility *Visibility `json:"visibility,omitempty"`
+}
+
+// GetTransformedImageParams defines parameters for GetTransformedImage.
+type GetTransformedImageParams struct {
+	// W Target width, 0 keeps the aspect ratio
+	W *int `form:"w,omitempty" json:"w,omitempty"`
+
+	// H Target height, 0 keeps the aspect ratio
+	H *int `form:"h,omitempty" json:"h,omitempty"`
+
+	// Fit How the image is fitted into the box, defaults to contain
+	Fit *GetTransformedImageParamsFit `form:"fit,omitempty" json:"fit,omitempty"`
+
+	// Fmt Output format, defaults to the original format
+	Fmt *GetTransformedImageParamsFmt `form:"fmt,omitempty" json:"fmt,omitempty"`
+
+	// Q JPEG quality
+	Q *int `form:"q,omitempty" json:"q,omitempty"`
+
+	// S HMAC signature of the transformation
+	S *string `form:"s,omitempty" json:"s,omitempty"`
+}
+
+// GetTransformedImageParamsFit defines parameters for GetTransformedImage.
+type GetTransformedImageParamsFit string
+
+// GetTransformedImageParamsFmt defines parameters for GetTransformedImage.
+type GetTransformedImageParamsFmt string
+
+// ListPublishedPostsParams defines parameters for ListPublishedPosts.
+type ListPublishedPostsParams struct {
+	// Tag Only list posts with the tag of this slug
+	Tag *TagFilter `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// Limit Page size
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of items to skip
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetPublishedPostParams defines parameters for GetPublishedPost.
+type GetPublishedPostParams struct {
+	// Preview Token of a preview link
+	Preview *string `form:"preview,omitempty" json:"preview,omitempty"`
+}
+
+// CreateCommentJSONBody defines parameters for CreateComment.
+type CreateCommentJSONBody struct {
+	// AuthorEmail Only seen by the site owners, ignored for signed in readers
+	AuthorEmail *string `json:"author_email,omitempty"`
+
+	// AuthorName Shown with the comment, Anonymous when empty
+	AuthorName *string `json:"author_name,omitempty"`
+
+	// Body Plain text
+	Body string `json:"body"`
+
+	// ParentId The approved comment replied to
+	ParentId *openapi_types.UUID `json:"parent_id,omitempty"`
+
+	// Website Honeypot, a form hides it from people and it must stay empty
+	Website *string `json:"website,omitempty"`
+}
+
+// SearchParams defines parameters for Search.
+type SearchParams struct {
+	// Q Words to search for
+	Q string `form:"q" json:"q"`
+
+	// Limit Page size
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of items to skip
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetSitemapParams defines parameters for GetSitemap.
+type GetSitemapParams struct {
+	// Page A sitemap listed in the sitemap index
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
 }
 
+// ModerateCommentJSONRequestBody defines body for ModerateComment for application/json ContentType.
+type ModerateCommentJSONRequestBody ModerateCommentJSONBody
+
+// CreateUploadJSONRequestBody defines body for CreateUpload for application/json ContentType.
+type CreateUploadJSONRequestBody CreateUploadJSONBody
+
+// CompleteUploadJSONRequestBody defines body for CompleteUpload for application/json ContentType.
+type CompleteUploadJSONRequestBody CompleteUploadJSONBody
+
+// CreatePostJSONRequestBody defines body for CreatePost for application/json ContentType.
+type CreatePostJSONRequestBody CreatePostJSONBody
+
+// UpdatePostJSONRequestBody defines body for UpdatePost for application/json ContentType.
+type UpdatePostJSONRequestBody UpdatePostJSONBody
+
+// CreateRedirectJSONRequestBody defines body for CreateRedirect for application/json ContentType.
+type CreateRedirectJSONRequestBody CreateRedirectJSONBody
+
+// MergeTagsJSONRequestBody defines body for MergeTags for application/json ContentType.
+type MergeTagsJSONRequestBody MergeTagsJSONBody
+
+// RenameTagJSONRequestBody defines body for RenameTag for application/json ContentType.
+type RenameTagJSONRequestBody RenameTagJSONBody
+
+// SetUserLimitsJSONRequestBody defines body for SetUserLimits for application/json ContentType.
+type SetUserLimitsJSONRequestBody = UploadLimits
+
+// CreateAlbumJSONRequestBody defines body for CreateAlbum for application/json ContentType.
+type CreateAlbumJSONRequestBody CreateAlbumJSONBody
+
+// UpdateAlbumJSONRequestBody defines body for UpdateAlbum for application/json ContentType.
+type UpdateAlbumJSONRequestBody UpdateAlbumJSONBody
+
+// AddAlbumImagesJSONRequestBody defines body for AddAlbumImages for application/json ContentType.
+type AddAlbumImagesJSONRequestBody = AlbumImageIDs
+
+// ReorderAlbumImagesJSONRequestBody defines body for ReorderAlbumImages for application/json ContentType.
+type ReorderAlbumImagesJSONRequestBody = AlbumImageIDs
+
+// UpdateImageJSONRequestBody defines body for UpdateImage for application/json ContentType.
+type UpdateImageJSONRequestBody UpdateImageJSONBody
+
 // LoginJSONRequestBody defines body for Login for application/json ContentType.
 type LoginJSONRequestBody LoginJSONBody
 
 // RegisterJSONRequestBody defines body for Register for application/json ContentType.
 type RegisterJSONRequestBody RegisterJSONBody
 
+// SendContactMessageJSONRequestBody defines body for SendContactMessage for application/json ContentType.
+type SendContactMessageJSONRequestBody SendContactMessageJSONBody
+
 // UploadImageMultipartRequestBody defines body for UploadImage for multipart/form-data ContentType.
 type UploadImageMultipartRequestBody UploadImageMultipartBody
+
+// CreateCommentJSONRequestBody defines body for CreateComment for application/json ContentType.
+type CreateCommentJSONRequestBody CreateCommentJSONBody
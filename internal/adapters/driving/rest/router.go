@@ -22,23 +22,35 @@ func NewRouter(app *app.Application, cfg *config.Config) *gin.Engine {
 	defer logger.Sync()
 
 	r := gin.New()
+	// ClientIP only reads X-Forwarded-For from these, otherwise anyone could dodge the rate limits with it
+	if err := r.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		logger.Fatal("Invalid TRUSTED_PROXIES", zap.Error(err))
+	}
 	r.Use(gin.Recovery())
 	r.Use(middleware.ZapLogger(logger))
 
 	// Configure CORS
 	r.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"https://lucianoscola.com", "https://www.lucianoscola.com", "http://localhost:5173"},
-		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization"},
-		ExposeHeaders:    []string{"Content-Length"},
+		AllowMethods:     []string{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization", "Tus-Resumable", "Upload-Length", "Upload-Metadata", "Upload-Offset"},
+		ExposeHeaders:    []string{"Content-Length", "Location", "Tus-Resumable", "Tus-Version", "Tus-Extension", "Tus-Max-Size", "Upload-Offset", "Upload-Length", "Upload-Expires", "Upload-Metadata", "Image-Id"},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}))
 
+	// Legacy paths of the redirect table, for every route and for paths without one
+	if app.Service.RedirectService != nil {
+		r.Use(middleware.Redirects(app.Service.RedirectService))
+	}
+
 	// Handler
 	handler := handlers.NewHandler(app)
 	wrapper := openapi.ServerInterfaceWrapper{
 		Handler: handler,
+		ErrorHandler: func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"error": err.Error()})
+		},
 	}
 
 	r.GET("/", func(c *gin.Context) {
@@ -59,7 +71,38 @@ func NewRouter(app *app.Application, cfg *config.Config) *gin.Engine {
 	r.StaticFile("/openapi.yml", "./openapi/openapi.yml")
 	r.Static("/docs", "./docs")
 
+	// Files stored by this process (fs driver or memory mode), MinIO serves them itself otherwise
+	if local := app.LocalStorage; local != nil {
+		public := gin.WrapH(http.StripPrefix("/storage/public", local.PublicHandler()))
+		r.GET("/storage/public/*name", public)
+		r.HEAD("/storage/public/*name", public)
+		signed := gin.WrapH(http.StripPrefix("/storage/signed", local))
+		r.GET("/storage/signed/*name", signed)
+		r.HEAD("/storage/signed/*name", signed)
+		r.PUT("/storage/signed/*name", signed)
+	}
+
 	// Public Routes
+	r.GET("/img/:id", wrapper.GetTransformedImage)
+	r.GET("/albums/:slug", wrapper.GetPublicAlbum)
+	r.GET("/images/:id", wrapper.GetPublicImage)
+	r.GET("/posts", wrapper.ListPublishedPosts)
+	r.GET("/posts/:slug", wrapper.GetPublishedPost)
+	r.GET("/posts/:slug/comments", wrapper.ListPostComments)
+	r.POST("/posts/:slug/comments",
+		middleware.OptionalAuthMiddleware(cfg.JWTKeys),
+		middleware.RateLimit(cfg.Comments.RateLimit, cfg.Comments.RateWindow),
+		wrapper.CreateComment)
+	r.POST("/contact", middleware.RateLimit(cfg.Contact.RateLimit, cfg.Contact.RateWindow), wrapper.SendContactMessage)
+	r.GET("/tags", wrapper.ListPublicTags)
+	r.GET("/search", wrapper.Search)
+	r.GET("/feed.xml", wrapper.GetRSSFeed)
+	r.GET("/atom.xml", wrapper.GetAtomFeed)
+	r.GET("/feed.json", wrapper.GetJSONFeed)
+	r.GET("/sitemap.xml", wrapper.GetSitemap)
+	r.GET("/robots.txt", wrapper.GetRobots)
+	r.OPTIONS("/api/images/tus", wrapper.TusOptions) // tus discovery is unauthenticated
+
 	authGroup := r.Group("/auth")
 	{
 		authGroup.POST("/login", wrapper.Login)
@@ -72,17 +115,56 @@ func NewRouter(app *app.Application, cfg *config.Config) *gin.Engine {
 
 	// 1. Member Routes (Any logged in user)
 	api.GET("/profile", wrapper.GetProfile)
+	api.PATCH("/images/:id", wrapper.UpdateImage)
+	api.DELETE("/images/:id", wrapper.DeleteImage)
+	api.GET("/images/:id/url", wrapper.GetImageURL)
+	api.GET("/images/:id/file", wrapper.GetImageFile)
+	api.GET("/albums", wrapper.ListAlbums)
+	api.POST("/albums", wrapper.CreateAlbum)
+	api.GET("/albums/:id", wrapper.GetAlbum)
+	api.PATCH("/albums/:id", wrapper.UpdateAlbum)
+	api.DELETE("/albums/:id", wrapper.DeleteAlbum)
+	api.POST("/albums/:id/images", wrapper.AddAlbumImages)
+	api.PUT("/albums/:id/images", wrapper.ReorderAlbumImages)
+	api.DELETE("/albums/:id/images/:imageId", wrapper.RemoveAlbumImage)
+	api.POST("/images/tus", wrapper.TusCreate)
+	api.HEAD("/images/tus/:id", wrapper.TusHead)
+	api.PATCH("/images/tus/:id", wrapper.TusPatch)
+	api.DELETE("/images/tus/:id", wrapper.TusDelete)
 
 	// 2. Admin Routes (Only Admins)
 	admin := api.Group("/admin")
 	admin.Use(middleware.RequireRole(domain.RoleAdmin)) // <--- Blocks non-admins
 	{
 		admin.DELETE("/users/:id", wrapper.DeleteUser)
+		admin.PUT("/users/:id/limits", wrapper.SetUserLimits)
+		admin.GET("/posts", wrapper.ListPosts)
+		admin.POST("/posts", wrapper.CreatePost)
+		admin.GET("/posts/:id", wrapper.GetPost)
+		admin.PATCH("/posts/:id", wrapper.UpdatePost)
+		admin.DELETE("/posts/:id", wrapper.DeletePost)
+		admin.POST("/posts/:id/preview", wrapper.PreviewPost)
+		admin.GET("/posts/:id/revisions", wrapper.ListPostRevisions)
+		admin.GET("/posts/:id/revisions/diff", wrapper.DiffPostRevisions)
+		admin.GET("/posts/:id/revisions/:number", wrapper.GetPostRevision)
+		admin.POST("/posts/:id/revisions/:number/restore", wrapper.RestorePostRevision)
+		admin.GET("/tags", wrapper.ListTags)
+		admin.PATCH("/tags/:slug", wrapper.RenameTag)
+		admin.POST("/tags/merge", wrapper.MergeTags)
+		admin.GET("/comments", wrapper.ListComments)
+		admin.PATCH("/comments/:id", wrapper.ModerateComment)
+		admin.GET("/contact", wrapper.ListContactMessages)
+		admin.DELETE("/contact/:id", wrapper.DeleteContactMessage)
+		admin.GET("/redirects", wrapper.ListRedirects)
+		admin.POST("/redirects", wrapper.CreateRedirect)
+		admin.DELETE("/redirects/:id", wrapper.DeleteRedirect)
 		admin.GET("/hola-mundo", func(c *gin.Context) {
 			c.JSON(http.StatusOK, gin.H{"message": "Hola Mundo"})
 		})
 		// admin.POST("/upload-system-config", imageController.UploadConfig) // Removed
 		admin.POST("/upload-image", wrapper.UploadImage)
+		admin.POST("/images/uploads", wrapper.CreateUpload)
+		admin.POST("/images/uploads/:id/complete", wrapper.CompleteUpload)
 	}
 
 	return r
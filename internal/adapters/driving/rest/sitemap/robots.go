@@ -0,0 +1,24 @@
+package sitemap
+
+import (
+	"strings"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+// Robots encodes the rules for every crawler, see https://www.rfc-editor.org/rfc/rfc9309
+func Robots(r domain.Robots) []byte {
+	var b strings.Builder
+	b.WriteString("User-agent: *\n")
+	if len(r.Disallow) == 0 {
+		// An empty rule allows everything, a group needs at least one
+		b.WriteString("Disallow:\n")
+	}
+	for _, path := range r.Disallow {
+		b.WriteString("Disallow: " + path + "\n")
+	}
+	if r.Sitemap != "" {
+		b.WriteString("\nSitemap: " + r.Sitemap + "\n")
+	}
+	return []byte(b.String())
+}
@@ -0,0 +1,60 @@
+// Package sitemap encodes domain.Sitemap in the sitemap protocol and domain.Robots as robots.txt
+package sitemap
+
+import (
+	"encoding/xml"
+	"time"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+const (
+	XMLContentType    = "application/xml; charset=utf-8"
+	RobotsContentType = "text/plain; charset=utf-8"
+)
+
+// See https://www.sitemaps.org/protocol.html
+const sitemapNS = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+type urlset struct {
+	XMLName xml.Name `xml:"urlset"`
+	NS      string   `xml:"xmlns,attr"`
+	URLs    []entry  `xml:"url"`
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name `xml:"sitemapindex"`
+	NS       string   `xml:"xmlns,attr"`
+	Sitemaps []entry  `xml:"sitemap"`
+}
+
+type entry struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// XML encodes the sitemap as a urlset, or a sitemapindex when it is an index
+func XML(s *domain.Sitemap) ([]byte, error) {
+	var doc any
+	if s.IsIndex() {
+		doc = sitemapIndex{NS: sitemapNS, Sitemaps: entries(s.Index)}
+	} else {
+		doc = urlset{NS: sitemapNS, URLs: entries(s.URLs)}
+	}
+	body, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
+
+func entries(urls []domain.SitemapURL) []entry {
+	result := make([]entry, len(urls))
+	for i, u := range urls {
+		result[i] = entry{Loc: u.Loc}
+		if !u.LastMod.IsZero() {
+			result[i].LastMod = u.LastMod.UTC().Format(time.RFC3339)
+		}
+	}
+	return result
+}
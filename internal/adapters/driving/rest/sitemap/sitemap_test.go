@@ -0,0 +1,54 @@
+package sitemap_test
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/llascola/web-backend/internal/adapters/driving/rest/sitemap"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type entry struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod"`
+}
+
+func TestXML(t *testing.T) {
+	modified := time.Date(2024, 3, 1, 10, 0, 0, 0, time.FixedZone("ART", -3*3600))
+
+	body, err := sitemap.XML(&domain.Sitemap{URLs: []domain.SitemapURL{
+		{Loc: "https://example.com/"},
+		{Loc: "https://example.com/posts/fish?a=1&b=2", LastMod: modified},
+	}})
+	require.NoError(t, err)
+	var urlset struct {
+		XMLName xml.Name
+		URLs    []entry `xml:"url"`
+	}
+	require.NoError(t, xml.Unmarshal(body, &urlset), string(body))
+	assert.Equal(t, xml.Name{Space: "http://www.sitemaps.org/schemas/sitemap/0.9", Local: "urlset"}, urlset.XMLName)
+	assert.Equal(t, []entry{
+		{Loc: "https://example.com/"},
+		{Loc: "https://example.com/posts/fish?a=1&b=2", LastMod: "2024-03-01T13:00:00Z"},
+	}, urlset.URLs)
+	assert.NotContains(t, string(body), "<lastmod></lastmod>")
+
+	body, err = sitemap.XML(&domain.Sitemap{Index: []domain.SitemapURL{{Loc: "https://example.com/sitemap.xml?page=1", LastMod: modified}}})
+	require.NoError(t, err)
+	var index struct {
+		XMLName  xml.Name
+		Sitemaps []entry `xml:"sitemap"`
+	}
+	require.NoError(t, xml.Unmarshal(body, &index))
+	assert.Equal(t, "sitemapindex", index.XMLName.Local)
+	assert.Equal(t, []entry{{Loc: "https://example.com/sitemap.xml?page=1", LastMod: "2024-03-01T13:00:00Z"}}, index.Sitemaps)
+}
+
+func TestRobots(t *testing.T) {
+	assert.Equal(t, "User-agent: *\nDisallow: /api/\nDisallow: /auth/\n\nSitemap: https://example.com/sitemap.xml\n",
+		string(sitemap.Robots(domain.Robots{Disallow: []string{"/api/", "/auth/"}, Sitemap: "https://example.com/sitemap.xml"})))
+	assert.Equal(t, "User-agent: *\nDisallow:\n", string(sitemap.Robots(domain.Robots{})))
+}
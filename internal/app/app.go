@@ -2,37 +2,134 @@ package app
 
 import (
 	"context"
+	"crypto/rand"
 	"fmt"
 	"log"
+	"net/http"
+	"net/mail"
 
 	_ "github.com/lib/pq"
+	"github.com/llascola/web-backend/internal/adapters/driven/captcha"
+	"github.com/llascola/web-backend/internal/adapters/driven/imaging"
+	"github.com/llascola/web-backend/internal/adapters/driven/mailer"
+	"github.com/llascola/web-backend/internal/adapters/driven/markdown"
 	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/ent/migrate"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/memory"
 	"github.com/llascola/web-backend/internal/adapters/driven/repository/postgres"
 	"github.com/llascola/web-backend/internal/adapters/driven/storage"
 	"github.com/llascola/web-backend/internal/app/inports"
+	"github.com/llascola/web-backend/internal/app/outports"
 	"github.com/llascola/web-backend/internal/app/services"
 	"github.com/llascola/web-backend/internal/config"
 )
 
 type Service struct {
-	ImageService inports.ImageService
-	UserService  inports.UserService
-	AuthService  inports.AuthService
+	AlbumService           inports.AlbumService
+	CommentService         inports.CommentService
+	ContactService         inports.ContactService
+	FeedService            inports.FeedService
+	SearchService          inports.SearchService
+	ImageService           inports.ImageService
+	PostService            inports.PostService
+	RedirectService        inports.RedirectService
+	SitemapService         inports.SitemapService
+	TagService             inports.TagService
+	ResumableUploadService inports.ResumableUploadService
+	StorageService         inports.StorageService
+	UserService            inports.UserService
+	AuthService            inports.AuthService
 }
 
 type Application struct {
 	Service *Service
+	// LocalStorage is set when files are stored by this process (fs driver or memory mode), the router then serves them
+	LocalStorage LocalStorage
+}
+
+// LocalStorage is a storage adapter whose files are served over HTTP by the application itself
+type LocalStorage interface {
+	// ServeHTTP serves signed URLs, the request path is the object name
+	http.Handler
+	// PublicHandler serves the objects under public/ without a signature
+	PublicHandler() http.Handler
+}
+
+// storageAdapter is what the services need from a storage adapter
+type storageAdapter interface {
+	outports.ObjectStore
+	outports.MultipartStorage
+}
+
+type repositories struct {
+	users            outports.UserRepository
+	images           outports.ImageRepository
+	blobs            outports.BlobRepository
+	uploads          outports.UploadRepository
+	resumableUploads outports.ResumableUploadRepository
+	albums           outports.AlbumRepository
+	posts            outports.PostRepository
+	revisions        outports.PostRevisionRepository
+	tags             outports.TagRepository
+	search           outports.SearchRepository
+	comments         outports.CommentRepository
+	contact          outports.ContactRepository
+	redirects        outports.RedirectRepository
 }
 
 func NewApplication(cfg *config.Config) *Application {
-	fileStorage := storage.NewMinIOAdapter(
-		cfg.MinIO.Endpoint,
-		cfg.MinIO.RootUser,
-		cfg.MinIO.RootPass,
-		cfg.MinIO.Bucket,
-		cfg.MinIO.Policy,
+	var (
+		repos        repositories
+		fileStorage  storageAdapter
+		localStorage LocalStorage
 	)
+	if cfg.Mode == config.ModeMemory {
+		log.Println("Running in memory mode, nothing is persisted")
+		memStorage := storage.NewMemoryAdapter(cfg.Storage.BaseURL, storageSigningKey(cfg))
+		repos, fileStorage, localStorage = newMemoryRepositories(), memStorage, memStorage
+	} else {
+		repos = newPostgresRepositories(cfg)
+		fileStorage, localStorage = newObjectStore(cfg, cfg.Storage.Driver)
+	}
+
+	imageService := services.NewImageService(fileStorage, repos.images, repos.blobs, repos.uploads, repos.users, imaging.NewProcessor(), cfg.Images)
+	resumableUploadService := services.NewResumableUploadService(repos.resumableUploads, fileStorage, fileStorage, imageService)
+	storageService := services.NewStorageService(fileStorage, fileStorage, repos.images, repos.blobs, repos.uploads, repos.resumableUploads)
+	albumService := services.NewAlbumService(repos.albums, repos.images, cfg.Images)
+	postService := services.NewPostService(repos.posts, repos.revisions, markdown.NewRenderer(), postConfig(cfg), cfg.SiteURL)
+	tagService := services.NewTagService(repos.tags)
+	redirectService := services.NewRedirectService(repos.redirects, cfg.Redirects)
+	feedService := services.NewFeedService(repos.posts, repos.tags, cfg.Feeds, cfg.SiteURL)
+	searchService := services.NewSearchService(repos.search)
+	emails := newMailer(cfg)
+	commentService := services.NewCommentService(repos.comments, repos.posts, repos.users, emails, cfg.SiteURL)
+	contactService := services.NewContactService(repos.contact, newCaptchaVerifier(cfg), emails, cfg.Contact.To, cfg.SiteURL)
+	sitemapService := services.NewSitemapService(repos.posts, repos.albums, cfg.Sitemap, cfg.SiteURL)
+	userService := services.NewUserService(repos.users)
+	authService := services.NewAuthService(repos.users, cfg.JWTKeys, cfg.ActiveKeyID)
+
+	return &Application{
+		LocalStorage: localStorage,
+		Service: &Service{
+			AlbumService:           albumService,
+			CommentService:         commentService,
+			ContactService:         contactService,
+			FeedService:            feedService,
+			SearchService:          searchService,
+			ImageService:           imageService,
+			PostService:            postService,
+			RedirectService:        redirectService,
+			SitemapService:         sitemapService,
+			TagService:             tagService,
+			ResumableUploadService: resumableUploadService,
+			StorageService:         storageService,
+			UserService:            userService,
+			AuthService:            authService,
+		},
+	}
+}
 
+func newPostgresRepositories(cfg *config.Config) repositories {
 	connStr := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
 		cfg.Postgres.Host,
 		cfg.Postgres.Port,
@@ -46,22 +143,137 @@ func NewApplication(cfg *config.Config) *Application {
 	if err != nil {
 		log.Fatalf("failed opening connection to postgres: %v", err)
 	}
-	// Run the auto migration tool.
-	if err := client.Schema.Create(context.Background()); err != nil {
+	// Run the auto migration tool. Dropping indexes lets constraints be relaxed, e.g. stored_name
+	// stopped being unique when images started sharing files.
+	if err := client.Schema.Create(context.Background(), migrate.WithDropIndex(true)); err != nil {
 		log.Fatalf("failed creating schema resources: %v", err)
 	}
+	if err := postgres.MigrateSearch(context.Background(), client, cfg.Search.Language); err != nil {
+		log.Fatalf("failed creating search index: %v", err)
+	}
 
-	userRepo := postgres.NewUserRepository(client)
+	return repositories{
+		users:            postgres.NewUserRepository(client),
+		images:           postgres.NewImageRepository(client),
+		blobs:            postgres.NewBlobRepository(client),
+		uploads:          postgres.NewUploadRepository(client),
+		resumableUploads: postgres.NewResumableUploadRepository(client),
+		albums:           postgres.NewAlbumRepository(client),
+		posts:            postgres.NewPostRepository(client),
+		revisions:        postgres.NewPostRevisionRepository(client),
+		tags:             postgres.NewTagRepository(client),
+		search:           postgres.NewSearchRepository(client),
+		comments:         postgres.NewCommentRepository(client),
+		contact:          postgres.NewContactRepository(client),
+		redirects:        postgres.NewRedirectRepository(client),
+	}
+}
 
-	imageService := services.NewImageService(fileStorage)
-	userService := services.NewUserService(userRepo)
-	authService := services.NewAuthService(userRepo, cfg.JWTKeys, cfg.ActiveKeyID)
+func newMemoryRepositories() repositories {
+	images, posts := memory.NewImageRepository(), memory.NewPostRepository()
+	return repositories{
+		users:            memory.NewUserRepository(),
+		images:           images,
+		blobs:            memory.NewBlobRepository(),
+		uploads:          memory.NewUploadRepository(),
+		resumableUploads: memory.NewResumableUploadRepository(),
+		albums:           memory.NewAlbumRepository(),
+		posts:            posts,
+		revisions:        memory.NewPostRevisionRepository(posts),
+		tags:             memory.NewTagRepository(posts, images),
+		search:           memory.NewSearchRepository(posts, images),
+		comments:         memory.NewCommentRepository(),
+		contact:          memory.NewContactRepository(),
+		redirects:        memory.NewRedirectRepository(),
+	}
+}
 
-	return &Application{
-		Service: &Service{
-			ImageService: imageService,
-			UserService:  userService,
-			AuthService:  authService,
-		},
+// NewObjectStore builds the storage adapter of a driver, e.g. the destination of a migration
+func NewObjectStore(cfg *config.Config, driver string) outports.ObjectStore {
+	store, _ := newObjectStore(cfg, driver)
+	return store
+}
+
+// newObjectStore builds the adapter of a STORAGE_DRIVER value, the filesystem adapter is also returned as LocalStorage
+func newObjectStore(cfg *config.Config, driver string) (storageAdapter, LocalStorage) {
+	switch driver {
+	case "fs":
+		fsStorage, err := storage.NewFilesystemAdapter(cfg.Storage.Root, cfg.Storage.BaseURL, storageSigningKey(cfg))
+		if err != nil {
+			log.Fatalf("failed preparing storage directory: %v", err)
+		}
+		return fsStorage, fsStorage
+	case "minio", "":
+		return storage.NewMinIOAdapter(
+			cfg.MinIO.Endpoint,
+			cfg.MinIO.PublicEndpoint,
+			cfg.MinIO.RootUser,
+			cfg.MinIO.RootPass,
+			cfg.MinIO.Bucket,
+			cfg.MinIO.Policy,
+		), nil
+	default:
+		log.Fatalf("unknown storage driver %q, expected fs or minio", driver)
+		return nil, nil
+	}
+}
+
+// newMailer sends through SMTP_HOST, emails are only logged in memory mode or without a host
+func newMailer(cfg *config.Config) outports.Mailer {
+	if cfg.Mode == config.ModeMemory || cfg.Mail.Host == "" {
+		log.Println("Emails are written to the log, set SMTP_HOST to send them")
+		return mailer.NewLogMailer()
+	}
+	from, err := mail.ParseAddress(cfg.Mail.From)
+	if err != nil {
+		log.Fatalf("invalid MAIL_FROM %q: %v", cfg.Mail.From, err)
+	}
+	return mailer.NewSMTPMailer(cfg.Mail.Host, cfg.Mail.Port, cfg.Mail.Username, cfg.Mail.Password, *from)
+}
+
+// newCaptchaVerifier checks the contact form with CAPTCHA_PROVIDER, none disables the captcha
+func newCaptchaVerifier(cfg *config.Config) outports.CaptchaVerifier {
+	switch cfg.Captcha.Provider {
+	case "":
+		log.Println("CAPTCHA_PROVIDER not set, the contact form is only protected by its honeypot and rate limit")
+		return captcha.NewDisabled()
+	case "fake":
+		log.Printf("Contact form captcha is fake, it accepts the token %q", captcha.FakeToken)
+		return captcha.NewFake()
+	}
+	endpoint, ok := captcha.Providers[cfg.Captcha.Provider]
+	if !ok {
+		log.Fatalf("unknown captcha provider %q, expected turnstile, hcaptcha, recaptcha or fake", cfg.Captcha.Provider)
+	}
+	if cfg.Captcha.Secret == "" {
+		log.Fatalf("CAPTCHA_SECRET is required with CAPTCHA_PROVIDER=%s", cfg.Captcha.Provider)
+	}
+	return captcha.NewSiteVerifier(endpoint, cfg.Captcha.Secret)
+}
+
+// storageSigningKey returns the key for the signed URLs of local storage, random when none is configured
+func storageSigningKey(cfg *config.Config) []byte {
+	if len(cfg.Storage.SigningKey) > 0 {
+		return cfg.Storage.SigningKey
+	}
+	log.Println("STORAGE_SIGNING_KEY not set, signed storage URLs will not survive a restart")
+	return randomKey()
+}
+
+// postConfig fills in a random preview key when none is configured
+func postConfig(cfg *config.Config) config.PostConfig {
+	posts := cfg.Posts
+	if len(posts.PreviewKey) == 0 {
+		log.Println("POST_PREVIEW_KEY not set, post preview links will not survive a restart")
+		posts.PreviewKey = randomKey()
+	}
+	return posts
+}
+
+func randomKey() []byte {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		log.Fatalf("failed generating random key: %v", err)
 	}
+	return key
 }
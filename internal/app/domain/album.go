@@ -0,0 +1,198 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrAlbumNotFound     = errors.New("album not found")
+	ErrInvalidAlbumTitle = errors.New("album title is required")
+	ErrImageNotInAlbum   = errors.New("image is not part of the album")
+	ErrInvalidAlbumOrder = errors.New("order must list every image of the album exactly once")
+	ErrAlbumFull         = errors.New("album has reached the maximum number of images")
+)
+
+const MaxAlbumImages = 500
+
+// Album is an ordered group of images, shown publicly under its slug when public
+type Album struct {
+	ID          uuid.UUID
+	OwnerID     uuid.UUID
+	Slug        string
+	Title       string
+	Description string
+	Visibility  Visibility
+	// CoverImageID falls back to the first image when nil
+	CoverImageID uuid.UUID
+	// ImageIDs are in display order
+	ImageIDs  []uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// AlbumChanges are the fields to edit, nil fields are left unchanged
+type AlbumChanges struct {
+	Title        *string
+	Slug         *string
+	Description  *string
+	Visibility   *Visibility
+	CoverImageID *uuid.UUID
+}
+
+// AlbumPage is an album with its images resolved, as shown to visitors
+type AlbumPage struct {
+	Album  *Album
+	Cover  *GalleryImage
+	Images []GalleryImage
+}
+
+func NewAlbum(ownerID uuid.UUID, title, slug, description string, visibility Visibility) (*Album, error) {
+	album := &Album{
+		ID:        uuid.New(),
+		OwnerID:   ownerID,
+		ImageIDs:  []uuid.UUID{},
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	if slug == "" {
+		slug = Slugify(title)
+	}
+	if err := album.Edit(title, slug, description, visibility); err != nil {
+		return nil, err
+	}
+	return album, nil
+}
+
+// Edit replaces the descriptive fields, an empty visibility keeps the current one
+func (a *Album) Edit(title, slug, description string, visibility Visibility) error {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return ErrInvalidAlbumTitle
+	}
+	if err := ValidateSlug(slug); err != nil {
+		return err
+	}
+	if visibility == "" {
+		visibility = a.Visibility
+	}
+	if visibility == "" {
+		visibility = VisibilityPublic
+	}
+	if visibility != VisibilityPublic && visibility != VisibilityPrivate {
+		return ErrInvalidVisibility
+	}
+
+	a.Title = title
+	a.Slug = slug
+	a.Description = strings.TrimSpace(description)
+	a.Visibility = visibility
+	a.UpdatedAt = time.Now()
+	return nil
+}
+
+// Apply edits the album with the non nil changes
+func (a *Album) Apply(changes AlbumChanges) error {
+	title, slug, description, visibility := a.Title, a.Slug, a.Description, a.Visibility
+	if changes.Title != nil {
+		title = *changes.Title
+	}
+	if changes.Slug != nil {
+		slug = *changes.Slug
+	}
+	if changes.Description != nil {
+		description = *changes.Description
+	}
+	if changes.Visibility != nil {
+		visibility = *changes.Visibility
+	}
+	if changes.CoverImageID != nil {
+		if err := a.SetCover(*changes.CoverImageID); err != nil {
+			return err
+		}
+	}
+	return a.Edit(title, slug, description, visibility)
+}
+
+func (a *Album) IsPublic() bool {
+	return a.Visibility == VisibilityPublic
+}
+
+// AlbumURL is where a public album is shown on the site
+func AlbumURL(siteURL, slug string) string {
+	return siteURL + "/albums/" + slug
+}
+
+func (a *Album) CanModify(userID uuid.UUID, role UserRole) bool {
+	return role == RoleAdmin || (userID != uuid.Nil && a.OwnerID == userID)
+}
+
+// AddImages appends images to the end, images already in the album keep their position
+func (a *Album) AddImages(ids ...uuid.UUID) error {
+	for _, id := range ids {
+		if slices.Contains(a.ImageIDs, id) {
+			continue
+		}
+		if len(a.ImageIDs) >= MaxAlbumImages {
+			return ErrAlbumFull
+		}
+		a.ImageIDs = append(a.ImageIDs, id)
+	}
+	a.UpdatedAt = time.Now()
+	return nil
+}
+
+func (a *Album) RemoveImage(id uuid.UUID) error {
+	i := slices.Index(a.ImageIDs, id)
+	if i < 0 {
+		return ErrImageNotInAlbum
+	}
+	a.ImageIDs = slices.Delete(a.ImageIDs, i, i+1)
+	if a.CoverImageID == id {
+		a.CoverImageID = uuid.Nil
+	}
+	a.UpdatedAt = time.Now()
+	return nil
+}
+
+// Reorder sets the display order, ids must be a permutation of the current images
+func (a *Album) Reorder(ids []uuid.UUID) error {
+	if len(ids) != len(a.ImageIDs) {
+		return ErrInvalidAlbumOrder
+	}
+	seen := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] || !slices.Contains(a.ImageIDs, id) {
+			return ErrInvalidAlbumOrder
+		}
+		seen[id] = true
+	}
+	a.ImageIDs = slices.Clone(ids)
+	a.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetCover picks the cover among the album images, uuid.Nil resets it to the first image
+func (a *Album) SetCover(id uuid.UUID) error {
+	if id != uuid.Nil && !slices.Contains(a.ImageIDs, id) {
+		return ErrImageNotInAlbum
+	}
+	a.CoverImageID = id
+	a.UpdatedAt = time.Now()
+	return nil
+}
+
+// Cover returns the ID of the cover image, uuid.Nil for an empty album
+func (a *Album) Cover() uuid.UUID {
+	if a.CoverImageID != uuid.Nil {
+		return a.CoverImageID
+	}
+	if len(a.ImageIDs) > 0 {
+		return a.ImageIDs[0]
+	}
+	return uuid.Nil
+}
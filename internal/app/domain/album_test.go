@@ -0,0 +1,43 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlugify(t *testing.T) {
+	assert.Equal(t, "dias-de-playa", domain.Slugify("  Días de Playa! "))
+	assert.Equal(t, "a-b-2024", domain.Slugify("A -- B / 2024"))
+	assert.NoError(t, domain.ValidateSlug(domain.Slugify("Ünïcode Título")))
+	assert.ErrorIs(t, domain.ValidateSlug("Not-Valid"), domain.ErrInvalidSlug)
+	assert.ErrorIs(t, domain.ValidateSlug("trailing-"), domain.ErrInvalidSlug)
+}
+
+func TestAlbumImages(t *testing.T) {
+	album, err := domain.NewAlbum(uuid.New(), "Portfolio", "", "", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "portfolio", album.Slug)
+	assert.Equal(t, domain.VisibilityPublic, album.Visibility)
+	assert.Equal(t, uuid.Nil, album.Cover())
+
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+	assert.NoError(t, album.AddImages(a, b, a, c))
+	assert.Equal(t, []uuid.UUID{a, b, c}, album.ImageIDs)
+	assert.Equal(t, a, album.Cover())
+
+	assert.NoError(t, album.SetCover(c))
+	assert.ErrorIs(t, album.SetCover(uuid.New()), domain.ErrImageNotInAlbum)
+
+	assert.ErrorIs(t, album.Reorder([]uuid.UUID{c, a}), domain.ErrInvalidAlbumOrder)
+	assert.ErrorIs(t, album.Reorder([]uuid.UUID{c, a, a}), domain.ErrInvalidAlbumOrder)
+	assert.NoError(t, album.Reorder([]uuid.UUID{c, a, b}))
+	assert.Equal(t, []uuid.UUID{c, a, b}, album.ImageIDs)
+
+	// Removing the cover falls back to the first image
+	assert.NoError(t, album.RemoveImage(c))
+	assert.Equal(t, a, album.Cover())
+	assert.ErrorIs(t, album.RemoveImage(c), domain.ErrImageNotInAlbum)
+}
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrBlobNotFound = errors.New("blob not found")
+	ErrBlobExists   = errors.New("blob already exists")
+)
+
+// Blob is a stored file shared by every image with the same content.
+// The file is only removed from storage once RefCount drops to zero.
+type Blob struct {
+	Name        string // Object name in storage, derived from the checksum
+	Checksum    string // Hex encoded SHA-256 of the stored bytes
+	ContentType string
+	Size        int64
+	URL         string
+	RefCount    int
+	CreatedAt   time.Time
+}
+
+// NewBlob describes the file of a new image, with the image as its first reference
+func NewBlob(img *Image, url string) *Blob {
+	return &Blob{
+		Name:        img.StoredName,
+		Checksum:    img.Checksum,
+		ContentType: img.ContentType,
+		Size:        img.Size,
+		URL:         url,
+		RefCount:    1,
+		CreatedAt:   time.Now(),
+	}
+}
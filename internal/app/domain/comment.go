@@ -0,0 +1,198 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrCommentNotFound      = errors.New("comment not found")
+	ErrInvalidComment       = errors.New("comment body is required")
+	ErrCommentTooLong       = errors.New("comment or author name is too long")
+	ErrInvalidReply         = errors.New("replies must answer an approved comment of the same post")
+	ErrInvalidCommentStatus = errors.New("comment status must be pending, approved, spam or deleted")
+	ErrInvalidTransition    = errors.New("comment cannot be moved to that status")
+	ErrCommentsClosed       = errors.New("comments are closed on archived posts")
+)
+
+const (
+	MaxCommentLength     = 5000 // characters
+	MaxCommentNameLength = 100
+	// AnonymousName is shown for comments whose author left no name
+	AnonymousName = "Anonymous"
+)
+
+type CommentStatus string
+
+const (
+	CommentPending  CommentStatus = "pending"  // Waiting for a moderator, only admins see it
+	CommentApproved CommentStatus = "approved" // Shown under the post
+	CommentSpam     CommentStatus = "spam"     // Caught by the honeypot or marked by a moderator
+	CommentDeleted  CommentStatus = "deleted"  // Removed by a moderator, final
+)
+
+// commentTransitions are the statuses a moderator can move a comment to from each status
+var commentTransitions = map[CommentStatus][]CommentStatus{
+	CommentPending:  {CommentApproved, CommentSpam, CommentDeleted},
+	CommentApproved: {CommentSpam, CommentDeleted},
+	CommentSpam:     {CommentApproved, CommentDeleted},
+	CommentDeleted:  {},
+}
+
+// Comment is a reader's comment on a post, or a reply to another comment
+type Comment struct {
+	ID     uuid.UUID
+	PostID uuid.UUID
+	// ParentID is the comment replied to, uuid.Nil for comments on the post itself
+	ParentID uuid.UUID
+	// AuthorID is uuid.Nil for anonymous comments and once the account was deleted
+	AuthorID   uuid.UUID
+	AuthorName string
+	// AuthorEmail is only shown to admins, empty when the author left none
+	AuthorEmail string
+	Body        string // plain text
+	Status      CommentStatus
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// CommentAuthor is who writes a comment, UserID is uuid.Nil for anonymous readers
+type CommentAuthor struct {
+	UserID uuid.UUID
+	Name   string
+	Email  string
+}
+
+// CommentFilter selects comments in the moderation queue, zero values do not filter
+type CommentFilter struct {
+	Status CommentStatus
+	Limit  int
+	Offset int
+}
+
+// Normalize applies the page size rules of posts
+func (f CommentFilter) Normalize() CommentFilter {
+	page := PostFilter{Limit: f.Limit, Offset: f.Offset}.Normalize()
+	f.Limit, f.Offset = page.Limit, page.Offset
+	return f
+}
+
+// CommentList is one page of comments along with the number of comments in all pages
+type CommentList struct {
+	Comments []*Comment
+	Total    int
+}
+
+// NewComment creates a pending comment on a published post. parent is the comment replied to, nil for none.
+func NewComment(post *Post, parent *Comment, author CommentAuthor, body string) (*Comment, error) {
+	if !post.IsPublished() {
+		if post.IsPublic() {
+			return nil, ErrCommentsClosed
+		}
+		return nil, ErrPostNotFound
+	}
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return nil, ErrInvalidComment
+	}
+	name := strings.Join(strings.Fields(author.Name), " ")
+	if name == "" {
+		name = AnonymousName
+	}
+	if utf8.RuneCountInString(body) > MaxCommentLength || utf8.RuneCountInString(name) > MaxCommentNameLength {
+		return nil, ErrCommentTooLong
+	}
+	email := strings.TrimSpace(author.Email)
+	if email != "" {
+		var err error
+		if email, err = NormalizeEmail(email); err != nil {
+			return nil, err
+		}
+	}
+
+	comment := &Comment{
+		ID:          uuid.New(),
+		PostID:      post.ID,
+		AuthorID:    author.UserID,
+		AuthorName:  name,
+		AuthorEmail: email,
+		Body:        body,
+		Status:      CommentPending,
+		CreatedAt:   time.Now(),
+	}
+	comment.UpdatedAt = comment.CreatedAt
+	if parent != nil {
+		if parent.PostID != post.ID || !parent.IsVisible() {
+			return nil, ErrInvalidReply
+		}
+		comment.ParentID = parent.ID
+	}
+	return comment, nil
+}
+
+// Moderate moves the comment to another status, following commentTransitions
+func (c *Comment) Moderate(status CommentStatus) error {
+	if _, ok := commentTransitions[status]; !ok {
+		return ErrInvalidCommentStatus
+	}
+	if status == c.Status {
+		return nil
+	}
+	if !slices.Contains(commentTransitions[c.Status], status) {
+		return ErrInvalidTransition
+	}
+	c.Status = status
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+func (c *Comment) IsVisible() bool {
+	return c.Status == CommentApproved
+}
+
+// CommentThread is a comment with its replies. Hidden comments are only kept as placeholders
+// for visible replies, their content must not be shown.
+type CommentThread struct {
+	Comment *Comment
+	Hidden  bool
+	Replies []*CommentThread
+}
+
+// ThreadComments nests the comments of a post under the ones they reply to, oldest first.
+// Comments that are not visible are left out unless a visible reply hangs from them.
+func ThreadComments(comments []*Comment) []*CommentThread {
+	sorted := slices.Clone(comments)
+	slices.SortStableFunc(sorted, func(a, b *Comment) int { return a.CreatedAt.Compare(b.CreatedAt) })
+
+	nodes := make(map[uuid.UUID]*CommentThread, len(sorted))
+	for _, c := range sorted {
+		nodes[c.ID] = &CommentThread{Comment: c, Hidden: !c.IsVisible()}
+	}
+	var roots []*CommentThread
+	for _, c := range sorted {
+		node := nodes[c.ID]
+		if parent, ok := nodes[c.ParentID]; ok {
+			parent.Replies = append(parent.Replies, node)
+		} else {
+			roots = append(roots, node)
+		}
+	}
+	return pruneThreads(roots)
+}
+
+// pruneThreads drops the hidden threads without visible replies
+func pruneThreads(threads []*CommentThread) []*CommentThread {
+	kept := threads[:0]
+	for _, t := range threads {
+		t.Replies = pruneThreads(t.Replies)
+		if !t.Hidden || len(t.Replies) > 0 {
+			kept = append(kept, t)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,87 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewComment(t *testing.T) {
+	post := &domain.Post{ID: uuid.New(), Status: domain.PostPublished}
+
+	comment, err := domain.NewComment(post, nil, domain.CommentAuthor{Name: "  ", Email: "Ana@Example.COM"}, "  Nice post ")
+	require.NoError(t, err)
+	assert.Equal(t, domain.CommentPending, comment.Status)
+	assert.Equal(t, domain.AnonymousName, comment.AuthorName)
+	assert.Equal(t, "Ana@example.com", comment.AuthorEmail)
+	assert.Equal(t, "Nice post", comment.Body)
+
+	_, err = domain.NewComment(post, nil, domain.CommentAuthor{}, " ")
+	assert.ErrorIs(t, err, domain.ErrInvalidComment)
+	_, err = domain.NewComment(post, nil, domain.CommentAuthor{}, strings.Repeat("a", domain.MaxCommentLength+1))
+	assert.ErrorIs(t, err, domain.ErrCommentTooLong)
+	_, err = domain.NewComment(post, nil, domain.CommentAuthor{Email: "Ana <ana@example.com>"}, "Hi")
+	assert.ErrorIs(t, err, domain.ErrInvalidEmail)
+
+	// Replies need an approved parent on the same post
+	_, err = domain.NewComment(post, comment, domain.CommentAuthor{}, "Reply")
+	assert.ErrorIs(t, err, domain.ErrInvalidReply)
+	require.NoError(t, comment.Moderate(domain.CommentApproved))
+	reply, err := domain.NewComment(post, comment, domain.CommentAuthor{}, "Reply")
+	require.NoError(t, err)
+	assert.Equal(t, comment.ID, reply.ParentID)
+	other := &domain.Post{ID: uuid.New(), Status: domain.PostPublished}
+	_, err = domain.NewComment(other, comment, domain.CommentAuthor{}, "Reply")
+	assert.ErrorIs(t, err, domain.ErrInvalidReply)
+
+	post.Status = domain.PostArchived
+	_, err = domain.NewComment(post, nil, domain.CommentAuthor{}, "Late")
+	assert.ErrorIs(t, err, domain.ErrCommentsClosed)
+	post.Status = domain.PostDraft
+	_, err = domain.NewComment(post, nil, domain.CommentAuthor{}, "Early")
+	assert.ErrorIs(t, err, domain.ErrPostNotFound)
+}
+
+func TestCommentModerate(t *testing.T) {
+	comment := &domain.Comment{Status: domain.CommentPending}
+	assert.ErrorIs(t, comment.Moderate("hidden"), domain.ErrInvalidCommentStatus)
+
+	require.NoError(t, comment.Moderate(domain.CommentSpam))
+	require.NoError(t, comment.Moderate(domain.CommentApproved))
+	assert.ErrorIs(t, comment.Moderate(domain.CommentPending), domain.ErrInvalidTransition)
+	require.NoError(t, comment.Moderate(domain.CommentDeleted))
+	assert.ErrorIs(t, comment.Moderate(domain.CommentApproved), domain.ErrInvalidTransition)
+	assert.NoError(t, comment.Moderate(domain.CommentDeleted), "staying in a status is not a transition")
+}
+
+func TestThreadComments(t *testing.T) {
+	at := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	comment := func(minute int, parent *domain.Comment, status domain.CommentStatus) *domain.Comment {
+		c := &domain.Comment{ID: uuid.New(), Status: status, CreatedAt: at.Add(time.Duration(minute) * time.Minute)}
+		if parent != nil {
+			c.ParentID = parent.ID
+		}
+		return c
+	}
+	first := comment(0, nil, domain.CommentApproved)
+	deleted := comment(1, nil, domain.CommentDeleted)
+	reply := comment(3, deleted, domain.CommentApproved)
+	nested := comment(4, reply, domain.CommentApproved)
+	pendingReply := comment(2, first, domain.CommentPending)
+	spam := comment(5, nil, domain.CommentSpam)
+
+	threads := domain.ThreadComments([]*domain.Comment{spam, nested, reply, pendingReply, deleted, first})
+	require.Len(t, threads, 2)
+	assert.Equal(t, first, threads[0].Comment)
+	assert.Empty(t, threads[0].Replies)
+	assert.Equal(t, deleted, threads[1].Comment)
+	assert.True(t, threads[1].Hidden)
+	require.Len(t, threads[1].Replies, 1)
+	assert.Equal(t, reply, threads[1].Replies[0].Comment)
+	assert.Equal(t, nested, threads[1].Replies[0].Replies[0].Comment)
+}
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrContactMessageNotFound = errors.New("contact message not found")
+	ErrInvalidContact         = errors.New("contact messages need a name, an email and a message")
+	ErrContactTooLong         = errors.New("contact message field is too long")
+	ErrCaptchaFailed          = errors.New("captcha verification failed")
+)
+
+// Lengths in characters
+const (
+	MaxContactNameLength    = 100
+	MaxContactSubjectLength = 200
+	MaxContactMessageLength = 10000
+)
+
+// ContactForm is what a visitor fills in the contact form of the site
+type ContactForm struct {
+	Name    string
+	Email   string
+	Subject string // optional
+	Message string
+}
+
+// ContactMessage is a submission of the contact form, kept in the admin inbox
+type ContactMessage struct {
+	ID        uuid.UUID
+	Name      string
+	Email     string
+	Subject   string
+	Message   string
+	CreatedAt time.Time
+}
+
+// ContactMessageList is one page of the inbox along with the number of messages in all pages
+type ContactMessageList struct {
+	Messages []*ContactMessage
+	Total    int
+}
+
+func NewContactMessage(form ContactForm) (*ContactMessage, error) {
+	name := strings.Join(strings.Fields(form.Name), " ")
+	subject := strings.Join(strings.Fields(form.Subject), " ")
+	message := strings.TrimSpace(form.Message)
+	if name == "" || message == "" || strings.TrimSpace(form.Email) == "" {
+		return nil, ErrInvalidContact
+	}
+	if utf8.RuneCountInString(name) > MaxContactNameLength ||
+		utf8.RuneCountInString(subject) > MaxContactSubjectLength ||
+		utf8.RuneCountInString(message) > MaxContactMessageLength {
+		return nil, ErrContactTooLong
+	}
+	email, err := NormalizeEmail(strings.TrimSpace(form.Email))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContactMessage{
+		ID:        uuid.New(),
+		Name:      name,
+		Email:     email,
+		Subject:   subject,
+		Message:   message,
+		CreatedAt: time.Now(),
+	}, nil
+}
@@ -0,0 +1,10 @@
+package domain
+
+// Email is a plain text message sent by the site, the sender is configured in the mailer
+type Email struct {
+	To []string
+	// ReplyTo is who answers reach, e.g. the reader who wrote a comment, empty for the sender
+	ReplyTo string
+	Subject string
+	Text    string
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Feed is the syndication of the latest published posts, independent of the format it is served in
+type Feed struct {
+	Title       string
+	Description string
+	Author      string
+	// SiteURL is the home page, links of the feed and its entries start with it
+	SiteURL string
+	// FullContent tells whether the entries carry the whole post or only its summary
+	FullContent bool
+	// Updated is the latest update of an entry, the Unix epoch when there are none
+	Updated time.Time
+	Entries []FeedEntry
+}
+
+type FeedEntry struct {
+	// ID stays the same when the slug changes, so readers do not show the post again
+	ID        uuid.UUID
+	Title     string
+	URL       string
+	Summary   string
+	HTML      string // empty unless the feed has full content
+	Tags      []Tag
+	Published time.Time
+	Updated   time.Time
+}
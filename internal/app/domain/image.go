@@ -1,8 +1,8 @@
 package domain
 
 import (
+	"encoding/hex"
 	"errors"
-	"path/filepath"
 	"strings"
 	"time"
 
@@ -10,44 +10,142 @@ import (
 )
 
 var (
-	ErrImageTooLarge = errors.New("image size exceeds maximum limit")
-	ErrInvalidFormat = errors.New("only jpeg and png formats are allowed")
+	ErrImageTooLarge      = errors.New("image size exceeds maximum limit")
+	ErrImageTooManyPixels = errors.New("image dimensions exceed maximum limit")
+	ErrInvalidFormat      = errors.New("only jpeg, png and webp formats are allowed")
+	ErrSuspiciousImage    = errors.New("image contains unexpected embedded data")
+	ErrImageNotFound      = errors.New("image not found")
+	ErrInvalidVisibility  = errors.New("visibility must be public or private")
 )
 
+const (
+	MaxImageSize   = 50 * 1024 * 1024 // Absolute ceiling, uploads are buffered in memory. See UploadLimits.
+	MaxImagePixels = 40_000_000       // Decompression bomb guard, ~8000x5000
+)
+
+// extensionsByContentType maps the allowed (sniffed) content types to the stored extension
+var extensionsByContentType = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+}
+
+type Visibility string
+
+const (
+	VisibilityPublic  Visibility = "public"  // Readable by anyone straight from storage
+	VisibilityPrivate Visibility = "private" // Only through signed URLs or the authenticated proxy
+)
+
+// SignedURLTTL is how long presigned download URLs of private images stay valid
+const SignedURLTTL = 5 * time.Minute
+
+// ImageInfo is what was learned by decoding the uploaded bytes, never what the client claimed
+type ImageInfo struct {
+	ContentType string
+	Width       int
+	Height      int
+	// Length is where the image ends, data appended after it is cut off before storing
+	Length int64
+}
+
+// ImagePreview is what clients need to lay out and paint a placeholder before the image loads
+type ImagePreview struct {
+	Width         int
+	Height        int
+	BlurHash      string
+	DominantColor string // CSS hex color, e.g. #a0b1c2
+}
+
+// UploadOptions are the per-upload choices of the uploader
+type UploadOptions struct {
+	UserID uuid.UUID
+	Role   UserRole
+	// KeepMetadata skips EXIF stripping, only honored for admins
+	KeepMetadata bool
+	// Visibility defaults to public
+	Visibility Visibility
+}
+
 type Image struct {
 	ID           uuid.UUID
+	OwnerID      uuid.UUID
+	Visibility   Visibility
 	OriginalName string
 	StoredName   string
 	ContentType  string
 	Size         int64
+	Checksum     string // Hex encoded SHA-256 of the stored file
+	URL          string
+	Preview      ImagePreview
+	Description  ImageDescription
+	Tags         []Tag
 	CreatedAt    time.Time
 }
 
-func NewImage(originalName string, contentType string, size int64) (*Image, error) {
-	if size > 5*1024*1024 { // 5MB Limit
+func NewImage(originalName string, info ImageInfo, size int64, checksum string, ownerID uuid.UUID, visibility Visibility) (*Image, error) {
+	if size > MaxImageSize {
 		return nil, ErrImageTooLarge
 	}
 
-	validTypes := map[string]bool{"image/jpeg": true, "image/png": true, "image/webp": true}
-	if !validTypes[contentType] {
+	ext, ok := extensionsByContentType[info.ContentType]
+	if !ok {
 		return nil, ErrInvalidFormat
 	}
 
-	ext := filepath.Ext(originalName)
-	if ext == "" {
-		ext = ".jpg"
+	if int64(info.Width)*int64(info.Height) > MaxImagePixels {
+		return nil, ErrImageTooManyPixels
 	}
 
-	id := uuid.New()
-	// StoredName sanitizes the filename to prevent path traversal
-	storedName := id.String() + strings.ToLower(ext)
+	if visibility == "" {
+		visibility = VisibilityPublic
+	}
+	if visibility != VisibilityPublic && visibility != VisibilityPrivate {
+		return nil, ErrInvalidVisibility
+	}
+
+	if sum, err := hex.DecodeString(checksum); err != nil || len(sum) != 32 {
+		return nil, ErrInvalidChecksum
+	}
+
+	// StoredName is derived from the content hash and the detected type to prevent path traversal,
+	// identical files map to the same object. The prefix keeps private objects out of the publicly
+	// readable part of the bucket.
+	storedName := string(visibility) + "/" + checksum + ext
 
 	return &Image{
-		ID:           id,
+		ID:           uuid.New(),
+		OwnerID:      ownerID,
+		Visibility:   visibility,
 		OriginalName: originalName,
 		StoredName:   storedName,
-		ContentType:  contentType,
+		ContentType:  info.ContentType,
 		Size:         size,
+		Checksum:     checksum,
+		Preview:      ImagePreview{Width: info.Width, Height: info.Height},
 		CreatedAt:    time.Now(),
 	}, nil
 }
+
+// LegacyStoredName reports whether the image was stored before visibility prefixes existed, at the
+// root of the bucket where the default policy no longer exposes it, and where it belongs instead
+func (img *Image) LegacyStoredName() (string, bool) {
+	if strings.Contains(img.StoredName, "/") {
+		return "", false
+	}
+	return string(img.Visibility) + "/" + img.StoredName, true
+}
+
+func (img *Image) IsPublic() bool {
+	return img.Visibility == VisibilityPublic
+}
+
+// CanAccess reports whether the user may read the image, private images are limited to the owner and admins
+func (img *Image) CanAccess(userID uuid.UUID, role UserRole) bool {
+	return img.IsPublic() || img.CanModify(userID, role)
+}
+
+// CanModify reports whether the user may change or delete the image
+func (img *Image) CanModify(userID uuid.UUID, role UserRole) bool {
+	return role == RoleAdmin || (userID != uuid.Nil && img.OwnerID == userID)
+}
@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"errors"
+	"maps"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"
+)
+
+var (
+	ErrInvalidLocale      = errors.New("alt text locale must be a BCP 47 language tag, e.g. en or es-AR")
+	ErrDescriptionTooLong = errors.New("image description field is too long")
+)
+
+// Lengths in characters
+const (
+	MaxAltTextLength = 1000
+	MaxCaptionLength = 2000
+	MaxCreditLength  = 200
+	MaxLicenseLength = 100
+)
+
+// ImageDescription is what visitors and screen readers are told about an image
+type ImageDescription struct {
+	// Alt is the alternative text by canonical BCP 47 locale
+	Alt     map[string]string
+	Caption string
+	Credit  string
+	// License is free text, preferably an SPDX identifier such as CC-BY-4.0
+	License string
+}
+
+// ImageDescriptionChanges are the fields to edit, nil fields are left unchanged.
+// Alt is merged by locale, a nil or empty text removes the locale.
+type ImageDescriptionChanges struct {
+	Alt     map[string]*string
+	Caption *string
+	Credit  *string
+	License *string
+	// Tags replaces all tags of the image
+	Tags *[]string
+}
+
+// Describe applies the changes to the description and tags, nothing changes when one of them is invalid
+func (img *Image) Describe(changes ImageDescriptionChanges) error {
+	tags := img.Tags
+	if changes.Tags != nil {
+		var err error
+		if tags, err = NewTags(*changes.Tags); err != nil {
+			return err
+		}
+	}
+
+	desc := img.Description
+	desc.Alt = maps.Clone(desc.Alt)
+	if desc.Alt == nil {
+		desc.Alt = make(map[string]string)
+	}
+
+	for locale, text := range changes.Alt {
+		tag, err := language.Parse(locale)
+		if err != nil || tag == language.Und {
+			return ErrInvalidLocale
+		}
+		locale = tag.String()
+		if text == nil || strings.TrimSpace(*text) == "" {
+			delete(desc.Alt, locale)
+			continue
+		}
+		alt, err := describeField(*text, MaxAltTextLength)
+		if err != nil {
+			return err
+		}
+		desc.Alt[locale] = alt
+	}
+
+	for _, field := range []struct {
+		value *string
+		max   int
+		dest  *string
+	}{
+		{changes.Caption, MaxCaptionLength, &desc.Caption},
+		{changes.Credit, MaxCreditLength, &desc.Credit},
+		{changes.License, MaxLicenseLength, &desc.License},
+	} {
+		if field.value == nil {
+			continue
+		}
+		value, err := describeField(*field.value, field.max)
+		if err != nil {
+			return err
+		}
+		*field.dest = value
+	}
+
+	img.Description = desc
+	img.Tags = tags
+	return nil
+}
+
+func describeField(value string, max int) (string, error) {
+	value = strings.TrimSpace(value)
+	if utf8.RuneCountInString(value) > max {
+		return "", ErrDescriptionTooLong
+	}
+	return value, nil
+}
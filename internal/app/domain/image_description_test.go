@@ -0,0 +1,36 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageDescribe(t *testing.T) {
+	img := &domain.Image{}
+	text := func(s string) *string { return &s }
+
+	err := img.Describe(domain.ImageDescriptionChanges{
+		Alt:     map[string]*string{"EN": text(" A red bicycle "), "es-ar": text("Una bicicleta roja")},
+		License: text("CC-BY-4.0"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"en": "A red bicycle", "es-AR": "Una bicicleta roja"}, img.Description.Alt)
+	assert.Equal(t, "CC-BY-4.0", img.Description.License)
+
+	err = img.Describe(domain.ImageDescriptionChanges{Alt: map[string]*string{"es-AR": nil}, Caption: text("Spring")})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"en": "A red bicycle"}, img.Description.Alt)
+	assert.Equal(t, "Spring", img.Description.Caption)
+	assert.Equal(t, "CC-BY-4.0", img.Description.License)
+
+	// Invalid changes leave the description untouched
+	err = img.Describe(domain.ImageDescriptionChanges{Alt: map[string]*string{"not a locale": text("x")}, Caption: text("Summer")})
+	assert.ErrorIs(t, err, domain.ErrInvalidLocale)
+	err = img.Describe(domain.ImageDescriptionChanges{Credit: text(strings.Repeat("a", domain.MaxCreditLength+1))})
+	assert.ErrorIs(t, err, domain.ErrDescriptionTooLong)
+	assert.Equal(t, "Spring", img.Description.Caption)
+	assert.Empty(t, img.Description.Credit)
+}
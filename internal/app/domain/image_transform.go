@@ -0,0 +1,171 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidTransform   = errors.New("invalid image transformation")
+	ErrTransformForbidden = errors.New("image transformation not allowed")
+)
+
+type ImageFit string
+
+const (
+	FitCover   ImageFit = "cover"   // Fill the box, cropping the overflow
+	FitContain ImageFit = "contain" // Fit inside the box, keeping the aspect ratio
+	FitFill    ImageFit = "fill"    // Stretch to the exact box
+)
+
+const (
+	MaxTransformDimension = 4096
+	DefaultQuality        = 80
+)
+
+// ImageSize is a width x height pair. A zero side means "keep aspect ratio".
+type ImageSize struct {
+	Width  int
+	Height int
+}
+
+// Path is the transformation endpoint URL of the image at this size
+func (s ImageSize) Path(id uuid.UUID) string {
+	query := make([]string, 0, 2)
+	if s.Width > 0 {
+		query = append(query, fmt.Sprintf("w=%d", s.Width))
+	}
+	if s.Height > 0 {
+		query = append(query, fmt.Sprintf("h=%d", s.Height))
+	}
+	return "/img/" + id.String() + "?" + strings.Join(query, "&")
+}
+
+// ImageVariant is a resized rendition served by the transformation endpoint
+type ImageVariant struct {
+	ImageSize
+	URL string
+}
+
+// GalleryImage is a public image together with the renditions clients can pick from
+type GalleryImage struct {
+	Image    *Image
+	Variants []ImageVariant
+}
+
+// NewGalleryImage lists a variant for every size that can be requested without a signature
+func NewGalleryImage(img *Image, sizes []ImageSize) GalleryImage {
+	variants := make([]ImageVariant, 0, len(sizes))
+	for _, size := range sizes {
+		variants = append(variants, ImageVariant{ImageSize: size, URL: size.Path(img.ID)})
+	}
+	return GalleryImage{Image: img, Variants: variants}
+}
+
+// ImageTransform describes how an image should be resized and encoded
+type ImageTransform struct {
+	Width   int
+	Height  int
+	Fit     ImageFit
+	Format  string // "jpeg" or "png"
+	Quality int
+}
+
+// formatsByContentType maps the stored content types to an output format
+var formatsByContentType = map[string]string{
+	"image/jpeg": "jpeg",
+	"image/png":  "png",
+	"image/webp": "jpeg", // webp can be decoded but not encoded
+}
+
+var contentTypesByFormat = map[string]string{
+	"jpeg": "image/jpeg",
+	"png":  "image/png",
+}
+
+// Normalize fills defaults from the source image and validates the result
+func (t ImageTransform) Normalize(sourceContentType string) (ImageTransform, error) {
+	if t.Width < 0 || t.Height < 0 || t.Width > MaxTransformDimension || t.Height > MaxTransformDimension {
+		return t, ErrInvalidTransform
+	}
+
+	if t.Fit == "" {
+		t.Fit = FitContain
+	}
+	switch t.Fit {
+	case FitCover, FitContain, FitFill:
+	default:
+		return t, ErrInvalidTransform
+	}
+	// cover and fill need both sides to know the box
+	if t.Fit != FitContain && (t.Width == 0 || t.Height == 0) {
+		return t, ErrInvalidTransform
+	}
+
+	t.Format = strings.ToLower(t.Format)
+	if t.Format == "jpg" {
+		t.Format = "jpeg"
+	}
+	if t.Format == "" {
+		t.Format = formatsByContentType[sourceContentType]
+	}
+	if _, ok := contentTypesByFormat[t.Format]; !ok {
+		return t, ErrInvalidTransform
+	}
+
+	if t.Quality == 0 {
+		t.Quality = DefaultQuality
+	}
+	if t.Quality < 1 || t.Quality > 100 {
+		return t, ErrInvalidTransform
+	}
+	if t.Format == "png" {
+		t.Quality = 0 // lossless, quality is meaningless
+	}
+
+	return t, nil
+}
+
+// ContentType returns the MIME type produced by the transformation
+func (t ImageTransform) ContentType() string {
+	return contentTypesByFormat[t.Format]
+}
+
+// Key is a canonical representation used for cache names and signatures
+func (t ImageTransform) Key() string {
+	return fmt.Sprintf("w%d_h%d_%s_q%d.%s", t.Width, t.Height, t.Fit, t.Quality, t.Format)
+}
+
+// CacheName is the storage object name for the transformed variant
+func (t ImageTransform) CacheName(imageID uuid.UUID) string {
+	return CachePrefix + imageID.String() + "/" + t.Key()
+}
+
+// ETag identifies the variant. Originals are immutable, so the name is enough.
+func (t ImageTransform) ETag(imageID uuid.UUID) string {
+	sum := sha256.Sum256([]byte(t.CacheName(imageID)))
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// Sign returns the URL-safe HMAC of the transformation for the given image
+func (t ImageTransform) Sign(key []byte, imageID uuid.UUID) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(imageID.String() + "/" + t.Key()))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature checks a signature produced by Sign in constant time
+func (t ImageTransform) VerifySignature(key []byte, imageID uuid.UUID, signature string) bool {
+	if len(key) == 0 || signature == "" {
+		return false
+	}
+	expected := t.Sign(key, imageID)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
@@ -0,0 +1,46 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageTransformNormalize(t *testing.T) {
+	tr, err := domain.ImageTransform{Width: 640}.Normalize("image/png")
+	assert.NoError(t, err)
+	assert.Equal(t, domain.FitContain, tr.Fit)
+	assert.Equal(t, "png", tr.Format)
+	assert.Equal(t, "image/png", tr.ContentType())
+
+	tr, err = domain.ImageTransform{Width: 640, Format: "jpg"}.Normalize("image/png")
+	assert.NoError(t, err)
+	assert.Equal(t, "jpeg", tr.Format)
+	assert.Equal(t, domain.DefaultQuality, tr.Quality)
+
+	_, err = domain.ImageTransform{Width: 640, Fit: domain.FitCover}.Normalize("image/jpeg")
+	assert.ErrorIs(t, err, domain.ErrInvalidTransform)
+
+	_, err = domain.ImageTransform{Width: 10000}.Normalize("image/jpeg")
+	assert.ErrorIs(t, err, domain.ErrInvalidTransform)
+
+	_, err = domain.ImageTransform{Format: "gif"}.Normalize("image/jpeg")
+	assert.ErrorIs(t, err, domain.ErrInvalidTransform)
+}
+
+func TestImageTransformSignature(t *testing.T) {
+	key := []byte("secret")
+	id := uuid.New()
+	tr, _ := domain.ImageTransform{Width: 500, Height: 500, Fit: domain.FitCover}.Normalize("image/jpeg")
+
+	sig := tr.Sign(key, id)
+	assert.True(t, tr.VerifySignature(key, id, sig))
+	assert.False(t, tr.VerifySignature([]byte("other"), id, sig))
+	assert.False(t, tr.VerifySignature(key, uuid.New(), sig))
+	assert.False(t, tr.VerifySignature(nil, id, sig))
+
+	tr.Width = 501
+	assert.False(t, tr.VerifySignature(key, id, sig))
+}
@@ -0,0 +1,293 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrPostNotFound      = errors.New("post not found")
+	ErrInvalidPostTitle  = errors.New("post title is required")
+	ErrInvalidPostStatus = errors.New("post status must be draft, scheduled, published or archived")
+	ErrExcerptTooLong    = errors.New("post excerpt is too long")
+	ErrPublishAtRequired = errors.New("scheduled posts need a publish_at time")
+)
+
+const (
+	MaxExcerptLength = 500 // characters, for excerpts written by the author
+	// SummaryLength is the length of summaries cut from the body when there is no excerpt
+	SummaryLength = 200
+	// MaxPostsPage is the largest page of posts that can be requested
+	MaxPostsPage     = 100
+	DefaultPostsPage = 20
+)
+
+type PostStatus string
+
+const (
+	PostDraft     PostStatus = "draft"     // Only visible to admins and preview links
+	PostScheduled PostStatus = "scheduled" // Published by the scheduler at PublishAt
+	PostPublished PostStatus = "published" // Listed publicly
+	PostArchived  PostStatus = "archived"  // Unlisted, but its URL keeps working
+)
+
+// Post is a Markdown article of the site
+type Post struct {
+	ID uuid.UUID
+	// AuthorID is uuid.Nil once the author was deleted, posts outlive their author
+	AuthorID uuid.UUID
+	Slug     string
+	Title    string
+	// Body is the Markdown source, HTML its sanitized rendering and Text its plain text
+	Body string
+	HTML string
+	Text string
+	// Excerpt is written by the author and may be empty, Summary is what is shown in listings
+	Excerpt     string
+	Summary     string
+	Tags        []Tag
+	Status      PostStatus
+	PublishAt   time.Time // when a scheduled post goes out, zero for other statuses
+	PublishedAt time.Time // zero until first published
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// PostChanges are the fields to edit, nil fields are left unchanged
+type PostChanges struct {
+	Title   *string
+	Slug    *string
+	Body    *string
+	Excerpt *string
+	Status  *PostStatus
+	// PublishAt is only kept for scheduled posts
+	PublishAt *time.Time
+	// Tags replaces all tags of the post
+	Tags *[]string
+}
+
+// PostFilter selects posts in a listing, zero values do not filter
+type PostFilter struct {
+	Status PostStatus
+	Tag    string // slug
+	Limit  int
+	Offset int
+}
+
+// Normalize applies the default page size and caps it at MaxPostsPage
+func (f PostFilter) Normalize() PostFilter {
+	if f.Limit <= 0 {
+		f.Limit = DefaultPostsPage
+	}
+	f.Limit = min(f.Limit, MaxPostsPage)
+	f.Offset = max(f.Offset, 0)
+	return f
+}
+
+// PostList is one page of a listing along with the number of posts in all pages
+type PostList struct {
+	Posts []*Post
+	Total int
+}
+
+// PostPreview is a link showing an unpublished post to whoever has it
+type PostPreview struct {
+	URL       string
+	Token     string
+	ExpiresAt time.Time
+}
+
+// RenderedMarkdown is the output of a Markdown renderer
+type RenderedMarkdown struct {
+	HTML string // sanitized, safe to embed in a page
+	Text string // plain text, e.g. for summaries
+}
+
+func NewPost(authorID uuid.UUID, title, slug, body, excerpt string, status PostStatus, publishAt time.Time) (*Post, error) {
+	post := &Post{
+		ID:        uuid.New(),
+		AuthorID:  authorID,
+		Status:    PostDraft,
+		CreatedAt: time.Now(),
+	}
+	if slug == "" {
+		slug = Slugify(title)
+	}
+	if err := post.Edit(title, slug, body, excerpt, status, publishAt); err != nil {
+		return nil, err
+	}
+	return post, nil
+}
+
+// Edit replaces the content, an empty status keeps the current one. A post scheduled
+// for a time already past is published right away.
+func (p *Post) Edit(title, slug, body, excerpt string, status PostStatus, publishAt time.Time) error {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return ErrInvalidPostTitle
+	}
+	if err := ValidateSlug(slug); err != nil {
+		return err
+	}
+	excerpt = strings.TrimSpace(excerpt)
+	if utf8.RuneCountInString(excerpt) > MaxExcerptLength {
+		return ErrExcerptTooLong
+	}
+	if status == "" {
+		status = p.Status
+	}
+	switch status {
+	case PostScheduled:
+		if publishAt.IsZero() {
+			return ErrPublishAtRequired
+		}
+	case PostDraft, PostPublished, PostArchived:
+		publishAt = time.Time{}
+	default:
+		return ErrInvalidPostStatus
+	}
+
+	p.Title = title
+	p.Slug = slug
+	p.Body = body
+	p.Excerpt = excerpt
+	p.Status = status
+	p.PublishAt = publishAt
+	p.UpdatedAt = time.Now()
+	if status == PostPublished && p.PublishedAt.IsZero() {
+		p.PublishedAt = p.UpdatedAt
+	}
+	p.PublishDue(p.UpdatedAt)
+	return nil
+}
+
+// PublishDue publishes a scheduled post whose time has come, dated as scheduled.
+// It reports whether the post was published.
+func (p *Post) PublishDue(now time.Time) bool {
+	if p.Status != PostScheduled || p.PublishAt.After(now) {
+		return false
+	}
+	p.Status = PostPublished
+	if p.PublishedAt.IsZero() {
+		p.PublishedAt = p.PublishAt
+	}
+	p.PublishAt = time.Time{}
+	p.UpdatedAt = now
+	return true
+}
+
+// Apply edits the post with the non nil changes
+func (p *Post) Apply(changes PostChanges) error {
+	title, slug, body, excerpt, status, publishAt := p.Title, p.Slug, p.Body, p.Excerpt, p.Status, p.PublishAt
+	if changes.Title != nil {
+		title = *changes.Title
+	}
+	if changes.Slug != nil {
+		slug = *changes.Slug
+	}
+	if changes.Body != nil {
+		body = *changes.Body
+	}
+	if changes.Excerpt != nil {
+		excerpt = *changes.Excerpt
+	}
+	if changes.Status != nil {
+		status = *changes.Status
+	}
+	if changes.PublishAt != nil {
+		publishAt = *changes.PublishAt
+	}
+	tags := p.Tags
+	if changes.Tags != nil {
+		var err error
+		if tags, err = NewTags(*changes.Tags); err != nil {
+			return err
+		}
+	}
+	if err := p.Edit(title, slug, body, excerpt, status, publishAt); err != nil {
+		return err
+	}
+	p.Tags = tags
+	return nil
+}
+
+// Tag replaces the tags of the post
+func (p *Post) Tag(names []string) error {
+	tags, err := NewTags(names)
+	if err != nil {
+		return err
+	}
+	p.Tags = tags
+	return nil
+}
+
+// Render stores the rendering of the body and derives the summary from it
+func (p *Post) Render(rendered RenderedMarkdown) {
+	p.HTML = rendered.HTML
+	p.Text = rendered.Text
+	p.Summary = p.Excerpt
+	if p.Summary == "" {
+		p.Summary = Summarize(rendered.Text, SummaryLength)
+	}
+}
+
+func (p *Post) IsPublished() bool {
+	return p.Status == PostPublished
+}
+
+// IsPublic tells whether visitors may read the post at its URL, archived posts are only unlisted
+func (p *Post) IsPublic() bool {
+	return p.Status == PostPublished || p.Status == PostArchived
+}
+
+// PostURL is where a post is read on the site
+func PostURL(siteURL, slug string) string {
+	return siteURL + "/posts/" + slug
+}
+
+// PreviewToken returns a token that shows the post in any status until expires.
+// It is bound to the post ID, so it survives a change of slug.
+func (p *Post) PreviewToken(key []byte, expires time.Time) string {
+	exp := strconv.FormatInt(expires.Unix(), 10)
+	return exp + "." + signPreview(key, p.ID, exp)
+}
+
+// VerifyPreviewToken checks a token produced by PreviewToken in constant time
+func (p *Post) VerifyPreviewToken(key []byte, token string, now time.Time) bool {
+	exp, signature, ok := strings.Cut(token, ".")
+	if len(key) == 0 || !ok {
+		return false
+	}
+	expires, err := strconv.ParseInt(exp, 10, 64)
+	if err != nil || now.Unix() > expires {
+		return false
+	}
+	return hmac.Equal([]byte(signPreview(key, p.ID, exp)), []byte(signature))
+}
+
+func signPreview(key []byte, id uuid.UUID, expires string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("post-preview/" + id.String() + "/" + expires))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// Summarize shortens text to at most length characters, cutting at a word boundary
+func Summarize(text string, length int) string {
+	text = strings.Join(strings.Fields(text), " ")
+	if utf8.RuneCountInString(text) <= length {
+		return text
+	}
+	cut := string([]rune(text)[:length-1]) // room for the ellipsis
+	if i := strings.LastIndex(cut, " "); i > 0 {
+		cut = cut[:i]
+	}
+	return strings.TrimRight(cut, ".,;:!? ") + "…"
+}
@@ -0,0 +1,83 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostPublish(t *testing.T) {
+	post, err := domain.NewPost(uuid.New(), " Hello, World! ", "", "# Hi", "", "", time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, "hello-world", post.Slug)
+	assert.Equal(t, "Hello, World!", post.Title)
+	assert.Equal(t, domain.PostDraft, post.Status)
+	assert.True(t, post.PublishedAt.IsZero())
+
+	published := domain.PostPublished
+	require.NoError(t, post.Apply(domain.PostChanges{Status: &published}))
+	assert.True(t, post.IsPublished())
+	first := post.PublishedAt
+	assert.False(t, first.IsZero())
+
+	// Publishing again keeps the original date
+	draft := domain.PostDraft
+	require.NoError(t, post.Apply(domain.PostChanges{Status: &draft}))
+	require.NoError(t, post.Apply(domain.PostChanges{Status: &published}))
+	assert.Equal(t, first, post.PublishedAt)
+
+	invalid := domain.PostStatus("hidden")
+	assert.ErrorIs(t, post.Apply(domain.PostChanges{Status: &invalid}), domain.ErrInvalidPostStatus)
+	empty := "  "
+	assert.ErrorIs(t, post.Apply(domain.PostChanges{Title: &empty}), domain.ErrInvalidPostTitle)
+	assert.Equal(t, "Hello, World!", post.Title)
+}
+
+func TestPostSchedule(t *testing.T) {
+	publishAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	_, err := domain.NewPost(uuid.New(), "Later", "", "", "", domain.PostScheduled, time.Time{})
+	assert.ErrorIs(t, err, domain.ErrPublishAtRequired)
+
+	post, err := domain.NewPost(uuid.New(), "Later", "", "", "", domain.PostScheduled, publishAt)
+	require.NoError(t, err)
+	assert.Equal(t, domain.PostScheduled, post.Status)
+	assert.False(t, post.PublishDue(publishAt.Add(-time.Second)))
+	assert.True(t, post.PublishDue(publishAt))
+	assert.Equal(t, domain.PostPublished, post.Status)
+	assert.Equal(t, publishAt, post.PublishedAt)
+	assert.True(t, post.PublishAt.IsZero())
+
+	// A time already past publishes right away
+	past := time.Now().Add(-time.Hour)
+	post, err = domain.NewPost(uuid.New(), "Earlier", "", "", "", domain.PostScheduled, past)
+	require.NoError(t, err)
+	assert.True(t, post.IsPublished())
+	assert.Equal(t, past, post.PublishedAt)
+}
+
+func TestPostPreviewToken(t *testing.T) {
+	key := []byte("preview-secret")
+	post, err := domain.NewPost(uuid.New(), "Draft", "", "", "", "", time.Time{})
+	require.NoError(t, err)
+	now := time.Now()
+
+	token := post.PreviewToken(key, now.Add(time.Hour))
+	assert.True(t, post.VerifyPreviewToken(key, token, now))
+	assert.False(t, post.VerifyPreviewToken(key, token, now.Add(2*time.Hour)), "expired")
+	assert.False(t, post.VerifyPreviewToken([]byte("other"), token, now))
+	assert.False(t, post.VerifyPreviewToken(key, "", now))
+
+	other := *post
+	other.ID = uuid.New()
+	assert.False(t, other.VerifyPreviewToken(key, token, now), "bound to the post")
+}
+
+func TestSummarize(t *testing.T) {
+	assert.Equal(t, "short text", domain.Summarize("  short\n text ", 20))
+	assert.Equal(t, "the quick brown…", domain.Summarize("the quick brown fox jumps", 18))
+	assert.Equal(t, "Hello…", domain.Summarize("Hello, world", 10))
+}
@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var (
+	ErrQuotaExceeded         = errors.New("storage quota exceeded")
+	ErrFileCountExceeded     = errors.New("maximum number of images reached")
+	ErrContentTypeNotAllowed = errors.New("image type is not allowed for this account")
+	ErrInvalidLimits         = errors.New("limits must not be negative")
+)
+
+// Names of the limits reported in LimitError
+const (
+	LimitFileSize    = "file_size"
+	LimitTotalBytes  = "total_bytes"
+	LimitFileCount   = "file_count"
+	LimitContentType = "content_type"
+)
+
+// UploadLimits bound what a user may store. Zero values mean unlimited,
+// an empty AllowedTypes allows every supported format.
+// MaxImageSize always applies on top as the absolute ceiling.
+type UploadLimits struct {
+	MaxFileSize   int64
+	MaxTotalBytes int64
+	MaxFiles      int
+	AllowedTypes  []string
+}
+
+// StorageUsage is what a user currently stores
+type StorageUsage struct {
+	Bytes int64
+	Files int
+}
+
+// Quota pairs the effective limits of a user with their usage
+type Quota struct {
+	Limits UploadLimits
+	Usage  StorageUsage
+}
+
+// LimitError reports which limit rejected an upload, it unwraps to one of the sentinel errors
+type LimitError struct {
+	Limit string
+	Max   int64
+	Value int64
+	Err   error
+}
+
+func (e *LimitError) Error() string {
+	if e.Limit == LimitContentType {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%s (%s: %d of %d)", e.Err, e.Limit, e.Value, e.Max)
+}
+
+func (e *LimitError) Unwrap() error {
+	return e.Err
+}
+
+func (l UploadLimits) Validate() error {
+	if l.MaxFileSize < 0 || l.MaxTotalBytes < 0 || l.MaxFiles < 0 {
+		return ErrInvalidLimits
+	}
+	for _, t := range l.AllowedTypes {
+		if _, ok := extensionsByContentType[t]; !ok {
+			return ErrInvalidFormat
+		}
+	}
+	return nil
+}
+
+// Override returns l with every non zero limit of o applied, used for per user overrides of role defaults
+func (l UploadLimits) Override(o UploadLimits) UploadLimits {
+	if o.MaxFileSize > 0 {
+		l.MaxFileSize = o.MaxFileSize
+	}
+	if o.MaxTotalBytes > 0 {
+		l.MaxTotalBytes = o.MaxTotalBytes
+	}
+	if o.MaxFiles > 0 {
+		l.MaxFiles = o.MaxFiles
+	}
+	if len(o.AllowedTypes) > 0 {
+		l.AllowedTypes = o.AllowedTypes
+	}
+	return l
+}
+
+// FileSizeLimit is the largest file the user may upload
+func (l UploadLimits) FileSizeLimit() int64 {
+	if l.MaxFileSize > 0 && l.MaxFileSize < MaxImageSize {
+		return l.MaxFileSize
+	}
+	return MaxImageSize
+}
+
+// CheckSize rejects files over the size limit before they are read
+func (l UploadLimits) CheckSize(size int64) error {
+	if limit := l.FileSizeLimit(); size > limit {
+		return &LimitError{Limit: LimitFileSize, Max: limit, Value: size, Err: ErrImageTooLarge}
+	}
+	return nil
+}
+
+// CheckType rejects image types the user may not upload
+func (l UploadLimits) CheckType(contentType string) error {
+	if len(l.AllowedTypes) > 0 && !slices.Contains(l.AllowedTypes, contentType) {
+		return &LimitError{Limit: LimitContentType, Err: ErrContentTypeNotAllowed}
+	}
+	return nil
+}
+
+// Check reports whether a new image of the given type and stored size fits next to the current usage
+func (l UploadLimits) Check(contentType string, size int64, usage StorageUsage) error {
+	if err := l.CheckType(contentType); err != nil {
+		return err
+	}
+	if err := l.CheckSize(size); err != nil {
+		return err
+	}
+	if l.MaxFiles > 0 && usage.Files+1 > l.MaxFiles {
+		return &LimitError{Limit: LimitFileCount, Max: int64(l.MaxFiles), Value: int64(usage.Files + 1), Err: ErrFileCountExceeded}
+	}
+	if l.MaxTotalBytes > 0 && usage.Bytes+size > l.MaxTotalBytes {
+		return &LimitError{Limit: LimitTotalBytes, Max: l.MaxTotalBytes, Value: usage.Bytes + size, Err: ErrQuotaExceeded}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUploadLimitsOverride(t *testing.T) {
+	role := domain.UploadLimits{MaxFileSize: 1000, MaxTotalBytes: 5000, MaxFiles: 10}
+	limits := role.Override(domain.UploadLimits{MaxFiles: 20, AllowedTypes: []string{"image/png"}})
+
+	assert.Equal(t, int64(1000), limits.MaxFileSize)
+	assert.Equal(t, int64(5000), limits.MaxTotalBytes)
+	assert.Equal(t, 20, limits.MaxFiles)
+	assert.Equal(t, []string{"image/png"}, limits.AllowedTypes)
+}
+
+func TestUploadLimitsCheck(t *testing.T) {
+	limits := domain.UploadLimits{MaxFileSize: 1000, MaxTotalBytes: 5000, MaxFiles: 3, AllowedTypes: []string{"image/png"}}
+	usage := domain.StorageUsage{Bytes: 4500, Files: 2}
+
+	assert.NoError(t, limits.Check("image/png", 500, usage))
+
+	var le *domain.LimitError
+	err := limits.Check("image/jpeg", 500, usage)
+	assert.ErrorIs(t, err, domain.ErrContentTypeNotAllowed)
+	assert.ErrorAs(t, err, &le)
+	assert.Equal(t, domain.LimitContentType, le.Limit)
+
+	err = limits.Check("image/png", 1001, usage)
+	assert.ErrorIs(t, err, domain.ErrImageTooLarge)
+
+	err = limits.Check("image/png", 501, usage)
+	assert.ErrorIs(t, err, domain.ErrQuotaExceeded)
+	assert.ErrorAs(t, err, &le)
+	assert.Equal(t, int64(5001), le.Value)
+
+	err = limits.Check("image/png", 10, domain.StorageUsage{Files: 3})
+	assert.ErrorIs(t, err, domain.ErrFileCountExceeded)
+
+	// Unlimited roles are still bound by the absolute ceiling
+	assert.ErrorIs(t, domain.UploadLimits{}.CheckSize(domain.MaxImageSize+1), domain.ErrImageTooLarge)
+}
@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrRedirectNotFound    = errors.New("redirect not found")
+	ErrRedirectExists      = errors.New("the path is already redirected")
+	ErrRedirectLoop        = errors.New("the target redirects back to the path")
+	ErrInvalidRedirect     = errors.New("redirects go from a path of the site to another path or an http(s) URL")
+	ErrReservedPath        = errors.New("paths under /api/ and /auth/ cannot be redirected")
+	ErrInvalidRedirectCode = errors.New("redirect code must be 301, 302, 307 or 308")
+)
+
+// RedirectCodes are the statuses a redirect can answer with, 301 unless chosen otherwise
+var RedirectCodes = []int{http.StatusMovedPermanently, http.StatusFound, http.StatusTemporaryRedirect, http.StatusPermanentRedirect}
+
+// reservedPrefixes keep the API reachable whatever the redirect table says
+var reservedPrefixes = []string{"/api/", "/auth/"}
+
+// Redirect sends the visitors of a legacy path elsewhere
+type Redirect struct {
+	ID        uuid.UUID
+	From      string // path, see RedirectPath
+	To        string // path of the site or absolute URL
+	Code      int
+	CreatedAt time.Time
+}
+
+// RedirectList is one page of the redirect table along with the number of redirects in all pages
+type RedirectList struct {
+	Redirects []*Redirect
+	Total     int
+}
+
+func NewRedirect(from, to string, code int) (*Redirect, error) {
+	from, to = RedirectPath(strings.TrimSpace(from)), strings.TrimSpace(to)
+	if code == 0 {
+		code = http.StatusMovedPermanently
+	}
+	if !slices.Contains(RedirectCodes, code) {
+		return nil, ErrInvalidRedirectCode
+	}
+	if !isSitePath(from) || strings.ContainsAny(from, "?#") || !isRedirectTarget(to) {
+		return nil, ErrInvalidRedirect
+	}
+	redirect := &Redirect{
+		ID:        uuid.New(),
+		From:      from,
+		To:        to,
+		Code:      code,
+		CreatedAt: time.Now(),
+	}
+	if target, ok := redirect.TargetPath(); ok && target == from {
+		return nil, ErrInvalidRedirect
+	}
+	for _, prefix := range reservedPrefixes {
+		if strings.HasPrefix(from+"/", prefix) {
+			return nil, ErrReservedPath
+		}
+	}
+	return redirect, nil
+}
+
+// TargetPath is the path of this site the redirect sends to, as redirects match it
+func (r *Redirect) TargetPath() (string, bool) {
+	if !isSitePath(r.To) {
+		return "", false
+	}
+	path, _, _ := strings.Cut(r.To, "#")
+	path, _, _ = strings.Cut(path, "?")
+	return RedirectPath(path), true
+}
+
+// RedirectPath is how redirects are matched, /old/ and /old are the same path
+func RedirectPath(path string) string {
+	if len(path) > 1 {
+		return strings.TrimRight(path, "/")
+	}
+	return path
+}
+
+// Location is where the redirect sends a request, which keeps its query unless the target has one
+func (r *Redirect) Location(rawQuery string) string {
+	if rawQuery == "" || strings.Contains(r.To, "?") {
+		return r.To
+	}
+	fragment := ""
+	to := r.To
+	if i := strings.Index(to, "#"); i >= 0 {
+		to, fragment = to[:i], to[i:]
+	}
+	return to + "?" + rawQuery + fragment
+}
+
+// isSitePath accepts paths of this site, // would make browsers leave for another host
+func isSitePath(path string) bool {
+	return strings.HasPrefix(path, "/") && !strings.HasPrefix(path, "//") &&
+		!strings.ContainsFunc(path, func(r rune) bool { return r <= ' ' || r == '\\' })
+}
+
+func isRedirectTarget(target string) bool {
+	if isSitePath(target) {
+		return true
+	}
+	u, err := url.Parse(target)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != "" &&
+		!strings.ContainsFunc(target, func(r rune) bool { return r <= ' ' })
+}
@@ -0,0 +1,51 @@
+package domain_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRedirect(t *testing.T) {
+	redirect, err := domain.NewRedirect(" /blog/hello.html/ ", "/posts/hello", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "/blog/hello.html", redirect.From)
+	assert.Equal(t, http.StatusMovedPermanently, redirect.Code)
+
+	tests := []struct {
+		name     string
+		from, to string
+		code     int
+		err      error
+	}{
+		{"relative from", "blog", "/posts", 0, domain.ErrInvalidRedirect},
+		{"from with query", "/blog?page=2", "/posts", 0, domain.ErrInvalidRedirect},
+		{"protocol relative target", "/blog", "//evil.example", 0, domain.ErrInvalidRedirect},
+		{"javascript target", "/blog", "javascript:alert(1)", 0, domain.ErrInvalidRedirect},
+		{"loop", "/blog/", "/blog", 0, domain.ErrInvalidRedirect},
+		{"loop with a query", "/blog", "/blog/?lang=es", 0, domain.ErrInvalidRedirect},
+		{"api", "/api/posts", "/posts", 0, domain.ErrReservedPath},
+		{"auth", "/auth", "/", 0, domain.ErrReservedPath},
+		{"code", "/blog", "/posts", http.StatusOK, domain.ErrInvalidRedirectCode},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewRedirect(tt.from, tt.to, tt.code)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestRedirectLocation(t *testing.T) {
+	redirect, err := domain.NewRedirect("/old", "https://example.com/new#top", http.StatusFound)
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/new#top", redirect.Location(""))
+	assert.Equal(t, "https://example.com/new?a=1#top", redirect.Location("a=1"))
+
+	redirect, err = domain.NewRedirect("/old", "/new?lang=es", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "/new?lang=es", redirect.Location("a=1"), "the query of the target wins")
+}
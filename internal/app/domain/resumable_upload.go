@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidUploadLength = errors.New("upload length must be positive")
+	ErrOffsetMismatch      = errors.New("upload offset does not match the current offset")
+	ErrUploadOverflow      = errors.New("chunk goes past the declared upload length")
+)
+
+const ResumableUploadTTL = 24 * time.Hour
+
+// UploadPart is a chunk of a resumable upload already written to storage
+type UploadPart struct {
+	Number int
+	ETag   string
+	Size   int64
+}
+
+// ResumableUpload is a tus upload received in chunks and assembled in storage
+type ResumableUpload struct {
+	ID          uuid.UUID
+	OwnerID     uuid.UUID
+	Length      int64
+	Offset      int64
+	Metadata    map[string]string // Upload-Metadata, e.g. filename and filetype
+	ObjectName  string            // Object the parts are assembled into
+	MultipartID string            // Storage multipart upload, empty until the first chunk
+	Parts       []UploadPart
+	ImageID     uuid.UUID // Set once the assembled file was registered as an image
+	ExpiresAt   time.Time
+	CreatedAt   time.Time
+}
+
+func NewResumableUpload(ownerID uuid.UUID, length int64, metadata map[string]string) (*ResumableUpload, error) {
+	if length <= 0 {
+		return nil, ErrInvalidUploadLength
+	}
+	if length > MaxImageSize {
+		return nil, ErrImageTooLarge
+	}
+
+	id := uuid.New()
+	now := time.Now()
+
+	return &ResumableUpload{
+		ID:         id,
+		OwnerID:    ownerID,
+		Length:     length,
+		Metadata:   metadata,
+		ObjectName: ResumableUploadPrefix + id.String(),
+		ExpiresAt:  now.Add(ResumableUploadTTL),
+		CreatedAt:  now,
+	}, nil
+}
+
+func (u *ResumableUpload) Expired() bool {
+	return time.Now().After(u.ExpiresAt)
+}
+
+func (u *ResumableUpload) Completed() bool {
+	return u.Offset == u.Length
+}
+
+// TailName is the object holding received bytes not yet large enough to become a part
+func (u *ResumableUpload) TailName() string {
+	return u.ObjectName + ".tail"
+}
+
+// TailSize is the number of received bytes not stored in a part yet
+func (u *ResumableUpload) TailSize() int64 {
+	size := u.Offset
+	for _, p := range u.Parts {
+		size -= p.Size
+	}
+	return size
+}
@@ -0,0 +1,199 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrRevisionNotFound = errors.New("post revision not found")
+
+// PostRevision is the content of a post as saved by one edit, revisions are never changed
+type PostRevision struct {
+	ID     uuid.UUID
+	PostID uuid.UUID
+	// Number counts the revisions of the post from 1, the repository assigns it
+	Number int
+	// EditorID is who saved the revision, uuid.Nil for scheduled publications, when unknown or
+	// once the account was deleted
+	EditorID uuid.UUID
+	Title    string
+	Slug     string
+	Body     string
+	Excerpt  string
+	Tags     []string // names
+	Status   PostStatus
+	// RestoredFrom is the number of the revision this one restored, 0 for regular edits
+	RestoredFrom int
+	CreatedAt    time.Time
+}
+
+// PostRevisionList is one page of the revisions of a post along with the number of revisions in all pages
+type PostRevisionList struct {
+	Revisions []*PostRevision
+	Total     int
+}
+
+// RevisionDiff compares the bodies of two revisions of a post
+type RevisionDiff struct {
+	From  *PostRevision
+	To    *PostRevision
+	Lines []DiffLine
+}
+
+// NewPostRevision records the current content of the post
+func NewPostRevision(post *Post, editorID uuid.UUID) *PostRevision {
+	tags := make([]string, len(post.Tags))
+	for i, tag := range post.Tags {
+		tags[i] = tag.Name
+	}
+	return &PostRevision{
+		ID:        uuid.New(),
+		PostID:    post.ID,
+		EditorID:  editorID,
+		Title:     post.Title,
+		Slug:      post.Slug,
+		Body:      post.Body,
+		Excerpt:   post.Excerpt,
+		Tags:      tags,
+		Status:    post.Status,
+		CreatedAt: time.Now(),
+	}
+}
+
+// Changes brings the content of the revision back. The slug and status are left as they are,
+// restoring old text must not break links or unpublish the post.
+func (r *PostRevision) Changes() PostChanges {
+	title, body, excerpt, tags := r.Title, r.Body, r.Excerpt, slices.Clone(r.Tags)
+	return PostChanges{Title: &title, Body: &body, Excerpt: &excerpt, Tags: &tags}
+}
+
+type DiffOp string
+
+const (
+	DiffEqual  DiffOp = "equal"
+	DiffInsert DiffOp = "insert" // only in the new text
+	DiffDelete DiffOp = "delete" // only in the old text
+)
+
+// DiffLine is a line of a diff. OldLine and NewLine number it from 1 in each text, 0 in the
+// text it is missing from.
+type DiffLine struct {
+	Op      DiffOp
+	Text    string
+	OldLine int
+	NewLine int
+}
+
+// maxDiffEdits bounds the search for the shortest diff, texts further apart are shown as
+// replaced wholesale
+const maxDiffEdits = 1000
+
+// DiffLines compares two texts line by line, a shortest edit script found with the algorithm of Myers
+func DiffLines(old, new string) []DiffLine {
+	a, b := splitLines(old), splitLines(new)
+	// Most edits touch a few lines, the common ends are kept out of the search
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	ops := slices.Repeat([]DiffOp{DiffEqual}, prefix)
+	ops = append(ops, editScript(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	ops = append(ops, slices.Repeat([]DiffOp{DiffEqual}, suffix)...)
+
+	lines := make([]DiffLine, len(ops))
+	i, j := 0, 0
+	for n, op := range ops {
+		switch op {
+		case DiffEqual:
+			lines[n] = DiffLine{Op: op, Text: a[i], OldLine: i + 1, NewLine: j + 1}
+			i, j = i+1, j+1
+		case DiffDelete:
+			lines[n] = DiffLine{Op: op, Text: a[i], OldLine: i + 1}
+			i++
+		case DiffInsert:
+			lines[n] = DiffLine{Op: op, Text: b[j], NewLine: j + 1}
+			j++
+		}
+	}
+	return lines
+}
+
+// editScript returns the operations turning a into b. trace[d] holds the furthest x reached
+// on each diagonal k = x - y in -d..d with d edits, at index k+d.
+func editScript(a, b []string) []DiffOp {
+	n, m := len(a), len(b)
+	var trace [][]int
+	end := -1
+	for d := 0; d <= min(n+m, maxDiffEdits) && end < 0; d++ {
+		v := make([]int, 2*d+1)
+		for k := -d; k <= d; k += 2 {
+			var x int
+			switch {
+			case d == 0:
+				x = 0
+			case k == -d || (k != d && trace[d-1][k-1+d-1] < trace[d-1][k+1+d-1]):
+				x = trace[d-1][k+1+d-1] // down from diagonal k+1, an insertion
+			default:
+				x = trace[d-1][k-1+d-1] + 1 // right from diagonal k-1, a deletion
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x, y = x+1, y+1
+			}
+			v[k+d] = x
+			if x >= n && y >= m {
+				end = d
+				break
+			}
+		}
+		trace = append(trace, v)
+	}
+	if end < 0 {
+		ops := slices.Repeat([]DiffOp{DiffDelete}, n)
+		return append(ops, slices.Repeat([]DiffOp{DiffInsert}, m)...)
+	}
+
+	// Walk back from the end, collecting the operations in reverse
+	var ops []DiffOp
+	x, y := n, m
+	for d := end; d > 0; d-- {
+		prev, k := trace[d-1], x-y
+		prevK := k - 1
+		if k == -d || (k != d && prev[k-1+d-1] < prev[k+1+d-1]) {
+			prevK = k + 1
+		}
+		prevX := prev[prevK+d-1]
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			ops = append(ops, DiffEqual)
+			x, y = x-1, y-1
+		}
+		if x == prevX {
+			ops = append(ops, DiffInsert)
+		} else {
+			ops = append(ops, DiffDelete)
+		}
+		x, y = prevX, prevY
+	}
+	ops = append(ops, slices.Repeat([]DiffOp{DiffEqual}, x)...)
+	slices.Reverse(ops)
+	return ops
+}
+
+// splitLines splits text at line breaks, an empty text has no lines
+func splitLines(text string) []string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
@@ -0,0 +1,62 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// render prints a diff like diff -u does, without headers
+func render(lines []domain.DiffLine) string {
+	prefixes := map[domain.DiffOp]string{domain.DiffEqual: " ", domain.DiffInsert: "+", domain.DiffDelete: "-"}
+	var b strings.Builder
+	for _, l := range lines {
+		b.WriteString(prefixes[l.Op] + l.Text + "\n")
+	}
+	return b.String()
+}
+
+func TestDiffLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		old, new string
+		want     string
+	}{
+		{"equal", "a\nb\n", "a\nb", " a\n b\n"},
+		{"empty", "", "", ""},
+		{"added", "", "a\nb", "+a\n+b\n"},
+		{"removed", "a\nb", "", "-a\n-b\n"},
+		{"changed line", "a\nb\nc", "a\nB\nc", " a\n-b\n+B\n c\n"},
+		{"crlf", "a\r\nb", "a\nb\nc", " a\n b\n+c\n"},
+		{"interleaved", "a\nb\nc\na\nb\nb\na", "c\nb\na\nb\na\nc", "-a\n-b\n c\n+b\n a\n b\n-b\n a\n+c\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, render(domain.DiffLines(tt.old, tt.new)))
+		})
+	}
+}
+
+func TestDiffLinesNumbers(t *testing.T) {
+	lines := domain.DiffLines("a\nb\nc", "a\nc\nd")
+	assert.Equal(t, []domain.DiffLine{
+		{Op: domain.DiffEqual, Text: "a", OldLine: 1, NewLine: 1},
+		{Op: domain.DiffDelete, Text: "b", OldLine: 2},
+		{Op: domain.DiffEqual, Text: "c", OldLine: 3, NewLine: 2},
+		{Op: domain.DiffInsert, Text: "d", NewLine: 3},
+	}, lines)
+}
+
+func TestDiffLinesFarApart(t *testing.T) {
+	var old, new []string
+	for i := range 1500 {
+		old = append(old, "old "+strings.Repeat("x", i%7))
+		new = append(new, "new "+strings.Repeat("y", i%5))
+	}
+	lines := domain.DiffLines(strings.Join(old, "\n"), strings.Join(new, "\n"))
+	assert.Len(t, lines, 3000)
+	assert.Equal(t, domain.DiffDelete, lines[0].Op)
+	assert.Equal(t, domain.DiffInsert, lines[2999].Op)
+}
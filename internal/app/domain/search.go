@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"errors"
+	"html"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var ErrInvalidSearchQuery = errors.New("search query needs a word of letters or digits and at most 200 characters")
+
+const (
+	MaxSearchQueryLength = 200 // characters
+	// MaxSearchTerms is how many words of a query are searched, the rest are ignored
+	MaxSearchTerms = 10
+)
+
+// Matches in the titles and snippets of search hits are between HighlightStart and HighlightStop.
+// They are private use characters, so they cannot clash with the text around them.
+const (
+	HighlightStart = "\ue000"
+	HighlightStop  = "\ue001"
+)
+
+// SearchQuery finds the published posts and public images with every term, each matching the start of a word
+type SearchQuery struct {
+	Terms  []string // lowercase letters and digits
+	Limit  int
+	Offset int
+}
+
+// NewSearchQuery splits the text typed by a visitor into terms, anything but letters and
+// digits separates them. Paging is normalized like PostFilter.
+func NewSearchQuery(q string, limit, offset int) (SearchQuery, error) {
+	if utf8.RuneCountInString(q) > MaxSearchQueryLength {
+		return SearchQuery{}, ErrInvalidSearchQuery
+	}
+	var terms []string
+	for _, word := range SearchWords(q) {
+		if len(terms) == MaxSearchTerms {
+			break
+		}
+		if !slices.Contains(terms, word) {
+			terms = append(terms, word)
+		}
+	}
+	if len(terms) == 0 {
+		return SearchQuery{}, ErrInvalidSearchQuery
+	}
+	page := PostFilter{Limit: limit, Offset: offset}.Normalize()
+	return SearchQuery{Terms: terms, Limit: page.Limit, Offset: page.Offset}, nil
+}
+
+// Matches tells whether a lowercase word starts with one of the terms
+func (q SearchQuery) Matches(word string) bool {
+	for _, term := range q.Terms {
+		if strings.HasPrefix(word, term) {
+			return true
+		}
+	}
+	return false
+}
+
+// SearchWords splits text in lowercase words of letters and digits
+func SearchWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// SearchHit is a post or an image matching a query, best matches come first
+type SearchHit struct {
+	// Either Post or Image is set
+	Post  *Post
+	Image *Image
+	// Title and Snippet are plain text with the matches highlighted. The title of an image is its
+	// caption, the snippet is cut from the body of a post or the alternative texts of an image.
+	Title   string
+	Snippet string
+}
+
+type SearchResults struct {
+	Hits  []SearchHit
+	Total int
+}
+
+// HighlightHTML escapes highlighted text and marks the matches with <mark>
+func HighlightHTML(text string) string {
+	text = html.EscapeString(text)
+	text = strings.ReplaceAll(text, HighlightStart, "<mark>")
+	return strings.ReplaceAll(text, HighlightStop, "</mark>")
+}
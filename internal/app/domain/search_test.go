@@ -0,0 +1,36 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSearchQuery(t *testing.T) {
+	query, err := domain.NewSearchQuery(`  Photo-walk in "Buenos Aires", photo & más!`, 0, -3)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"photo", "walk", "in", "buenos", "aires", "más"}, query.Terms)
+	assert.Equal(t, domain.DefaultPostsPage, query.Limit)
+	assert.Zero(t, query.Offset)
+
+	assert.True(t, query.Matches("photography"))
+	assert.False(t, query.Matches("telephoto"))
+
+	for _, q := range []string{"", " -- & ", strings.Repeat("a", domain.MaxSearchQueryLength+1)} {
+		_, err := domain.NewSearchQuery(q, 10, 0)
+		assert.ErrorIs(t, err, domain.ErrInvalidSearchQuery, q)
+	}
+
+	query, err = domain.NewSearchQuery(strings.Repeat("word ", 5)+"a b c d e f g h i j k", 500, 0)
+	require.NoError(t, err)
+	assert.Len(t, query.Terms, domain.MaxSearchTerms)
+	assert.Equal(t, domain.MaxPostsPage, query.Limit)
+}
+
+func TestHighlightHTML(t *testing.T) {
+	text := "Fish & " + domain.HighlightStart + "<Chips>" + domain.HighlightStop
+	assert.Equal(t, "Fish &amp; <mark>&lt;Chips&gt;</mark>", domain.HighlightHTML(text))
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrSitemapNotFound = errors.New("sitemap not found")
+
+// MaxSitemapURLs is the most URLs the sitemap protocol allows in one sitemap or sitemap index
+const MaxSitemapURLs = 50000
+
+// SitemapURL is a page of the site, or a sitemap when listed in a sitemap index
+type SitemapURL struct {
+	Loc     string
+	LastMod time.Time // zero when unknown, e.g. for static pages
+}
+
+// Sitemap tells search engines the pages of the site. A site with more pages than fit in one
+// sitemap gets a sitemap index instead, listing the sitemaps that hold them.
+type Sitemap struct {
+	URLs []SitemapURL
+	// Index lists sitemaps rather than pages, URLs is empty when it is set
+	Index []SitemapURL
+	// Modified is the latest LastMod, zero when none is known
+	Modified time.Time
+}
+
+func (s *Sitemap) IsIndex() bool {
+	return len(s.Index) > 0
+}
+
+// Robots is what robots.txt tells crawlers
+type Robots struct {
+	// Disallow are path prefixes crawlers should stay out of, "/" is the whole site
+	Disallow []string
+	// Sitemap is the absolute URL of the sitemap
+	Sitemap string
+}
+
+// NewSitemap splits the URLs in pages of at most size, page 1 being the first, size defaults to
+// MaxSitemapURLs. The sitemap of page 0 is the root: all URLs when they fit in one page, the index
+// of the pages otherwise. pageURL is where the sitemap of a page is served.
+func NewSitemap(urls []SitemapURL, size, page int, pageURL func(page int) string) (*Sitemap, error) {
+	if size <= 0 || size > MaxSitemapURLs {
+		size = MaxSitemapURLs
+	}
+	pages := max((len(urls)+size-1)/size, 1)
+	if page < 0 || page > pages {
+		return nil, ErrSitemapNotFound
+	}
+
+	if page == 0 && pages > 1 {
+		sitemap := &Sitemap{Index: make([]SitemapURL, pages)}
+		for i := range pages {
+			chunk := urls[i*size : min((i+1)*size, len(urls))]
+			sitemap.Index[i] = SitemapURL{Loc: pageURL(i + 1), LastMod: latestMod(chunk)}
+		}
+		sitemap.Modified = latestMod(sitemap.Index)
+		return sitemap, nil
+	}
+
+	page = max(page, 1)
+	chunk := urls[min((page-1)*size, len(urls)):min(page*size, len(urls))]
+	return &Sitemap{URLs: chunk, Modified: latestMod(chunk)}, nil
+}
+
+func latestMod(urls []SitemapURL) time.Time {
+	var latest time.Time
+	for _, u := range urls {
+		if u.LastMod.After(latest) {
+			latest = u.LastMod
+		}
+	}
+	return latest
+}
@@ -0,0 +1,59 @@
+package domain_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSitemap(t *testing.T) {
+	base := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	urls := []domain.SitemapURL{
+		{Loc: "https://example.com/"},
+		{Loc: "https://example.com/posts/a", LastMod: base.Add(2 * time.Hour)},
+		{Loc: "https://example.com/posts/b", LastMod: base},
+		{Loc: "https://example.com/albums/c", LastMod: base.Add(time.Hour)},
+		{Loc: "https://example.com/albums/d", LastMod: base.Add(3 * time.Hour)},
+	}
+	pageURL := func(page int) string { return "https://example.com/sitemap.xml?page=" + strconv.Itoa(page) }
+
+	t.Run("fits in one", func(t *testing.T) {
+		sitemap, err := domain.NewSitemap(urls, 0, 0, pageURL)
+		require.NoError(t, err)
+		assert.False(t, sitemap.IsIndex())
+		assert.Equal(t, urls, sitemap.URLs)
+		assert.Equal(t, base.Add(3*time.Hour), sitemap.Modified)
+
+		_, err = domain.NewSitemap(urls, 0, 2, pageURL)
+		assert.ErrorIs(t, err, domain.ErrSitemapNotFound)
+	})
+
+	t.Run("index", func(t *testing.T) {
+		index, err := domain.NewSitemap(urls, 2, 0, pageURL)
+		require.NoError(t, err)
+		require.True(t, index.IsIndex())
+		assert.Equal(t, []domain.SitemapURL{
+			{Loc: pageURL(1), LastMod: base.Add(2 * time.Hour)},
+			{Loc: pageURL(2), LastMod: base.Add(time.Hour)},
+			{Loc: pageURL(3), LastMod: base.Add(3 * time.Hour)},
+		}, index.Index)
+
+		last, err := domain.NewSitemap(urls, 2, 3, pageURL)
+		require.NoError(t, err)
+		assert.Equal(t, urls[4:], last.URLs)
+
+		_, err = domain.NewSitemap(urls, 2, 4, pageURL)
+		assert.ErrorIs(t, err, domain.ErrSitemapNotFound)
+	})
+
+	t.Run("empty site", func(t *testing.T) {
+		sitemap, err := domain.NewSitemap(nil, 0, 0, pageURL)
+		require.NoError(t, err)
+		assert.Empty(t, sitemap.URLs)
+		assert.True(t, sitemap.Modified.IsZero())
+	})
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+var (
+	ErrInvalidSlug = errors.New("slug must be lowercase letters, digits and single dashes")
+	ErrSlugTaken   = errors.New("slug is already in use")
+)
+
+const maxSlugLength = 80
+
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+
+// Slugify turns a title into a URL friendly slug, "Días de Playa!" becomes "dias-de-playa"
+func Slugify(title string) string {
+	var b strings.Builder
+	dash := false
+	// NFKD splits accented letters into the letter and a combining mark, the mark is dropped
+	for _, r := range norm.NFKD.String(strings.ToLower(title)) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			dash = false
+		default:
+			dash = true
+		}
+	}
+
+	slug := b.String()
+	if len(slug) > maxSlugLength {
+		slug = strings.TrimRight(slug[:maxSlugLength], "-")
+	}
+	return slug
+}
+
+// ValidateSlug accepts slugs as produced by Slugify
+func ValidateSlug(slug string) error {
+	if len(slug) > maxSlugLength || !slugPattern.MatchString(slug) {
+		return ErrInvalidSlug
+	}
+	return nil
+}
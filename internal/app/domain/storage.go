@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrChecksumMismatch = errors.New("copied object does not match the source checksum")
+
+// Prefixes of the objects that do not belong to an image record by name
+const (
+	CachePrefix           = "cache/"   // transformed variants, cache/{image id}/{transform key}
+	UploadPrefix          = "uploads/" // presigned uploads waiting to be completed
+	ResumableUploadPrefix = "tus/"     // resumable uploads and their buffered tails
+)
+
+// StagingTTL is how long an upload object can legitimately exist without an image, false if name is no upload.
+// Resumable uploads renew their tail on every chunk, so their age is the time since the last activity.
+func StagingTTL(name string) (time.Duration, bool) {
+	switch {
+	case strings.HasPrefix(name, UploadPrefix):
+		return UploadTTL, true
+	case strings.HasPrefix(name, ResumableUploadPrefix):
+		return ResumableUploadTTL, true
+	}
+	return 0, false
+}
+
+// CachedImageID returns the image a cached variant was made from, false if name is no cache object
+func CachedImageID(name string) (uuid.UUID, bool) {
+	rest, ok := strings.CutPrefix(name, CachePrefix)
+	if !ok {
+		return uuid.Nil, false
+	}
+	dir, _, _ := strings.Cut(rest, "/")
+	id, err := uuid.Parse(dir)
+	return id, err == nil
+}
+
+// MigrationOptions controls a copy of the stored objects to another storage backend
+type MigrationOptions struct {
+	// Prefix limits the migration to objects whose name starts with it
+	Prefix string
+	// StartAfter skips every object up to and including this name, objects are visited in lexical order
+	StartAfter string
+	// DryRun compares source and destination without writing anything
+	DryRun bool
+	// RewriteURLs points the image and blob records at the destination
+	RewriteURLs bool
+}
+
+// MigrationReport is what a migration did so far
+type MigrationReport struct {
+	// Last is the last object visited, pass it as StartAfter to resume
+	Last   string
+	Copied int // would be copied on a dry run
+	// Skipped objects were already at the destination with the same checksum
+	Skipped       int
+	Bytes         int64
+	URLsRewritten int
+	Failures      []ObjectFailure
+}
+
+// RelocationReport is what moving the legacy image files under their visibility prefix did
+type RelocationReport struct {
+	Moved    int // Images, a file shared by several counts once for each
+	Failures []ObjectFailure
+}
+
+// ObjectFailure is an object a maintenance job gave up on, the job moves on to the next one
+type ObjectFailure struct {
+	Name string
+	Err  error
+}
+
+// GCOptions controls a garbage collection run over the stored objects
+type GCOptions struct {
+	// GracePeriod spares recent orphans, their image record may still be on its way
+	GracePeriod time.Duration
+	// DryRun reports without deleting anything, expired upload records included
+	DryRun bool
+}
+
+// GCReport is what a garbage collection run found and did
+type GCReport struct {
+	Scanned int
+	// Orphans are objects no image, blob or upload refers to
+	Orphans     []string
+	OrphanBytes int64
+	// Deleted orphans were older than the grace period
+	Deleted      int
+	DeletedBytes int64
+	// Missing objects are referred to by an image or blob but absent from storage
+	Missing []string
+	// ExpiredUploads counts the removed upload and resumable upload records
+	ExpiredUploads int
+	Failures       []ObjectFailure
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	ErrTagNotFound  = errors.New("tag not found")
+	ErrInvalidTag   = errors.New("tag names must have a letter or digit and at most 50 characters")
+	ErrTooManyTags  = errors.New("too many tags")
+	ErrTagExists    = errors.New("another tag already has this name, merge them instead")
+	ErrInvalidMerge = errors.New("merge needs at least one tag besides the target")
+)
+
+const (
+	MaxTagLength = 50 // characters
+	MaxTags      = 20 // per post or image
+)
+
+// Tag labels posts and images. The slug identifies it, the name is how it is displayed.
+type Tag struct {
+	Slug string
+	Name string
+}
+
+// TagCount is a tag with how much public content carries it, for tag clouds
+type TagCount struct {
+	Tag
+	Posts  int // published posts
+	Images int // public images
+}
+
+func NewTag(name string) (Tag, error) {
+	name = strings.Join(strings.Fields(name), " ")
+	slug := Slugify(name)
+	if slug == "" || utf8.RuneCountInString(name) > MaxTagLength {
+		return Tag{}, ErrInvalidTag
+	}
+	return Tag{Slug: slug, Name: name}, nil
+}
+
+// NewTags parses a list of tag names, names with the same slug count once and the first spelling wins.
+// The tags are sorted by slug.
+func NewTags(names []string) ([]Tag, error) {
+	tags := make([]Tag, 0, len(names))
+	for _, name := range names {
+		tag, err := NewTag(name)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.ContainsFunc(tags, func(t Tag) bool { return t.Slug == tag.Slug }) {
+			tags = append(tags, tag)
+		}
+	}
+	if len(tags) > MaxTags {
+		return nil, ErrTooManyTags
+	}
+	slices.SortFunc(tags, func(a, b Tag) int { return strings.Compare(a.Slug, b.Slug) })
+	return tags, nil
+}
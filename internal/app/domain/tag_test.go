@@ -0,0 +1,29 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTags(t *testing.T) {
+	tags, err := domain.NewTags([]string{" Go ", "Photography", "go", "Año  Nuevo"})
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Tag{
+		{Slug: "ano-nuevo", Name: "Año Nuevo"},
+		{Slug: "go", Name: "Go"},
+		{Slug: "photography", Name: "Photography"},
+	}, tags)
+
+	_, err = domain.NewTags([]string{"!!"})
+	assert.ErrorIs(t, err, domain.ErrInvalidTag)
+
+	many := make([]string, domain.MaxTags+1)
+	for i := range many {
+		many[i] = string(rune('a'+i%26)) + string(rune('a'+i/26))
+	}
+	_, err = domain.NewTags(many)
+	assert.ErrorIs(t, err, domain.ErrTooManyTags)
+}
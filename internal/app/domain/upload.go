@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidChecksum   = errors.New("checksum must be a hex encoded sha256")
+	ErrUploadNotFound    = errors.New("upload not found")
+	ErrUploadExpired     = errors.New("upload has expired")
+	ErrUploadNotReceived = errors.New("file has not been uploaded yet")
+	ErrUploadMismatch    = errors.New("uploaded file does not match the declared size or checksum")
+)
+
+const UploadTTL = 15 * time.Minute
+
+// Upload is a presigned direct-to-storage upload waiting to be completed
+type Upload struct {
+	ID           uuid.UUID
+	OwnerID      uuid.UUID
+	OriginalName string
+	ObjectName   string // Staging object the client PUTs to
+	ContentType  string
+	Size         int64
+	Checksum     string // Hex encoded SHA-256 of the file
+	ExpiresAt    time.Time
+	CreatedAt    time.Time
+}
+
+func NewUpload(ownerID uuid.UUID, originalName, contentType string, size int64, checksum string) (*Upload, error) {
+	if size <= 0 || size > MaxImageSize {
+		return nil, ErrImageTooLarge
+	}
+
+	ext, ok := extensionsByContentType[contentType]
+	if !ok {
+		return nil, ErrInvalidFormat
+	}
+
+	checksum = strings.ToLower(checksum)
+	if sum, err := hex.DecodeString(checksum); err != nil || len(sum) != 32 {
+		return nil, ErrInvalidChecksum
+	}
+
+	id := uuid.New()
+	now := time.Now()
+
+	return &Upload{
+		ID:           id,
+		OwnerID:      ownerID,
+		OriginalName: originalName,
+		ObjectName:   UploadPrefix + id.String() + ext,
+		ContentType:  contentType,
+		Size:         size,
+		Checksum:     checksum,
+		ExpiresAt:    now.Add(UploadTTL),
+		CreatedAt:    now,
+	}, nil
+}
+
+func (u *Upload) Expired() bool {
+	return time.Now().After(u.ExpiresAt)
+}
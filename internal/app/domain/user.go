@@ -2,6 +2,8 @@ package domain
 
 import (
 	"errors"
+	"net/mail"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,6 +13,7 @@ import (
 var (
 	ErrInvalidEmail = errors.New("invalid email format")
 	ErrPasswordWeak = errors.New("password must be at least 8 characters")
+	ErrUserNotFound = errors.New("user not found")
 )
 
 type UserRole string
@@ -25,6 +28,7 @@ type User struct {
 	Email        string
 	PasswordHash string
 	Role         UserRole
+	Limits       UploadLimits // Overrides the upload limits of the role, zero values inherit them
 	CreatedAt    time.Time
 }
 
@@ -51,6 +55,16 @@ func NewUser(email, password string, role UserRole) (*User, error) {
 	}, nil
 }
 
+// NormalizeEmail checks a bare address such as ana@example.com and lowercases its domain
+func NormalizeEmail(email string) (string, error) {
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email || addr.Name != "" {
+		return "", ErrInvalidEmail
+	}
+	local, host, _ := strings.Cut(addr.Address, "@")
+	return local + "@" + strings.ToLower(host), nil
+}
+
 func (u *User) CheckPassword(password string) bool {
 	err := bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password))
 	return err == nil
@@ -0,0 +1,20 @@
+package inports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+type AlbumService interface {
+	CreateAlbum(ctx context.Context, ownerID uuid.UUID, title, slug, description string, visibility domain.Visibility) (*domain.Album, error)
+	ListAlbums(ctx context.Context, ownerID uuid.UUID) ([]*domain.Album, error)
+	GetAlbum(ctx context.Context, id, userID uuid.UUID, role domain.UserRole) (*domain.Album, error)
+	UpdateAlbum(ctx context.Context, id, userID uuid.UUID, role domain.UserRole, changes domain.AlbumChanges) (*domain.Album, error)
+	DeleteAlbum(ctx context.Context, id, userID uuid.UUID, role domain.UserRole) error
+	AddImages(ctx context.Context, id, userID uuid.UUID, role domain.UserRole, imageIDs []uuid.UUID) (*domain.Album, error)
+	RemoveImage(ctx context.Context, id, imageID, userID uuid.UUID, role domain.UserRole) (*domain.Album, error)
+	ReorderImages(ctx context.Context, id, userID uuid.UUID, role domain.UserRole, imageIDs []uuid.UUID) (*domain.Album, error)
+	GetPublicAlbum(ctx context.Context, slug string) (*domain.AlbumPage, error)
+}
@@ -0,0 +1,20 @@
+package inports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+type CommentService interface {
+	// ListComments returns the approved comments of a public post, threaded
+	ListComments(ctx context.Context, slug string) ([]*domain.CommentThread, error)
+	// AddComment comments on a published post, or replies to one of its comments when parentID
+	// is set. Comments wait for moderation unless an admin wrote them. A filled honeypot files
+	// the comment as spam, which callers should not reveal.
+	AddComment(ctx context.Context, slug string, parentID uuid.UUID, author domain.CommentAuthor, body, honeypot string) (*domain.Comment, error)
+	// ListModerationQueue returns comments of every post, newest first
+	ListModerationQueue(ctx context.Context, filter domain.CommentFilter) (*domain.CommentList, error)
+	ModerateComment(ctx context.Context, id uuid.UUID, status domain.CommentStatus) (*domain.Comment, error)
+}
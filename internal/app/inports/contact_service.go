@@ -0,0 +1,18 @@
+package inports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+type ContactService interface {
+	// Submit stores a message of the contact form and forwards it by email. captchaToken is
+	// checked with the captcha provider. A filled honeypot drops the message without an error,
+	// which callers should not reveal.
+	Submit(ctx context.Context, form domain.ContactForm, captchaToken, remoteIP, honeypot string) error
+	// ListMessages returns the inbox, newest first
+	ListMessages(ctx context.Context, limit, offset int) (*domain.ContactMessageList, error)
+	DeleteMessage(ctx context.Context, id uuid.UUID) error
+}
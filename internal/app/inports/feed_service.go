@@ -0,0 +1,12 @@
+package inports
+
+import (
+	"context"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+type FeedService interface {
+	// Feed returns the latest published posts, only those with the tag of the slug unless it is empty
+	Feed(ctx context.Context, tag string) (*domain.Feed, error)
+}
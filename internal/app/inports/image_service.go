@@ -3,10 +3,25 @@ package inports
 import (
 	"context"
 	"io"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
 	"github.com/llascola/web-backend/internal/app/outports"
 )
 
 type ImageService interface {
-	UploadImage(ctx context.Context, file io.Reader, meta outports.FileMetadata) (string, error)
+	UploadImage(ctx context.Context, file io.Reader, meta outports.FileMetadata, opts domain.UploadOptions) (*domain.Image, error)
+	CreateUpload(ctx context.Context, meta outports.FileMetadata, checksum string, ownerID uuid.UUID, role domain.UserRole) (*domain.Upload, string, error)
+	// CheckUpload rejects an announced upload the limits of the user do not allow before any byte arrives,
+	// an empty content type is not checked. The received image is checked again.
+	CheckUpload(ctx context.Context, contentType string, size int64, userID uuid.UUID, role domain.UserRole) error
+	CompleteUpload(ctx context.Context, uploadID uuid.UUID, opts domain.UploadOptions) (*domain.Image, error)
+	GetImage(ctx context.Context, id, userID uuid.UUID, role domain.UserRole) (*domain.Image, error)
+	UpdateImage(ctx context.Context, id, userID uuid.UUID, role domain.UserRole, changes domain.ImageDescriptionChanges) (*domain.Image, error)
+	ImageURL(ctx context.Context, id, userID uuid.UUID, role domain.UserRole) (string, time.Time, error)
+	OpenImage(ctx context.Context, id, userID uuid.UUID, role domain.UserRole) (io.ReadCloser, outports.FileMetadata, error)
+	TransformImage(ctx context.Context, id uuid.UUID, t domain.ImageTransform, signature string) (io.ReadCloser, outports.FileMetadata, error)
+	DeleteImage(ctx context.Context, id, userID uuid.UUID, role domain.UserRole) error
+	Quota(ctx context.Context, userID uuid.UUID) (*domain.Quota, error)
 }
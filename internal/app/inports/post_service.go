@@ -0,0 +1,34 @@
+package inports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+type PostService interface {
+	CreatePost(ctx context.Context, authorID uuid.UUID, title, slug, body, excerpt string, status domain.PostStatus, publishAt time.Time, tags []string) (*domain.Post, error)
+	ListPosts(ctx context.Context, filter domain.PostFilter) (*domain.PostList, error)
+	GetPost(ctx context.Context, id uuid.UUID) (*domain.Post, error)
+	// UpdatePost records the edit as a new revision saved by editorID
+	UpdatePost(ctx context.Context, id, editorID uuid.UUID, changes domain.PostChanges) (*domain.Post, error)
+	DeletePost(ctx context.Context, id uuid.UUID) error
+	// PreviewPost returns a link showing the post whatever its status
+	PreviewPost(ctx context.Context, id uuid.UUID) (*domain.PostPreview, error)
+	// ListRevisions returns the revisions of a post, newest first
+	ListRevisions(ctx context.Context, id uuid.UUID, limit, offset int) (*domain.PostRevisionList, error)
+	GetRevision(ctx context.Context, id uuid.UUID, number int) (*domain.PostRevision, error)
+	// DiffRevisions compares the bodies of two revisions line by line
+	DiffRevisions(ctx context.Context, id uuid.UUID, from, to int) (*domain.RevisionDiff, error)
+	// RestoreRevision brings back the content of an old revision as a new one, see PostRevision.Changes
+	RestoreRevision(ctx context.Context, id, editorID uuid.UUID, number int) (*domain.Post, error)
+	// PublishDue publishes the scheduled posts whose time has come and returns how many
+	PublishDue(ctx context.Context) (int, error)
+	// ListPublishedPosts and GetPublishedPost are for visitors, they never see drafts.
+	// GetPublishedPost also returns archived posts, and any post with a valid preview token.
+	// A post found by one of its old slugs carries its current slug.
+	ListPublishedPosts(ctx context.Context, filter domain.PostFilter) (*domain.PostList, error)
+	GetPublishedPost(ctx context.Context, slug, previewToken string) (*domain.Post, error)
+}
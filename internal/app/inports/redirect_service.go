@@ -0,0 +1,19 @@
+package inports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+type RedirectService interface {
+	// ListRedirects returns the redirect table ordered by path
+	ListRedirects(ctx context.Context, limit, offset int) (*domain.RedirectList, error)
+	// CreateRedirect sends from to to with the status code, 301 when code is 0
+	CreateRedirect(ctx context.Context, from, to string, code int) (*domain.Redirect, error)
+	DeleteRedirect(ctx context.Context, id uuid.UUID) error
+	// Resolve returns the redirect of a request path, nil when it is not redirected.
+	// The table is cached, changes made by other instances show after a while.
+	Resolve(ctx context.Context, path string) (*domain.Redirect, error)
+}
@@ -0,0 +1,18 @@
+package inports
+
+import (
+	"context"
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+type ResumableUploadService interface {
+	// Create fails if the limits of the owner do not allow length bytes or the filetype in metadata
+	Create(ctx context.Context, ownerID uuid.UUID, role domain.UserRole, length int64, metadata map[string]string) (*domain.ResumableUpload, error)
+	Get(ctx context.Context, id, ownerID uuid.UUID) (*domain.ResumableUpload, error)
+	// Append stores chunk at offset. The final chunk registers the assembled file as an image.
+	Append(ctx context.Context, id uuid.UUID, offset int64, chunk io.Reader, opts domain.UploadOptions) (*domain.ResumableUpload, error)
+	Terminate(ctx context.Context, id, ownerID uuid.UUID) error
+}
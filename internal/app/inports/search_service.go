@@ -0,0 +1,12 @@
+package inports
+
+import (
+	"context"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+type SearchService interface {
+	// Search returns a page of the published posts and public images with every word of q, words match by prefix
+	Search(ctx context.Context, q string, limit, offset int) (*domain.SearchResults, error)
+}
@@ -0,0 +1,15 @@
+package inports
+
+import (
+	"context"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+type SitemapService interface {
+	// Sitemap returns the root sitemap for page 0, which is a sitemap index once the site outgrew
+	// one sitemap, and the sitemaps listed in the index from page 1. Pages past the last one
+	// return ErrSitemapNotFound.
+	Sitemap(ctx context.Context, page int) (*domain.Sitemap, error)
+	Robots() domain.Robots
+}
@@ -0,0 +1,21 @@
+package inports
+
+import (
+	"context"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+// StorageService runs maintenance jobs over the stored objects
+type StorageService interface {
+	// Migrate copies every object to dest, verifying checksums. Objects already at dest are skipped,
+	// so an interrupted migration can simply be run again. progress is called after each object.
+	Migrate(ctx context.Context, dest outports.ObjectStore, opts domain.MigrationOptions, progress func(domain.MigrationReport)) (*domain.MigrationReport, error)
+	// CollectGarbage reports the objects nothing refers to and the referred objects that are missing,
+	// deleting orphans older than the grace period along with expired upload records
+	CollectGarbage(ctx context.Context, opts domain.GCOptions) (*domain.GCReport, error)
+	// RelocateLegacyImages moves the files of images stored at the bucket root under their visibility
+	// prefix and points the records at them. Moved images are not visited again.
+	RelocateLegacyImages(ctx context.Context) (*domain.RelocationReport, error)
+}
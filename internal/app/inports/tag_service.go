@@ -0,0 +1,15 @@
+package inports
+
+import (
+	"context"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+type TagService interface {
+	// ListTags returns the tags by name, without the unused ones unless includeUnused
+	ListTags(ctx context.Context, includeUnused bool) ([]domain.TagCount, error)
+	RenameTag(ctx context.Context, slug, name string) (*domain.Tag, error)
+	// MergeTags moves everything tagged with the sources to the target and deletes the sources
+	MergeTags(ctx context.Context, sources []string, target string) error
+}
@@ -10,4 +10,5 @@ import (
 type UserService interface {
 	GetProfile(ctx context.Context, userID uuid.UUID) (*domain.User, error)
 	DeleteUser(ctx context.Context, userID uuid.UUID) error
+	SetUploadLimits(ctx context.Context, userID uuid.UUID, limits domain.UploadLimits) (*domain.User, error)
 }
@@ -0,0 +1,22 @@
+package outports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+type AlbumRepository interface {
+	// Save and Edit return ErrSlugTaken when another album uses the slug
+	Save(ctx context.Context, album *domain.Album) error
+	// Edit passes the album to edit and saves it once edit returns, nothing is saved if edit fails.
+	// No other edit of the album runs in between. ErrAlbumNotFound if the album does not exist.
+	Edit(ctx context.Context, id uuid.UUID, edit func(album *domain.Album) error) (*domain.Album, error)
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.Album, error)
+	FindBySlug(ctx context.Context, slug string) (*domain.Album, error)
+	FindByOwner(ctx context.Context, ownerID uuid.UUID) ([]*domain.Album, error)
+	// FindPublic returns the public albums, most recently updated first
+	FindPublic(ctx context.Context) ([]*domain.Album, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
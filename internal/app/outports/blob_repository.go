@@ -0,0 +1,23 @@
+package outports
+
+import (
+	"context"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+// BlobRepository keeps the reference counts of stored files shared between images
+type BlobRepository interface {
+	// Create registers a new blob, ErrBlobExists if another upload registered it first
+	Create(ctx context.Context, blob *domain.Blob) error
+	// FindAll returns every blob, for maintenance jobs
+	FindAll(ctx context.Context) ([]*domain.Blob, error)
+	// Acquire adds a reference to an existing blob and returns it
+	Acquire(ctx context.Context, name string) (*domain.Blob, error)
+	// UpdateURL changes where a blob is served from, ErrBlobNotFound if it is not registered
+	UpdateURL(ctx context.Context, name, url string) error
+	// Release drops a reference and returns how many are left. At zero the record is removed and
+	// deleteFile runs while the record is still locked, so an upload of the same file waits for it
+	// instead of storing its copy right before the deletion. The record is removed even if deleteFile fails.
+	Release(ctx context.Context, name string, deleteFile func() error) (int, error)
+}
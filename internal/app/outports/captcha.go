@@ -0,0 +1,11 @@
+package outports
+
+import (
+	"context"
+)
+
+type CaptchaVerifier interface {
+	// Verify checks the token a widget handed to the visitor, domain.ErrCaptchaFailed when
+	// the challenge was not solved. remoteIP is the address of the visitor, empty if unknown.
+	Verify(ctx context.Context, token, remoteIP string) error
+}
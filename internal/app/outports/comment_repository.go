@@ -0,0 +1,18 @@
+package outports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+type CommentRepository interface {
+	Save(ctx context.Context, comment *domain.Comment) error
+	Update(ctx context.Context, comment *domain.Comment) error
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.Comment, error)
+	// FindByPost returns every comment of the post whatever its status, oldest first
+	FindByPost(ctx context.Context, postID uuid.UUID) ([]*domain.Comment, error)
+	// List returns a page of comments of all posts, newest first
+	List(ctx context.Context, filter domain.CommentFilter) (*domain.CommentList, error)
+}
@@ -0,0 +1,15 @@
+package outports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+type ContactRepository interface {
+	Save(ctx context.Context, message *domain.ContactMessage) error
+	// List returns a page of messages, newest first
+	List(ctx context.Context, limit, offset int) (*domain.ContactMessageList, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
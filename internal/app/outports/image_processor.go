@@ -0,0 +1,21 @@
+package outports
+
+import (
+	"context"
+	"io"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+type ImageProcessor interface {
+	// Inspect sniffs the real format from the magic bytes and fully decodes the image.
+	// It fails for unknown formats, images above domain.MaxImagePixels and polyglot files.
+	Inspect(ctx context.Context, data []byte) (domain.ImageInfo, error)
+	// StripMetadata rotates the pixels according to the EXIF orientation and drops EXIF, XMP and ICC data.
+	// With keepCopyright the copyright and author fields are preserved.
+	StripMetadata(ctx context.Context, data []byte, keepCopyright bool) ([]byte, error)
+	// Preview computes the final dimensions and the placeholders shown while the image loads
+	Preview(ctx context.Context, data []byte) (domain.ImagePreview, error)
+	// Transform decodes src, applies the transformation and encodes the result into dst
+	Transform(ctx context.Context, dst io.Writer, src io.Reader, t domain.ImageTransform) error
+}
@@ -0,0 +1,26 @@
+package outports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+type ImageRepository interface {
+	Save(ctx context.Context, image *domain.Image) error
+	// Update saves the editable fields, ErrImageNotFound if the image does not exist
+	Update(ctx context.Context, image *domain.Image) error
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.Image, error)
+	// FindByIDs skips images that do not exist, the result is in no particular order
+	FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Image, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	// FindAll returns every image without its tags, for maintenance jobs
+	FindAll(ctx context.Context) ([]*domain.Image, error)
+	// UpdateURL points every image with the visibility stored as storedName at url and returns how many changed
+	UpdateURL(ctx context.Context, storedName string, visibility domain.Visibility, url string) (int, error)
+	// Rename points every image with the visibility stored as oldName at newName and url, and returns how many changed
+	Rename(ctx context.Context, oldName string, visibility domain.Visibility, newName, url string) (int, error)
+	// Usage sums the images of an owner, duplicates count once per image
+	Usage(ctx context.Context, ownerID uuid.UUID) (domain.StorageUsage, error)
+}
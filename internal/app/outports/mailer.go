@@ -0,0 +1,11 @@
+package outports
+
+import (
+	"context"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+type Mailer interface {
+	Send(ctx context.Context, email domain.Email) error
+}
@@ -0,0 +1,8 @@
+package outports
+
+import "github.com/llascola/web-backend/internal/app/domain"
+
+type MarkdownRenderer interface {
+	// Render converts Markdown to HTML stripped of anything that could run script in a visitor's browser
+	Render(source string) (domain.RenderedMarkdown, error)
+}
@@ -0,0 +1,26 @@
+package outports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+type PostRepository interface {
+	// Save and Update return ErrSlugTaken when another post uses the slug
+	Save(ctx context.Context, post *domain.Post) error
+	// Update keeps the slug the post had when it changes, see FindByOldSlug
+	Update(ctx context.Context, post *domain.Post) error
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.Post, error)
+	FindBySlug(ctx context.Context, slug string) (*domain.Post, error)
+	// FindByOldSlug returns the last post that was renamed away from the slug
+	FindByOldSlug(ctx context.Context, slug string) (*domain.Post, error)
+	// List returns a page of the matching posts, unpublished ones first and then the most
+	// recently published, ties by last update
+	List(ctx context.Context, filter domain.PostFilter) (*domain.PostList, error)
+	// FindDue returns the scheduled posts whose publish time is not after now
+	FindDue(ctx context.Context, now time.Time) ([]*domain.Post, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,20 @@
+package outports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+type RedirectRepository interface {
+	// Save returns ErrRedirectExists when the path is already redirected
+	Save(ctx context.Context, redirect *domain.Redirect) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	// GetByPath returns ErrRedirectNotFound when the path is not redirected
+	GetByPath(ctx context.Context, path string) (*domain.Redirect, error)
+	// List returns a page of the redirect table, ordered by path
+	List(ctx context.Context, limit, offset int) (*domain.RedirectList, error)
+	// All returns the whole table, for the cache redirects are served from
+	All(ctx context.Context) ([]*domain.Redirect, error)
+}
@@ -0,0 +1,21 @@
+package outports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+type ResumableUploadRepository interface {
+	Save(ctx context.Context, upload *domain.ResumableUpload) error
+	Update(ctx context.Context, upload *domain.ResumableUpload) error
+	// Edit passes the upload to edit and saves it once edit returns, nothing is saved if edit fails.
+	// No other edit of the upload runs in between. ErrUploadNotFound if the upload does not exist.
+	Edit(ctx context.Context, id uuid.UUID, edit func(upload *domain.ResumableUpload) error) (*domain.ResumableUpload, error)
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.ResumableUpload, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	// FindExpired returns the uploads that expired before t
+	FindExpired(ctx context.Context, t time.Time) ([]*domain.ResumableUpload, error)
+}
@@ -0,0 +1,20 @@
+package outports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+type PostRevisionRepository interface {
+	// Save numbers the revision after the last one of its post
+	Save(ctx context.Context, revision *domain.PostRevision) error
+	// Edit passes the post and how many revisions it has to edit, then saves the edited post and the
+	// revisions edit returns, in order, all at once. No other edit of the post runs in between.
+	// ErrPostNotFound if the post does not exist, ErrSlugTaken like PostRepository.Update.
+	Edit(ctx context.Context, postID uuid.UUID, edit func(post *domain.Post, revisions int) ([]*domain.PostRevision, error)) (*domain.Post, error)
+	// List returns a page of the revisions of a post, newest first
+	List(ctx context.Context, postID uuid.UUID, limit, offset int) (*domain.PostRevisionList, error)
+	FindByNumber(ctx context.Context, postID uuid.UUID, number int) (*domain.PostRevision, error)
+}
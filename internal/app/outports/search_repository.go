@@ -0,0 +1,12 @@
+package outports
+
+import (
+	"context"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+type SearchRepository interface {
+	// Search returns the page of published posts and public images matching the query, best matches first
+	Search(ctx context.Context, query domain.SearchQuery) (*domain.SearchResults, error)
+}
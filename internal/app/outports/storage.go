@@ -2,16 +2,62 @@ package outports
 
 import (
 	"context"
+	"errors"
 	"io"
+	"time"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+var (
+	ErrFileNotFound      = errors.New("file not found")
+	ErrInvalidObjectName = errors.New("invalid object name")
 )
 
+// MultipartMinPartSize is the smallest part accepted by S3 compatible storage, except for the last one
+const MultipartMinPartSize = 5 * 1024 * 1024
+
 type FileMetadata struct {
 	Name        string
 	Size        int64
 	ContentType string
+	ETag        string
 }
 
 type FileStorageRepository interface {
 	// Returns the public URL of the uploaded file
 	Save(ctx context.Context, file io.Reader, meta FileMetadata) (string, error)
+	// Open returns the file contents, or ErrFileNotFound if it does not exist
+	Open(ctx context.Context, name string) (io.ReadCloser, FileMetadata, error)
+	Delete(ctx context.Context, name string) error
+	// PresignPut returns a URL the client can PUT the file to directly, valid for expiry
+	PresignPut(ctx context.Context, name string, expiry time.Duration) (string, error)
+	// PresignGet returns a URL the client can download a non public file from, valid for expiry
+	PresignGet(ctx context.Context, name string, expiry time.Duration) (string, error)
+}
+
+// MultipartStorage assembles an object from parts uploaded separately
+type MultipartStorage interface {
+	CreateMultipart(ctx context.Context, name, contentType string) (string, error)
+	UploadPart(ctx context.Context, name, multipartID string, number int, part io.Reader, size int64) (domain.UploadPart, error)
+	CompleteMultipart(ctx context.Context, name, multipartID string, parts []domain.UploadPart) error
+	AbortMultipart(ctx context.Context, name, multipartID string) error
+}
+
+// ObjectInfo describes a stored object as it is listed
+type ObjectInfo struct {
+	Name         string
+	Size         int64
+	LastModified time.Time
+}
+
+// ObjectStore is a storage adapter that can enumerate its objects, maintenance jobs work on it
+type ObjectStore interface {
+	FileStorageRepository
+	// List calls fn for every object whose name starts with prefix, in lexical order and after startAfter
+	// when it is set. Staging data such as unfinished multipart uploads is not listed. An error returned
+	// by fn stops the listing and is returned.
+	List(ctx context.Context, prefix, startAfter string, fn func(ObjectInfo) error) error
+	// URL is the URL Save returns for name
+	URL(name string) string
 }
@@ -0,0 +1,19 @@
+package outports
+
+import (
+	"context"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+// TagRepository manages tags as a whole, posts and images set their own tags when saved
+type TagRepository interface {
+	FindBySlug(ctx context.Context, slug string) (domain.Tag, error)
+	// Counts returns every tag with its published posts and public images, by name
+	Counts(ctx context.Context) ([]domain.TagCount, error)
+	// Rename gives the tag with the slug a new name and slug, ErrTagExists when another tag has that slug
+	Rename(ctx context.Context, slug string, tag domain.Tag) error
+	// Merge moves the posts and images of the source tags to the target and deletes the sources.
+	// Either every reference moves or none does.
+	Merge(ctx context.Context, sources []string, target string) error
+}
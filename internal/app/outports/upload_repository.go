@@ -0,0 +1,17 @@
+package outports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+)
+
+type UploadRepository interface {
+	Save(ctx context.Context, upload *domain.Upload) error
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.Upload, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	// DeleteExpired removes the uploads that expired before t and returns how many
+	DeleteExpired(ctx context.Context, t time.Time) (int, error)
+}
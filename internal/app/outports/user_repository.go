@@ -9,6 +9,7 @@ import (
 
 type UserRepository interface {
 	Save(ctx context.Context, user *domain.User) error
+	Update(ctx context.Context, user *domain.User) error
 	FindByEmail(ctx context.Context, email string) (*domain.User, error)
 	FindByID(ctx context.Context, id uuid.UUID) (*domain.User, error)
 	Delete(ctx context.Context, id uuid.UUID) error
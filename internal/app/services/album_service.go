@@ -0,0 +1,160 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/inports"
+	"github.com/llascola/web-backend/internal/app/outports"
+	"github.com/llascola/web-backend/internal/config"
+)
+
+type AlbumServiceImpl struct {
+	albumRepo outports.AlbumRepository
+	imageRepo outports.ImageRepository
+	cfg       config.ImageConfig
+}
+
+var _ inports.AlbumService = (*AlbumServiceImpl)(nil)
+
+func NewAlbumService(albumRepo outports.AlbumRepository, imageRepo outports.ImageRepository, cfg config.ImageConfig) *AlbumServiceImpl {
+	return &AlbumServiceImpl{
+		albumRepo: albumRepo,
+		imageRepo: imageRepo,
+		cfg:       cfg,
+	}
+}
+
+func (s *AlbumServiceImpl) CreateAlbum(ctx context.Context, ownerID uuid.UUID, title, slug, description string, visibility domain.Visibility) (*domain.Album, error) {
+	album, err := domain.NewAlbum(ownerID, title, slug, description, visibility)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.albumRepo.Save(ctx, album); err != nil {
+		return nil, err
+	}
+	return album, nil
+}
+
+func (s *AlbumServiceImpl) ListAlbums(ctx context.Context, ownerID uuid.UUID) ([]*domain.Album, error) {
+	return s.albumRepo.FindByOwner(ctx, ownerID)
+}
+
+func (s *AlbumServiceImpl) GetAlbum(ctx context.Context, id, userID uuid.UUID, role domain.UserRole) (*domain.Album, error) {
+	return s.findModifiable(ctx, id, userID, role)
+}
+
+func (s *AlbumServiceImpl) UpdateAlbum(ctx context.Context, id, userID uuid.UUID, role domain.UserRole, changes domain.AlbumChanges) (*domain.Album, error) {
+	return s.update(ctx, id, userID, role, func(album *domain.Album) error {
+		return album.Apply(changes)
+	})
+}
+
+func (s *AlbumServiceImpl) DeleteAlbum(ctx context.Context, id, userID uuid.UUID, role domain.UserRole) error {
+	album, err := s.findModifiable(ctx, id, userID, role)
+	if err != nil {
+		return err
+	}
+	// Images are left alone, they may be used elsewhere
+	return s.albumRepo.Delete(ctx, album.ID)
+}
+
+// AddImages appends images the user is allowed to manage, admins may add any image
+func (s *AlbumServiceImpl) AddImages(ctx context.Context, id, userID uuid.UUID, role domain.UserRole, imageIDs []uuid.UUID) (*domain.Album, error) {
+	images, err := s.imageRepo.FindByIDs(ctx, imageIDs)
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[uuid.UUID]bool, len(images))
+	for _, img := range images {
+		if img.CanModify(userID, role) {
+			found[img.ID] = true
+		}
+	}
+	for _, imageID := range imageIDs {
+		if !found[imageID] {
+			return nil, domain.ErrImageNotFound
+		}
+	}
+
+	return s.update(ctx, id, userID, role, func(album *domain.Album) error {
+		return album.AddImages(imageIDs...)
+	})
+}
+
+func (s *AlbumServiceImpl) RemoveImage(ctx context.Context, id, imageID, userID uuid.UUID, role domain.UserRole) (*domain.Album, error) {
+	return s.update(ctx, id, userID, role, func(album *domain.Album) error {
+		return album.RemoveImage(imageID)
+	})
+}
+
+func (s *AlbumServiceImpl) ReorderImages(ctx context.Context, id, userID uuid.UUID, role domain.UserRole, imageIDs []uuid.UUID) (*domain.Album, error) {
+	return s.update(ctx, id, userID, role, func(album *domain.Album) error {
+		return album.Reorder(imageIDs)
+	})
+}
+
+// GetPublicAlbum resolves a public album for visitors. Private or deleted images are left out,
+// a private album is reported as not found.
+func (s *AlbumServiceImpl) GetPublicAlbum(ctx context.Context, slug string) (*domain.AlbumPage, error) {
+	album, err := s.albumRepo.FindBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	if !album.IsPublic() {
+		return nil, domain.ErrAlbumNotFound
+	}
+
+	images, err := s.imageRepo.FindByIDs(ctx, album.ImageIDs)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]*domain.Image, len(images))
+	for _, img := range images {
+		if img.IsPublic() {
+			byID[img.ID] = img
+		}
+	}
+
+	page := &domain.AlbumPage{Album: album, Images: []domain.GalleryImage{}}
+	for _, imageID := range album.ImageIDs {
+		img, ok := byID[imageID]
+		if !ok {
+			continue
+		}
+		page.Images = append(page.Images, domain.NewGalleryImage(img, s.cfg.AllowedSizes))
+	}
+
+	if cover, ok := byID[album.Cover()]; ok {
+		g := domain.NewGalleryImage(cover, s.cfg.AllowedSizes)
+		page.Cover = &g
+	} else if len(page.Images) > 0 {
+		page.Cover = &page.Images[0]
+	}
+
+	return page, nil
+}
+
+// update applies edit to an album the user may modify and persists it, concurrent updates of the
+// album wait for each other so none of them is lost
+func (s *AlbumServiceImpl) update(ctx context.Context, id, userID uuid.UUID, role domain.UserRole, edit func(*domain.Album) error) (*domain.Album, error) {
+	return s.albumRepo.Edit(ctx, id, func(album *domain.Album) error {
+		if !album.CanModify(userID, role) {
+			return domain.ErrAlbumNotFound
+		}
+		return edit(album)
+	})
+}
+
+// findModifiable hides albums of other users behind ErrAlbumNotFound
+func (s *AlbumServiceImpl) findModifiable(ctx context.Context, id, userID uuid.UUID, role domain.UserRole) (*domain.Album, error) {
+	album, err := s.albumRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !album.CanModify(userID, role) {
+		return nil, domain.ErrAlbumNotFound
+	}
+	return album, nil
+}
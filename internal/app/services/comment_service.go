@@ -0,0 +1,139 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/inports"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+type CommentServiceImpl struct {
+	commentRepo outports.CommentRepository
+	postRepo    outports.PostRepository
+	userRepo    outports.UserRepository
+	mailer      outports.Mailer
+	siteURL     string
+}
+
+var _ inports.CommentService = (*CommentServiceImpl)(nil)
+
+func NewCommentService(commentRepo outports.CommentRepository, postRepo outports.PostRepository, userRepo outports.UserRepository, mailer outports.Mailer, siteURL string) *CommentServiceImpl {
+	return &CommentServiceImpl{
+		commentRepo: commentRepo,
+		postRepo:    postRepo,
+		userRepo:    userRepo,
+		mailer:      mailer,
+		siteURL:     siteURL,
+	}
+}
+
+func (s *CommentServiceImpl) ListComments(ctx context.Context, slug string) ([]*domain.CommentThread, error) {
+	post, err := s.postRepo.FindBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	if !post.IsPublic() {
+		return nil, domain.ErrPostNotFound
+	}
+	comments, err := s.commentRepo.FindByPost(ctx, post.ID)
+	if err != nil {
+		return nil, err
+	}
+	return domain.ThreadComments(comments), nil
+}
+
+func (s *CommentServiceImpl) AddComment(ctx context.Context, slug string, parentID uuid.UUID, author domain.CommentAuthor, body, honeypot string) (*domain.Comment, error) {
+	post, err := s.postRepo.FindBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	var parent *domain.Comment
+	if parentID != uuid.Nil {
+		parent, err = s.commentRepo.FindByID(ctx, parentID)
+		if errors.Is(err, domain.ErrCommentNotFound) {
+			return nil, domain.ErrInvalidReply
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Signed in readers comment under their account address
+	isAdmin := false
+	if author.UserID != uuid.Nil {
+		user, err := s.userRepo.FindByID(ctx, author.UserID)
+		if err != nil {
+			return nil, err
+		}
+		author.Email = user.Email
+		isAdmin = user.Role == domain.RoleAdmin
+	}
+
+	comment, err := domain.NewComment(post, parent, author, body)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case honeypot != "":
+		comment.Status = domain.CommentSpam
+	case isAdmin:
+		comment.Status = domain.CommentApproved
+	}
+	if err := s.commentRepo.Save(ctx, comment); err != nil {
+		return nil, err
+	}
+
+	if comment.Status != domain.CommentSpam {
+		// The comment is stored, a notification that fails is not worth failing the request
+		if err := s.notifyAuthor(ctx, post, comment); err != nil {
+			log.Printf("Notifying the author of post %s of comment %s: %v", post.ID, comment.ID, err)
+		}
+	}
+	return comment, nil
+}
+
+// notifyAuthor emails the author of the post about a new comment, unless they wrote it
+func (s *CommentServiceImpl) notifyAuthor(ctx context.Context, post *domain.Post, comment *domain.Comment) error {
+	if post.AuthorID == uuid.Nil || post.AuthorID == comment.AuthorID {
+		return nil
+	}
+	author, err := s.userRepo.FindByID(ctx, post.AuthorID)
+	if err != nil {
+		return err
+	}
+
+	text := fmt.Sprintf("%s commented on %q:\n\n%s\n\n%s#comment-%s\n",
+		comment.AuthorName, post.Title, comment.Body, domain.PostURL(s.siteURL, post.Slug), comment.ID)
+	if comment.Status == domain.CommentPending {
+		text += "\nThe comment is waiting for moderation.\n"
+	}
+	return s.mailer.Send(ctx, domain.Email{
+		To:      []string{author.Email},
+		ReplyTo: comment.AuthorEmail,
+		Subject: "New comment on " + post.Title,
+		Text:    text,
+	})
+}
+
+func (s *CommentServiceImpl) ListModerationQueue(ctx context.Context, filter domain.CommentFilter) (*domain.CommentList, error) {
+	return s.commentRepo.List(ctx, filter.Normalize())
+}
+
+func (s *CommentServiceImpl) ModerateComment(ctx context.Context, id uuid.UUID, status domain.CommentStatus) (*domain.Comment, error) {
+	comment, err := s.commentRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := comment.Moderate(status); err != nil {
+		return nil, err
+	}
+	if err := s.commentRepo.Update(ctx, comment); err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
@@ -0,0 +1,65 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/memory"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingMailer keeps the emails instead of sending them
+type recordingMailer struct {
+	sent []domain.Email
+}
+
+func (m *recordingMailer) Send(ctx context.Context, email domain.Email) error {
+	m.sent = append(m.sent, email)
+	return nil
+}
+
+func TestCommentServiceNotifiesAuthor(t *testing.T) {
+	ctx := t.Context()
+	users, posts := memory.NewUserRepository(), memory.NewPostRepository()
+	author, err := domain.NewUser("author@example.com", "password123", domain.RoleAdmin)
+	require.NoError(t, err)
+	require.NoError(t, users.Save(ctx, author))
+	post, err := domain.NewPost(author.ID, "Hello", "hello", "Body", "", domain.PostPublished, time.Time{})
+	require.NoError(t, err)
+	require.NoError(t, posts.Save(ctx, post))
+
+	mailer := &recordingMailer{}
+	service := services.NewCommentService(memory.NewCommentRepository(), posts, users, mailer, "https://example.com")
+
+	comment, err := service.AddComment(ctx, "hello", uuid.Nil, domain.CommentAuthor{Name: "Ana", Email: "ana@example.com"}, "Great read", "")
+	require.NoError(t, err)
+	assert.Equal(t, domain.CommentPending, comment.Status)
+	require.Len(t, mailer.sent, 1)
+	assert.Equal(t, []string{"author@example.com"}, mailer.sent[0].To)
+	assert.Equal(t, "ana@example.com", mailer.sent[0].ReplyTo)
+	assert.Contains(t, mailer.sent[0].Text, "https://example.com/posts/hello#comment-"+comment.ID.String())
+	assert.Contains(t, mailer.sent[0].Text, "waiting for moderation")
+
+	spam, err := service.AddComment(ctx, "hello", uuid.Nil, domain.CommentAuthor{}, "Buy now", "https://spam.example")
+	require.NoError(t, err)
+	assert.Equal(t, domain.CommentSpam, spam.Status)
+
+	// The author replying to their own post is approved and not notified
+	_, err = service.ModerateComment(ctx, comment.ID, domain.CommentApproved)
+	require.NoError(t, err)
+	reply, err := service.AddComment(ctx, "hello", comment.ID, domain.CommentAuthor{UserID: author.ID, Name: "Author"}, "Thanks", "")
+	require.NoError(t, err)
+	assert.Equal(t, domain.CommentApproved, reply.Status)
+	assert.Equal(t, "author@example.com", reply.AuthorEmail)
+	assert.Len(t, mailer.sent, 1)
+
+	threads, err := service.ListComments(ctx, "hello")
+	require.NoError(t, err)
+	require.Len(t, threads, 1)
+	assert.Equal(t, reply.ID, threads[0].Replies[0].Comment.ID)
+}
@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/inports"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+type ContactServiceImpl struct {
+	contactRepo outports.ContactRepository
+	captcha     outports.CaptchaVerifier
+	mailer      outports.Mailer
+	// recipients of the forwarded messages, none keeps them in the inbox only
+	recipients []string
+	siteURL    string
+}
+
+var _ inports.ContactService = (*ContactServiceImpl)(nil)
+
+func NewContactService(contactRepo outports.ContactRepository, captcha outports.CaptchaVerifier, mailer outports.Mailer, recipients []string, siteURL string) *ContactServiceImpl {
+	return &ContactServiceImpl{
+		contactRepo: contactRepo,
+		captcha:     captcha,
+		mailer:      mailer,
+		recipients:  recipients,
+		siteURL:     siteURL,
+	}
+}
+
+func (s *ContactServiceImpl) Submit(ctx context.Context, form domain.ContactForm, captchaToken, remoteIP, honeypot string) error {
+	if honeypot != "" {
+		return nil
+	}
+	message, err := domain.NewContactMessage(form)
+	if err != nil {
+		return err
+	}
+	// After validation, tokens can only be verified once and a typo must not spend it
+	if err := s.captcha.Verify(ctx, captchaToken, remoteIP); err != nil {
+		return err
+	}
+	if err := s.contactRepo.Save(ctx, message); err != nil {
+		return err
+	}
+
+	// The message is in the inbox, a forward that fails is not worth failing the request
+	if err := s.forward(ctx, message); err != nil {
+		log.Printf("Forwarding contact message %s: %v", message.ID, err)
+	}
+	return nil
+}
+
+func (s *ContactServiceImpl) forward(ctx context.Context, message *domain.ContactMessage) error {
+	if len(s.recipients) == 0 {
+		return nil
+	}
+	subject := "Contact from " + message.Name
+	if message.Subject != "" {
+		subject += ": " + message.Subject
+	}
+	return s.mailer.Send(ctx, domain.Email{
+		To:      s.recipients,
+		ReplyTo: message.Email,
+		Subject: subject,
+		Text: fmt.Sprintf("%s <%s> wrote through the contact form of %s:\n\n%s\n",
+			message.Name, message.Email, s.siteURL, message.Message),
+	})
+}
+
+func (s *ContactServiceImpl) ListMessages(ctx context.Context, limit, offset int) (*domain.ContactMessageList, error) {
+	page := domain.PostFilter{Limit: limit, Offset: offset}.Normalize()
+	return s.contactRepo.List(ctx, page.Limit, page.Offset)
+}
+
+func (s *ContactServiceImpl) DeleteMessage(ctx context.Context, id uuid.UUID) error {
+	return s.contactRepo.Delete(ctx, id)
+}
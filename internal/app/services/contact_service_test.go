@@ -0,0 +1,45 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/llascola/web-backend/internal/adapters/driven/captcha"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/memory"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContactServiceSubmit(t *testing.T) {
+	ctx := t.Context()
+	mailer := &recordingMailer{}
+	service := services.NewContactService(memory.NewContactRepository(), captcha.NewFake(), mailer, []string{"owner@example.com"}, "https://example.com")
+	form := domain.ContactForm{Name: " Ana ", Email: "Ana@Example.COM", Subject: "Hello", Message: "Nice site"}
+
+	require.ErrorIs(t, service.Submit(ctx, form, "wrong", "", ""), domain.ErrCaptchaFailed)
+	require.ErrorIs(t, service.Submit(ctx, domain.ContactForm{Name: "Ana"}, captcha.FakeToken, "", ""), domain.ErrInvalidContact)
+	require.NoError(t, service.Submit(ctx, form, captcha.FakeToken, "", ""))
+
+	inbox, err := service.ListMessages(ctx, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, inbox.Total)
+	assert.Equal(t, "Ana", inbox.Messages[0].Name)
+	assert.Equal(t, "Ana@example.com", inbox.Messages[0].Email)
+
+	require.Len(t, mailer.sent, 1)
+	assert.Equal(t, []string{"owner@example.com"}, mailer.sent[0].To)
+	assert.Equal(t, "Ana@example.com", mailer.sent[0].ReplyTo)
+	assert.Equal(t, "Contact from Ana: Hello", mailer.sent[0].Subject)
+	assert.Contains(t, mailer.sent[0].Text, "Nice site")
+
+	// Bots filling the honeypot get no error and leave no trace
+	require.NoError(t, service.Submit(ctx, form, "", "", "https://spam.example"))
+	inbox, err = service.ListMessages(ctx, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, inbox.Total)
+	assert.Len(t, mailer.sent, 1)
+
+	require.NoError(t, service.DeleteMessage(ctx, inbox.Messages[0].ID))
+	assert.ErrorIs(t, service.DeleteMessage(ctx, inbox.Messages[0].ID), domain.ErrContactMessageNotFound)
+}
@@ -0,0 +1,72 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/inports"
+	"github.com/llascola/web-backend/internal/app/outports"
+	"github.com/llascola/web-backend/internal/config"
+)
+
+type FeedServiceImpl struct {
+	postRepo outports.PostRepository
+	tagRepo  outports.TagRepository
+	cfg      config.FeedConfig
+	siteURL  string
+}
+
+var _ inports.FeedService = (*FeedServiceImpl)(nil)
+
+func NewFeedService(postRepo outports.PostRepository, tagRepo outports.TagRepository, cfg config.FeedConfig, siteURL string) *FeedServiceImpl {
+	return &FeedServiceImpl{
+		postRepo: postRepo,
+		tagRepo:  tagRepo,
+		cfg:      cfg,
+		siteURL:  siteURL,
+	}
+}
+
+func (s *FeedServiceImpl) Feed(ctx context.Context, tag string) (*domain.Feed, error) {
+	feed := &domain.Feed{
+		Title:       s.cfg.Title,
+		Description: s.cfg.Description,
+		Author:      s.cfg.Author,
+		SiteURL:     s.siteURL,
+		FullContent: s.cfg.FullContent,
+		Updated:     time.Unix(0, 0).UTC(),
+	}
+	if tag != "" {
+		t, err := s.tagRepo.FindBySlug(ctx, tag)
+		if err != nil {
+			return nil, err
+		}
+		feed.Title += " - " + t.Name
+	}
+
+	list, err := s.postRepo.List(ctx, domain.PostFilter{Status: domain.PostPublished, Tag: tag, Limit: s.cfg.Size}.Normalize())
+	if err != nil {
+		return nil, err
+	}
+	feed.Entries = make([]domain.FeedEntry, len(list.Posts))
+	for i, post := range list.Posts {
+		entry := domain.FeedEntry{
+			ID:        post.ID,
+			Title:     post.Title,
+			URL:       domain.PostURL(s.siteURL, post.Slug),
+			Summary:   post.Summary,
+			Tags:      post.Tags,
+			Published: post.PublishedAt,
+			Updated:   post.UpdatedAt,
+		}
+		if s.cfg.FullContent {
+			entry.HTML = post.HTML
+		}
+		feed.Entries[i] = entry
+		if post.UpdatedAt.After(feed.Updated) {
+			feed.Updated = post.UpdatedAt
+		}
+	}
+	return feed, nil
+}
@@ -1,39 +1,430 @@
 package services
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"io"
+	"slices"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/inports"
 	"github.com/llascola/web-backend/internal/app/outports"
+	"github.com/llascola/web-backend/internal/config"
 )
 
 type ImageServiceImpl struct {
-	repo outports.FileStorageRepository
+	storage    outports.FileStorageRepository
+	imageRepo  outports.ImageRepository
+	blobRepo   outports.BlobRepository
+	uploadRepo outports.UploadRepository
+	userRepo   outports.UserRepository
+	processor  outports.ImageProcessor
+	cfg        config.ImageConfig
 }
 
+var _ inports.ImageService = (*ImageServiceImpl)(nil)
+
 // NewImageService is the constructor
-func NewImageService(repo outports.FileStorageRepository) *ImageServiceImpl {
-	return &ImageServiceImpl{repo: repo}
+func NewImageService(storage outports.FileStorageRepository, imageRepo outports.ImageRepository, blobRepo outports.BlobRepository, uploadRepo outports.UploadRepository, userRepo outports.UserRepository, processor outports.ImageProcessor, cfg config.ImageConfig) *ImageServiceImpl {
+	return &ImageServiceImpl{
+		storage:    storage,
+		imageRepo:  imageRepo,
+		blobRepo:   blobRepo,
+		uploadRepo: uploadRepo,
+		userRepo:   userRepo,
+		processor:  processor,
+		cfg:        cfg,
+	}
+}
+
+// UploadImage validates the upload from its content, the client provided content type is ignored
+func (s *ImageServiceImpl) UploadImage(ctx context.Context, file io.Reader, meta outports.FileMetadata, opts domain.UploadOptions) (*domain.Image, error) {
+	limits, err := s.limitsFor(ctx, opts.UserID, opts.Role)
+	if err != nil {
+		return nil, err
+	}
+	if err := limits.CheckSize(meta.Size); err != nil {
+		return nil, err
+	}
+
+	// Read one byte past the limit to detect lying size headers
+	data, err := io.ReadAll(io.LimitReader(file, limits.FileSizeLimit()+1))
+	if err != nil {
+		return nil, err
+	}
+	if err := limits.CheckSize(int64(len(data))); err != nil {
+		return nil, err
+	}
+
+	return s.storeImage(ctx, meta.Name, data, limits, opts)
+}
+
+// CreateUpload registers the intended upload and returns it with a presigned PUT URL for the client
+func (s *ImageServiceImpl) CreateUpload(ctx context.Context, meta outports.FileMetadata, checksum string, ownerID uuid.UUID, role domain.UserRole) (*domain.Upload, string, error) {
+	upload, err := domain.NewUpload(ownerID, meta.Name, meta.ContentType, meta.Size, checksum)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := s.CheckUpload(ctx, upload.ContentType, upload.Size, ownerID, role); err != nil {
+		return nil, "", err
+	}
+
+	url, err := s.storage.PresignPut(ctx, upload.ObjectName, domain.UploadTTL)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := s.uploadRepo.Save(ctx, upload); err != nil {
+		return nil, "", err
+	}
+
+	return upload, url, nil
+}
+
+func (s *ImageServiceImpl) CheckUpload(ctx context.Context, contentType string, size int64, userID uuid.UUID, role domain.UserRole) error {
+	limits, err := s.limitsFor(ctx, userID, role)
+	if err != nil {
+		return err
+	}
+	if contentType != "" {
+		if err := limits.CheckType(contentType); err != nil {
+			return err
+		}
+	}
+	return limits.CheckSize(size)
+}
+
+// CompleteUpload verifies the object the client uploaded against what was declared and registers it as an image.
+// The staging object goes through the same validation as multipart uploads.
+func (s *ImageServiceImpl) CompleteUpload(ctx context.Context, uploadID uuid.UUID, opts domain.UploadOptions) (*domain.Image, error) {
+	upload, err := s.uploadRepo.FindByID(ctx, uploadID)
+	if err != nil {
+		return nil, err
+	}
+	if upload.OwnerID != opts.UserID {
+		return nil, domain.ErrUploadNotFound
+	}
+	if upload.Expired() {
+		return nil, domain.ErrUploadExpired
+	}
+
+	object, meta, err := s.storage.Open(ctx, upload.ObjectName)
+	if errors.Is(err, outports.ErrFileNotFound) {
+		return nil, domain.ErrUploadNotReceived
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer object.Close()
+
+	if meta.Size != upload.Size {
+		return nil, domain.ErrUploadMismatch
+	}
+
+	data, err := io.ReadAll(io.LimitReader(object, upload.Size+1))
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(data)
+	if int64(len(data)) != upload.Size || hex.EncodeToString(sum[:]) != upload.Checksum {
+		return nil, domain.ErrUploadMismatch
+	}
+
+	limits, err := s.limitsFor(ctx, opts.UserID, opts.Role)
+	if err != nil {
+		return nil, err
+	}
+	img, err := s.storeImage(ctx, upload.OriginalName, data, limits, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.storage.Delete(ctx, upload.ObjectName); err != nil {
+		return nil, err
+	}
+	if err := s.uploadRepo.Delete(ctx, upload.ID); err != nil {
+		return nil, err
+	}
+
+	return img, nil
 }
 
-func (s *ImageServiceImpl) UploadImage(ctx context.Context, file io.Reader, meta outports.FileMetadata) (string, error) {
+// storeImage validates the image bytes, strips metadata and persists both the file and its metadata.
+// Files are content addressed, an identical file already in storage is referenced instead of stored again.
+func (s *ImageServiceImpl) storeImage(ctx context.Context, originalName string, data []byte, limits domain.UploadLimits, opts domain.UploadOptions) (*domain.Image, error) {
+	info, err := s.processor.Inspect(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	// Drops what phones append to JPEGs, e.g. a motion photo video, also when the metadata is kept
+	data = data[:info.Length]
+
+	// Concurrent uploads may overshoot the quota by a few files, that is accepted over locking the user
+	usage, err := s.imageRepo.Usage(ctx, opts.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := limits.Check(info.ContentType, int64(len(data)), usage); err != nil {
+		return nil, err
+	}
+
+	// Photos leak GPS coordinates and device details through EXIF, only admins may keep them
+	oriented, err := s.processor.StripMetadata(ctx, data, s.cfg.KeepCopyright)
+	if err != nil {
+		return nil, err
+	}
+	if !opts.KeepMetadata || opts.Role != domain.RoleAdmin {
+		data = oriented
+	}
+
+	// Hash what is stored, not what was uploaded, so the same photo dedupes whatever metadata it carried
+	sum := sha256.Sum256(data)
+	img, err := domain.NewImage(originalName, info, int64(len(data)), hex.EncodeToString(sum[:]), opts.UserID, opts.Visibility)
+	if err != nil {
+		return nil, err // Returns "image size exceeds..." or "only jpeg..."
+	}
 
-	img, err := domain.NewImage(meta.Name, meta.ContentType, meta.Size)
+	// Computed on the upright pixels, also when the stored file keeps its EXIF orientation
+	img.Preview, err = s.processor.Preview(ctx, oriented)
 	if err != nil {
-		return "", err // Returns "image size exceeds..." or "only jpeg..."
+		return nil, err
+	}
+
+	blob, err := s.acquireBlob(ctx, img, data)
+	if err != nil {
+		return nil, err
+	}
+	// Private objects are not reachable by their plain URL, see ImageURL
+	if img.IsPublic() {
+		img.URL = blob.URL
+	}
+
+	if err := s.imageRepo.Save(ctx, img); err != nil {
+		return nil, errors.Join(err, s.releaseBlob(ctx, img.StoredName))
+	}
+
+	return img, nil
+}
+
+// acquireBlob references the stored file of img, uploading data only if no image has the same content yet
+func (s *ImageServiceImpl) acquireBlob(ctx context.Context, img *domain.Image, data []byte) (*domain.Blob, error) {
+	blob, err := s.blobRepo.Acquire(ctx, img.StoredName)
+	if !errors.Is(err, domain.ErrBlobNotFound) {
+		return blob, err
 	}
 
-	repoMeta := outports.FileMetadata{
+	url, err := s.storage.Save(ctx, bytes.NewReader(data), outports.FileMetadata{
 		Name:        img.StoredName,
 		Size:        img.Size,
 		ContentType: img.ContentType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	blob = domain.NewBlob(img, url)
+	err = s.blobRepo.Create(ctx, blob)
+	if errors.Is(err, domain.ErrBlobExists) {
+		// A concurrent upload of the same content registered it first, the object we wrote is identical
+		return s.blobRepo.Acquire(ctx, img.StoredName)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return blob, nil
+}
+
+// releaseBlob drops a reference and deletes the stored file once nothing references it
+func (s *ImageServiceImpl) releaseBlob(ctx context.Context, name string) error {
+	deleteFile := func() error {
+		err := s.storage.Delete(ctx, name)
+		if errors.Is(err, outports.ErrFileNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	_, err := s.blobRepo.Release(ctx, name, deleteFile)
+	// Images stored before deduplication own their file alone and have no blob record
+	if errors.Is(err, domain.ErrBlobNotFound) {
+		return deleteFile()
+	}
+	return err
+}
+
+// DeleteImage removes the image, limited to the owner and admins
+func (s *ImageServiceImpl) DeleteImage(ctx context.Context, id, userID uuid.UUID, role domain.UserRole) error {
+	img, err := s.imageRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !img.CanModify(userID, role) {
+		return domain.ErrImageNotFound
+	}
+
+	if err := s.imageRepo.Delete(ctx, img.ID); err != nil {
+		return err
+	}
+	return s.releaseBlob(ctx, img.StoredName)
+}
+
+// Quota returns the effective upload limits of the user and what they currently store
+func (s *ImageServiceImpl) Quota(ctx context.Context, userID uuid.UUID) (*domain.Quota, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	usage, err := s.imageRepo.Usage(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	limits := s.cfg.Limits[user.Role].Override(user.Limits)
+	// Report the size cap that actually applies, the ceiling included
+	limits.MaxFileSize = limits.FileSizeLimit()
+	return &domain.Quota{Limits: limits, Usage: usage}, nil
+}
+
+// limitsFor applies the per user overrides to the limits of the role
+func (s *ImageServiceImpl) limitsFor(ctx context.Context, userID uuid.UUID, role domain.UserRole) (domain.UploadLimits, error) {
+	limits := s.cfg.Limits[role]
+	if userID == uuid.Nil {
+		return limits, nil
+	}
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return domain.UploadLimits{}, err
+	}
+	return limits.Override(user.Limits), nil
+}
+
+// GetImage returns the image if the user may read it, anonymous visitors pass uuid.Nil and an empty role
+func (s *ImageServiceImpl) GetImage(ctx context.Context, id, userID uuid.UUID, role domain.UserRole) (*domain.Image, error) {
+	return s.findAccessible(ctx, id, userID, role)
+}
+
+// UpdateImage edits the description, limited to the owner and admins
+func (s *ImageServiceImpl) UpdateImage(ctx context.Context, id, userID uuid.UUID, role domain.UserRole, changes domain.ImageDescriptionChanges) (*domain.Image, error) {
+	img, err := s.imageRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !img.CanModify(userID, role) {
+		return nil, domain.ErrImageNotFound
+	}
+	if err := img.Describe(changes); err != nil {
+		return nil, err
+	}
+	if err := s.imageRepo.Update(ctx, img); err != nil {
+		return nil, err
+	}
+	return img, nil
+}
+
+// ImageURL returns where the user can download the image from.
+// Private images get a presigned URL valid for domain.SignedURLTTL, public ones their permanent URL.
+func (s *ImageServiceImpl) ImageURL(ctx context.Context, id, userID uuid.UUID, role domain.UserRole) (string, time.Time, error) {
+	img, err := s.findAccessible(ctx, id, userID, role)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if img.IsPublic() {
+		return img.URL, time.Time{}, nil
+	}
+
+	expiresAt := time.Now().Add(domain.SignedURLTTL)
+	url, err := s.storage.PresignGet(ctx, img.StoredName, domain.SignedURLTTL)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return url, expiresAt, nil
+}
+
+// OpenImage returns the original file for the authenticated proxy
+func (s *ImageServiceImpl) OpenImage(ctx context.Context, id, userID uuid.UUID, role domain.UserRole) (io.ReadCloser, outports.FileMetadata, error) {
+	img, err := s.findAccessible(ctx, id, userID, role)
+	if err != nil {
+		return nil, outports.FileMetadata{}, err
+	}
+	return s.storage.Open(ctx, img.StoredName)
+}
+
+// findAccessible hides images the user may not read behind ErrImageNotFound
+func (s *ImageServiceImpl) findAccessible(ctx context.Context, id, userID uuid.UUID, role domain.UserRole) (*domain.Image, error) {
+	img, err := s.imageRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !img.CanAccess(userID, role) {
+		return nil, domain.ErrImageNotFound
+	}
+	return img, nil
+}
+
+// TransformImage returns the image resized as described by t, generating and caching the variant on first use.
+// Sizes outside the allow-list, or a non default quality, require a valid signature.
+func (s *ImageServiceImpl) TransformImage(ctx context.Context, id uuid.UUID, t domain.ImageTransform, signature string) (io.ReadCloser, outports.FileMetadata, error) {
+	img, err := s.imageRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, outports.FileMetadata{}, err
+	}
+	// Variants are served without authentication
+	if !img.IsPublic() {
+		return nil, outports.FileMetadata{}, domain.ErrImageNotFound
 	}
 
-	uploadURL, err := s.repo.Save(ctx, file, repoMeta)
+	t, err = t.Normalize(img.ContentType)
 	if err != nil {
-		return "", err
+		return nil, outports.FileMetadata{}, err
+	}
+
+	if !s.isAllowed(t) && !t.VerifySignature(s.cfg.SigningKey, id, signature) {
+		return nil, outports.FileMetadata{}, domain.ErrTransformForbidden
+	}
+
+	cacheName := t.CacheName(id)
+
+	cached, meta, err := s.storage.Open(ctx, cacheName)
+	if err == nil {
+		meta.ETag = t.ETag(id)
+		return cached, meta, nil
+	}
+	if !errors.Is(err, outports.ErrFileNotFound) {
+		return nil, outports.FileMetadata{}, err
 	}
 
-	return uploadURL, nil
+	original, _, err := s.storage.Open(ctx, img.StoredName)
+	if err != nil {
+		return nil, outports.FileMetadata{}, err
+	}
+	defer original.Close()
+
+	var buf bytes.Buffer
+	if err := s.processor.Transform(ctx, &buf, original, t); err != nil {
+		return nil, outports.FileMetadata{}, err
+	}
+
+	meta = outports.FileMetadata{
+		Name:        cacheName,
+		Size:        int64(buf.Len()),
+		ContentType: t.ContentType(),
+	}
+	if _, err := s.storage.Save(ctx, bytes.NewReader(buf.Bytes()), meta); err != nil {
+		return nil, outports.FileMetadata{}, err
+	}
+
+	meta.ETag = t.ETag(id)
+	return io.NopCloser(&buf), meta, nil
+}
+
+// isAllowed reports whether t can be served without a signature
+func (s *ImageServiceImpl) isAllowed(t domain.ImageTransform) bool {
+	if t.Quality != 0 && t.Quality != domain.DefaultQuality {
+		return false
+	}
+	return slices.Contains(s.cfg.AllowedSizes, domain.ImageSize{Width: t.Width, Height: t.Height})
 }
@@ -0,0 +1,212 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/inports"
+	"github.com/llascola/web-backend/internal/app/outports"
+	"github.com/llascola/web-backend/internal/config"
+)
+
+type PostServiceImpl struct {
+	postRepo     outports.PostRepository
+	revisionRepo outports.PostRevisionRepository
+	renderer     outports.MarkdownRenderer
+	cfg          config.PostConfig
+	siteURL      string
+}
+
+var _ inports.PostService = (*PostServiceImpl)(nil)
+
+func NewPostService(postRepo outports.PostRepository, revisionRepo outports.PostRevisionRepository, renderer outports.MarkdownRenderer, cfg config.PostConfig, siteURL string) *PostServiceImpl {
+	return &PostServiceImpl{
+		postRepo:     postRepo,
+		revisionRepo: revisionRepo,
+		renderer:     renderer,
+		cfg:          cfg,
+		siteURL:      siteURL,
+	}
+}
+
+func (s *PostServiceImpl) CreatePost(ctx context.Context, authorID uuid.UUID, title, slug, body, excerpt string, status domain.PostStatus, publishAt time.Time, tags []string) (*domain.Post, error) {
+	post, err := domain.NewPost(authorID, title, slug, body, excerpt, status, publishAt)
+	if err != nil {
+		return nil, err
+	}
+	if err := post.Tag(tags); err != nil {
+		return nil, err
+	}
+	if err := s.render(post); err != nil {
+		return nil, err
+	}
+	if err := s.postRepo.Save(ctx, post); err != nil {
+		return nil, err
+	}
+	if err := s.revisionRepo.Save(ctx, domain.NewPostRevision(post, authorID)); err != nil {
+		return nil, err
+	}
+	return post, nil
+}
+
+func (s *PostServiceImpl) ListPosts(ctx context.Context, filter domain.PostFilter) (*domain.PostList, error) {
+	return s.postRepo.List(ctx, filter.Normalize())
+}
+
+func (s *PostServiceImpl) GetPost(ctx context.Context, id uuid.UUID) (*domain.Post, error) {
+	return s.postRepo.FindByID(ctx, id)
+}
+
+func (s *PostServiceImpl) UpdatePost(ctx context.Context, id, editorID uuid.UUID, changes domain.PostChanges) (*domain.Post, error) {
+	return s.edit(ctx, id, editorID, 0, s.applier(changes))
+}
+
+// edit changes the post and records the result as a revision, both at once. Posts created before
+// revisions were kept first get one of the content about to be replaced, its editor is unknown.
+func (s *PostServiceImpl) edit(ctx context.Context, id, editorID uuid.UUID, restoredFrom int, change func(post *domain.Post) error) (*domain.Post, error) {
+	return s.revisionRepo.Edit(ctx, id, func(post *domain.Post, count int) ([]*domain.PostRevision, error) {
+		var revisions []*domain.PostRevision
+		if count == 0 {
+			baseline := domain.NewPostRevision(post, uuid.Nil)
+			baseline.CreatedAt = post.UpdatedAt
+			revisions = append(revisions, baseline)
+		}
+		if err := change(post); err != nil {
+			return nil, err
+		}
+		revision := domain.NewPostRevision(post, editorID)
+		revision.RestoredFrom = restoredFrom
+		return append(revisions, revision), nil
+	})
+}
+
+// applier edits a post with the changes and renders its new body
+func (s *PostServiceImpl) applier(changes domain.PostChanges) func(post *domain.Post) error {
+	return func(post *domain.Post) error {
+		if err := post.Apply(changes); err != nil {
+			return err
+		}
+		return s.render(post)
+	}
+}
+
+func (s *PostServiceImpl) DeletePost(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.postRepo.FindByID(ctx, id); err != nil {
+		return err
+	}
+	return s.postRepo.Delete(ctx, id)
+}
+
+func (s *PostServiceImpl) PreviewPost(ctx context.Context, id uuid.UUID) (*domain.PostPreview, error) {
+	post, err := s.postRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	expires := time.Now().Add(s.cfg.PreviewTTL)
+	token := post.PreviewToken(s.cfg.PreviewKey, expires)
+	return &domain.PostPreview{
+		URL:       domain.PostURL(s.siteURL, post.Slug) + "?preview=" + url.QueryEscape(token),
+		Token:     token,
+		ExpiresAt: expires,
+	}, nil
+}
+
+func (s *PostServiceImpl) ListRevisions(ctx context.Context, id uuid.UUID, limit, offset int) (*domain.PostRevisionList, error) {
+	if _, err := s.postRepo.FindByID(ctx, id); err != nil {
+		return nil, err
+	}
+	page := domain.PostFilter{Limit: limit, Offset: offset}.Normalize()
+	return s.revisionRepo.List(ctx, id, page.Limit, page.Offset)
+}
+
+func (s *PostServiceImpl) GetRevision(ctx context.Context, id uuid.UUID, number int) (*domain.PostRevision, error) {
+	return s.revisionRepo.FindByNumber(ctx, id, number)
+}
+
+func (s *PostServiceImpl) DiffRevisions(ctx context.Context, id uuid.UUID, from, to int) (*domain.RevisionDiff, error) {
+	fromRev, err := s.revisionRepo.FindByNumber(ctx, id, from)
+	if err != nil {
+		return nil, err
+	}
+	toRev, err := s.revisionRepo.FindByNumber(ctx, id, to)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.RevisionDiff{From: fromRev, To: toRev, Lines: domain.DiffLines(fromRev.Body, toRev.Body)}, nil
+}
+
+func (s *PostServiceImpl) RestoreRevision(ctx context.Context, id, editorID uuid.UUID, number int) (*domain.Post, error) {
+	revision, err := s.revisionRepo.FindByNumber(ctx, id, number)
+	if err != nil {
+		return nil, err
+	}
+	return s.edit(ctx, id, editorID, number, s.applier(revision.Changes()))
+}
+
+// errNotDue skips a post that was edited after FindDue returned it and is no longer due
+var errNotDue = errors.New("post is no longer due")
+
+// PublishDue keeps going when a post fails, the failures are joined into the error.
+// The revision of a publication has no editor.
+func (s *PostServiceImpl) PublishDue(ctx context.Context) (int, error) {
+	now := time.Now()
+	posts, err := s.postRepo.FindDue(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+	var published int
+	var errs []error
+	for _, post := range posts {
+		_, err := s.edit(ctx, post.ID, uuid.Nil, 0, func(post *domain.Post) error {
+			if !post.PublishDue(now) {
+				return errNotDue
+			}
+			return nil
+		})
+		if errors.Is(err, errNotDue) {
+			continue
+		}
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		published++
+	}
+	return published, errors.Join(errs...)
+}
+
+func (s *PostServiceImpl) ListPublishedPosts(ctx context.Context, filter domain.PostFilter) (*domain.PostList, error) {
+	filter.Status = domain.PostPublished
+	return s.postRepo.List(ctx, filter.Normalize())
+}
+
+// GetPublishedPost hides unpublished posts behind ErrPostNotFound, their slugs are not public.
+// A wrong or expired preview token is no different from none. Posts are also found by the
+// slugs they had before, callers should send readers to the current one.
+func (s *PostServiceImpl) GetPublishedPost(ctx context.Context, slug, previewToken string) (*domain.Post, error) {
+	post, err := s.postRepo.FindBySlug(ctx, slug)
+	if errors.Is(err, domain.ErrPostNotFound) {
+		post, err = s.postRepo.FindByOldSlug(ctx, slug)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !post.IsPublic() && !(previewToken != "" && post.VerifyPreviewToken(s.cfg.PreviewKey, previewToken, time.Now())) {
+		return nil, domain.ErrPostNotFound
+	}
+	return post, nil
+}
+
+// render stores the HTML of the body, so reading a post never renders
+func (s *PostServiceImpl) render(post *domain.Post) error {
+	rendered, err := s.renderer.Render(post.Body)
+	if err != nil {
+		return err
+	}
+	post.Render(rendered)
+	return nil
+}
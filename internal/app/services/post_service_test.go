@@ -0,0 +1,66 @@
+package services_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/markdown"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/memory"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/services"
+	"github.com/llascola/web-backend/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Posts saved before revisions were kept get one of their old content at their first edit
+func TestPostServiceRevisionBaseline(t *testing.T) {
+	ctx := t.Context()
+	posts := memory.NewPostRepository()
+	revisions := memory.NewPostRevisionRepository(posts)
+	post, err := domain.NewPost(uuid.New(), "Hello", "hello", "Old body", "", domain.PostDraft, time.Time{})
+	require.NoError(t, err)
+	require.NoError(t, posts.Save(ctx, post))
+
+	service := services.NewPostService(posts, revisions, markdown.NewRenderer(), config.PostConfig{}, "https://example.com")
+	editor := uuid.New()
+	body := "New body"
+	_, err = service.UpdatePost(ctx, post.ID, editor, domain.PostChanges{Body: &body})
+	require.NoError(t, err)
+
+	list, err := service.ListRevisions(ctx, post.ID, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, list.Total)
+	assert.Equal(t, "New body", list.Revisions[0].Body)
+	assert.Equal(t, editor, list.Revisions[0].EditorID)
+	assert.Equal(t, "Old body", list.Revisions[1].Body)
+	assert.Equal(t, uuid.Nil, list.Revisions[1].EditorID)
+}
+
+func TestPostServicePublishDueRecordsRevision(t *testing.T) {
+	ctx := t.Context()
+	posts := memory.NewPostRepository()
+	service := services.NewPostService(posts, memory.NewPostRevisionRepository(posts), markdown.NewRenderer(), config.PostConfig{}, "https://example.com")
+	post, err := service.CreatePost(ctx, uuid.New(), "Hello", "hello", "Body", "", domain.PostScheduled, time.Now().Add(time.Hour), nil)
+	require.NoError(t, err)
+
+	published, err := service.PublishDue(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, published)
+
+	scheduled, err := posts.FindByID(ctx, post.ID)
+	require.NoError(t, err)
+	scheduled.PublishAt = time.Now().Add(-time.Minute)
+	require.NoError(t, posts.Update(ctx, scheduled))
+
+	published, err = service.PublishDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, published)
+
+	list, err := service.ListRevisions(ctx, post.ID, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, list.Total)
+	assert.Equal(t, domain.PostPublished, list.Revisions[0].Status)
+	assert.Equal(t, uuid.Nil, list.Revisions[0].EditorID)
+}
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/inports"
+	"github.com/llascola/web-backend/internal/app/outports"
+	"github.com/llascola/web-backend/internal/config"
+)
+
+type RedirectServiceImpl struct {
+	redirectRepo outports.RedirectRepository
+	cfg          config.RedirectConfig
+
+	// table is the redirect table requests read without locking, see Resolve
+	table atomic.Pointer[redirectTable]
+	// changes counts the changes made here, a table loaded before the last one is stale
+	changes atomic.Uint64
+	// loading is held by the request loading the table
+	loading sync.Mutex
+}
+
+// redirectTable is the redirect table by path, loaded again once it is CacheTTL old or after a change
+type redirectTable struct {
+	byPath   map[string]*domain.Redirect
+	changes  uint64
+	loadedAt time.Time
+}
+
+var _ inports.RedirectService = (*RedirectServiceImpl)(nil)
+
+func NewRedirectService(redirectRepo outports.RedirectRepository, cfg config.RedirectConfig) *RedirectServiceImpl {
+	return &RedirectServiceImpl{
+		redirectRepo: redirectRepo,
+		cfg:          cfg,
+	}
+}
+
+func (s *RedirectServiceImpl) ListRedirects(ctx context.Context, limit, offset int) (*domain.RedirectList, error) {
+	page := domain.PostFilter{Limit: limit, Offset: offset}.Normalize()
+	return s.redirectRepo.List(ctx, page.Limit, page.Offset)
+}
+
+func (s *RedirectServiceImpl) CreateRedirect(ctx context.Context, from, to string, code int) (*domain.Redirect, error) {
+	redirect, err := domain.NewRedirect(from, to, code)
+	if err != nil {
+		return nil, err
+	}
+	if target, ok := redirect.TargetPath(); ok {
+		back, err := s.redirectRepo.GetByPath(ctx, target)
+		switch {
+		case err == nil:
+			if path, ok := back.TargetPath(); ok && path == redirect.From {
+				return nil, domain.ErrRedirectLoop
+			}
+		case !errors.Is(err, domain.ErrRedirectNotFound):
+			return nil, err
+		}
+	}
+	if err := s.redirectRepo.Save(ctx, redirect); err != nil {
+		return nil, err
+	}
+	s.changes.Add(1)
+	return redirect, nil
+}
+
+func (s *RedirectServiceImpl) DeleteRedirect(ctx context.Context, id uuid.UUID) error {
+	if err := s.redirectRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.changes.Add(1)
+	return nil
+}
+
+// Resolve serves from the current table. Once it is stale one request loads it again while the
+// others keep using it, they only wait when there is no table yet.
+func (s *RedirectServiceImpl) Resolve(ctx context.Context, path string) (*domain.Redirect, error) {
+	table := s.table.Load()
+	if table == nil || s.stale(table) {
+		var err error
+		if table, err = s.reload(ctx, table); err != nil {
+			return nil, err
+		}
+	}
+	return table.byPath[domain.RedirectPath(path)], nil
+}
+
+func (s *RedirectServiceImpl) stale(table *redirectTable) bool {
+	return table.changes != s.changes.Load() || time.Since(table.loadedAt) >= s.cfg.CacheTTL
+}
+
+// reload loads the table unless another request is already at it. When loading fails the current
+// table is kept for another CacheTTL rather than loaded again on every request.
+func (s *RedirectServiceImpl) reload(ctx context.Context, current *redirectTable) (*redirectTable, error) {
+	if current == nil {
+		s.loading.Lock()
+	} else if !s.loading.TryLock() {
+		return current, nil
+	}
+	defer s.loading.Unlock()
+	if table := s.table.Load(); table != nil && !s.stale(table) {
+		return table, nil
+	}
+
+	changes := s.changes.Load()
+	// The table outlives the request, which may go away while it loads
+	redirects, err := s.redirectRepo.All(context.WithoutCancel(ctx))
+	if err != nil {
+		if current == nil {
+			return nil, err
+		}
+		log.Printf("Loading the redirect table, keeping the current one: %v", err)
+		table := &redirectTable{byPath: current.byPath, changes: changes, loadedAt: time.Now()}
+		s.table.Store(table)
+		return table, nil
+	}
+	table := &redirectTable{
+		byPath:   make(map[string]*domain.Redirect, len(redirects)),
+		changes:  changes,
+		loadedAt: time.Now(),
+	}
+	for _, redirect := range redirects {
+		table.byPath[redirect.From] = redirect
+	}
+	s.table.Store(table)
+	return table, nil
+}
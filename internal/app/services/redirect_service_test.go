@@ -0,0 +1,68 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/memory"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/services"
+	"github.com/llascola/web-backend/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyRedirectRepository counts the loads of the redirect table and fails them while down
+type flakyRedirectRepository struct {
+	*memory.InMemoryRedirectRepository
+	down  bool
+	loads int
+}
+
+func (r *flakyRedirectRepository) All(ctx context.Context) ([]*domain.Redirect, error) {
+	r.loads++
+	if r.down {
+		return nil, errors.New("database down")
+	}
+	return r.InMemoryRedirectRepository.All(ctx)
+}
+
+func TestRedirectServiceKeepsTableWhenLoadFails(t *testing.T) {
+	ctx := t.Context()
+	repo := &flakyRedirectRepository{InMemoryRedirectRepository: memory.NewRedirectRepository()}
+	service := services.NewRedirectService(repo, config.RedirectConfig{CacheTTL: time.Hour})
+
+	_, err := service.CreateRedirect(ctx, "/old", "/new", 0)
+	require.NoError(t, err)
+	for range 2 {
+		redirect, err := service.Resolve(ctx, "/old/")
+		require.NoError(t, err)
+		require.NotNil(t, redirect)
+		assert.Equal(t, "/new", redirect.To)
+	}
+	assert.Equal(t, 1, repo.loads)
+
+	repo.down = true
+	_, err = service.CreateRedirect(ctx, "/older", "/new", 0)
+	require.NoError(t, err)
+	for range 2 {
+		redirect, err := service.Resolve(ctx, "/old")
+		require.NoError(t, err)
+		assert.NotNil(t, redirect, "the stale table is still served")
+	}
+	assert.Equal(t, 2, repo.loads, "a failed load waits for the TTL before trying again")
+}
+
+func TestRedirectServiceRejectsLoops(t *testing.T) {
+	ctx := t.Context()
+	service := services.NewRedirectService(memory.NewRedirectRepository(), config.RedirectConfig{CacheTTL: time.Hour})
+
+	_, err := service.CreateRedirect(ctx, "/a", "/b", 0)
+	require.NoError(t, err)
+	_, err = service.CreateRedirect(ctx, "/b/", "/a?from=b", 0)
+	require.ErrorIs(t, err, domain.ErrRedirectLoop)
+	_, err = service.CreateRedirect(ctx, "/b", "/c", 0)
+	require.NoError(t, err)
+}
@@ -0,0 +1,227 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/inports"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+type ResumableUploadServiceImpl struct {
+	repo         outports.ResumableUploadRepository
+	storage      outports.FileStorageRepository
+	multipart    outports.MultipartStorage
+	imageService inports.ImageService
+}
+
+var _ inports.ResumableUploadService = (*ResumableUploadServiceImpl)(nil)
+
+func NewResumableUploadService(repo outports.ResumableUploadRepository, storage outports.FileStorageRepository, multipart outports.MultipartStorage, imageService inports.ImageService) *ResumableUploadServiceImpl {
+	return &ResumableUploadServiceImpl{
+		repo:         repo,
+		storage:      storage,
+		multipart:    multipart,
+		imageService: imageService,
+	}
+}
+
+func (s *ResumableUploadServiceImpl) Create(ctx context.Context, ownerID uuid.UUID, role domain.UserRole, length int64, metadata map[string]string) (*domain.ResumableUpload, error) {
+	upload, err := domain.NewResumableUpload(ownerID, length, metadata)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.imageService.CheckUpload(ctx, metadata["filetype"], length, ownerID, role); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Save(ctx, upload); err != nil {
+		return nil, err
+	}
+	return upload, nil
+}
+
+func (s *ResumableUploadServiceImpl) Get(ctx context.Context, id, ownerID uuid.UUID) (*domain.ResumableUpload, error) {
+	upload, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if upload.OwnerID != ownerID {
+		return nil, domain.ErrUploadNotFound
+	}
+	if upload.Expired() {
+		return nil, domain.ErrUploadExpired
+	}
+	return upload, nil
+}
+
+// Append buffers bytes until they are large enough to become a storage part.
+// The buffered tail lives in its own object so that chunks of any size survive between requests.
+func (s *ResumableUploadServiceImpl) Append(ctx context.Context, id uuid.UUID, offset int64, chunk io.Reader, opts domain.UploadOptions) (*domain.ResumableUpload, error) {
+	upload, err := s.Get(ctx, id, opts.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if offset != upload.Offset || upload.Completed() {
+		return nil, domain.ErrOffsetMismatch
+	}
+
+	// Read before locking the upload, a slow client must not hold the lock.
+	// Bytes that arrived before the connection dropped are kept, the client resumes after them.
+	remaining := upload.Length - upload.Offset
+	var received bytes.Buffer
+	n, readErr := io.Copy(&received, io.LimitReader(chunk, remaining+1))
+	if n > remaining {
+		return nil, domain.ErrUploadOverflow
+	}
+	if n == 0 {
+		if readErr != nil {
+			return nil, readErr
+		}
+		return upload, nil
+	}
+
+	movedTail := false
+	upload, err = s.repo.Edit(ctx, id, func(upload *domain.ResumableUpload) error {
+		// A concurrent request may have written at this offset since it was checked
+		if offset != upload.Offset || upload.Completed() {
+			return domain.ErrOffsetMismatch
+		}
+		movedTail, err = s.write(ctx, upload, received.Bytes())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Only once the row no longer points at the tail, otherwise a failed update loses its bytes
+	if movedTail {
+		if err := s.storage.Delete(ctx, upload.TailName()); err != nil {
+			log.Printf("Deleting the tail of upload %s: %v", upload.ID, err)
+		}
+	}
+
+	// A drop after the last byte still leaves a whole file
+	final := upload.Completed()
+	if readErr != nil && !final {
+		return nil, readErr
+	}
+
+	if final {
+		return s.complete(ctx, upload, opts)
+	}
+	return upload, nil
+}
+
+// write stores data after the bytes received so far, as a new part or in the tail, and advances the offset.
+// It reports whether the tail went into the part, it is deleted once the upload no longer counts it.
+func (s *ResumableUploadServiceImpl) write(ctx context.Context, upload *domain.ResumableUpload, data []byte) (bool, error) {
+	var buf bytes.Buffer
+	tailSize := upload.TailSize()
+	if tailSize > 0 {
+		tail, _, err := s.storage.Open(ctx, upload.TailName())
+		if err != nil {
+			return false, err
+		}
+		_, err = io.Copy(&buf, tail)
+		tail.Close()
+		if err != nil {
+			return false, err
+		}
+	}
+	buf.Write(data)
+
+	if upload.MultipartID == "" {
+		id, err := s.multipart.CreateMultipart(ctx, upload.ObjectName, "application/octet-stream")
+		if err != nil {
+			return false, err
+		}
+		upload.MultipartID = id
+	}
+
+	movedTail := false
+	final := upload.Offset+int64(len(data)) == upload.Length
+	if final || buf.Len() >= outports.MultipartMinPartSize {
+		part, err := s.multipart.UploadPart(ctx, upload.ObjectName, upload.MultipartID, len(upload.Parts)+1, bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		if err != nil {
+			return false, err
+		}
+		upload.Parts = append(upload.Parts, part)
+		movedTail = tailSize > 0
+	} else {
+		if _, err := s.storage.Save(ctx, bytes.NewReader(buf.Bytes()), outports.FileMetadata{
+			Name:        upload.TailName(),
+			Size:        int64(buf.Len()),
+			ContentType: "application/octet-stream",
+		}); err != nil {
+			return false, err
+		}
+	}
+
+	upload.Offset += int64(len(data))
+	upload.ExpiresAt = time.Now().Add(domain.ResumableUploadTTL)
+	return movedTail, nil
+}
+
+// complete assembles the parts and hands the file to the image validation pipeline
+func (s *ResumableUploadServiceImpl) complete(ctx context.Context, upload *domain.ResumableUpload, opts domain.UploadOptions) (*domain.ResumableUpload, error) {
+	if err := s.multipart.CompleteMultipart(ctx, upload.ObjectName, upload.MultipartID, upload.Parts); err != nil {
+		return nil, err
+	}
+
+	file, _, err := s.storage.Open(ctx, upload.ObjectName)
+	if err != nil {
+		return nil, err
+	}
+	opts.Visibility = domain.Visibility(upload.Metadata["visibility"])
+	img, err := s.imageService.UploadImage(ctx, file, outports.FileMetadata{
+		Name:        upload.Metadata["filename"],
+		Size:        upload.Length,
+		ContentType: upload.Metadata["filetype"],
+	}, opts)
+	file.Close()
+
+	// The assembled object is only a staging copy, the image service stored its own
+	if delErr := s.storage.Delete(ctx, upload.ObjectName); delErr != nil && err == nil {
+		err = delErr
+	}
+	if err != nil {
+		// A complete upload cannot be resumed, drop it so the client starts over
+		return nil, errors.Join(err, s.repo.Delete(ctx, upload.ID))
+	}
+
+	upload.ImageID = img.ID
+	if err := s.repo.Update(ctx, upload); err != nil {
+		return nil, err
+	}
+	return upload, nil
+}
+
+func (s *ResumableUploadServiceImpl) Terminate(ctx context.Context, id, ownerID uuid.UUID) error {
+	upload, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if upload.OwnerID != ownerID {
+		return domain.ErrUploadNotFound
+	}
+
+	if upload.MultipartID != "" && !upload.Completed() {
+		if err := s.multipart.AbortMultipart(ctx, upload.ObjectName, upload.MultipartID); err != nil {
+			return err
+		}
+	}
+	if upload.TailSize() > 0 {
+		if err := s.storage.Delete(ctx, upload.TailName()); err != nil {
+			return err
+		}
+	}
+
+	return s.repo.Delete(ctx, upload.ID)
+}
@@ -0,0 +1,136 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"testing/iotest"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/imaging"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/memory"
+	"github.com/llascola/web-backend/internal/adapters/driven/storage"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/services"
+	"github.com/llascola/web-backend/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errConnectionReset = errors.New("connection reset")
+
+// newResumableUploadService returns the service on memory adapters and a member bound by limits
+func newResumableUploadService(t *testing.T, limits domain.UploadLimits) (*services.ResumableUploadServiceImpl, *storage.MemoryAdapter, uuid.UUID) {
+	store := storage.NewMemoryAdapter("http://storage", []byte("secret"))
+	users := memory.NewUserRepository()
+	member, err := domain.NewUser("member@example.com", "password123", domain.RoleMember)
+	require.NoError(t, err)
+	require.NoError(t, users.Save(context.Background(), member))
+
+	images := services.NewImageService(store, memory.NewImageRepository(), memory.NewBlobRepository(), memory.NewUploadRepository(), users, imaging.NewProcessor(), config.ImageConfig{
+		Limits: map[domain.UserRole]domain.UploadLimits{domain.RoleMember: limits},
+	})
+	return services.NewResumableUploadService(memory.NewResumableUploadRepository(), store, store, images), store, member.ID
+}
+
+func TestResumableUploadCreateChecksLimits(t *testing.T) {
+	ctx := context.Background()
+	service, _, owner := newResumableUploadService(t, domain.UploadLimits{MaxFileSize: 1000, AllowedTypes: []string{"image/png"}})
+
+	_, err := service.Create(ctx, owner, domain.RoleMember, 1001, nil)
+	assert.ErrorIs(t, err, domain.ErrImageTooLarge)
+	_, err = service.Create(ctx, owner, domain.RoleMember, 1000, map[string]string{"filetype": "image/jpeg"})
+	assert.ErrorIs(t, err, domain.ErrContentTypeNotAllowed)
+	_, err = service.Create(ctx, owner, domain.RoleMember, 1000, map[string]string{"filetype": "image/png"})
+	assert.NoError(t, err)
+}
+
+func TestResumableUploadKeepsBytesOfDroppedChunk(t *testing.T) {
+	ctx := context.Background()
+	service, store, owner := newResumableUploadService(t, domain.UploadLimits{})
+
+	upload, err := service.Create(ctx, owner, domain.RoleMember, 100, nil)
+	require.NoError(t, err)
+
+	// The connection drops after 40 of the 100 bytes
+	chunk := io.MultiReader(strings.NewReader(strings.Repeat("a", 40)), iotest.ErrReader(errConnectionReset))
+	_, err = service.Append(ctx, upload.ID, 0, chunk, domain.UploadOptions{UserID: owner})
+	assert.ErrorIs(t, err, errConnectionReset)
+
+	upload, err = service.Get(ctx, upload.ID, owner)
+	require.NoError(t, err)
+	assert.Equal(t, int64(40), upload.Offset)
+	assert.Equal(t, int64(40), upload.TailSize())
+
+	_, err = service.Append(ctx, upload.ID, 0, strings.NewReader("b"), domain.UploadOptions{UserID: owner})
+	assert.ErrorIs(t, err, domain.ErrOffsetMismatch)
+
+	upload, err = service.Append(ctx, upload.ID, 40, strings.NewReader(strings.Repeat("b", 20)), domain.UploadOptions{UserID: owner})
+	require.NoError(t, err)
+	assert.Equal(t, int64(60), upload.Offset)
+
+	tail, _, err := store.Open(ctx, upload.TailName())
+	require.NoError(t, err)
+	defer tail.Close()
+	data, err := io.ReadAll(tail)
+	require.NoError(t, err)
+	assert.Equal(t, strings.Repeat("a", 40)+strings.Repeat("b", 20), string(data))
+}
+
+// barrierReader holds its data back until every reader sharing the barrier is being read
+type barrierReader struct {
+	io.Reader
+	barrier *sync.WaitGroup
+	once    sync.Once
+}
+
+func (r *barrierReader) Read(p []byte) (int, error) {
+	r.once.Do(func() {
+		r.barrier.Done()
+		r.barrier.Wait()
+	})
+	return r.Reader.Read(p)
+}
+
+func TestResumableUploadConcurrentAppendsAtSameOffset(t *testing.T) {
+	ctx := context.Background()
+	service, store, owner := newResumableUploadService(t, domain.UploadLimits{})
+
+	upload, err := service.Create(ctx, owner, domain.RoleMember, 100, nil)
+	require.NoError(t, err)
+
+	// Both requests pass the offset check before either writes
+	var barrier, done sync.WaitGroup
+	barrier.Add(2)
+	errs := make([]error, 2)
+	for i, b := range []string{"a", "b"} {
+		done.Add(1)
+		go func() {
+			defer done.Done()
+			chunk := &barrierReader{Reader: strings.NewReader(strings.Repeat(b, 30)), barrier: &barrier}
+			_, errs[i] = service.Append(ctx, upload.ID, 0, chunk, domain.UploadOptions{UserID: owner})
+		}()
+	}
+	done.Wait()
+
+	if errs[0] == nil {
+		assert.ErrorIs(t, errs[1], domain.ErrOffsetMismatch)
+	} else {
+		assert.ErrorIs(t, errs[0], domain.ErrOffsetMismatch)
+		assert.NoError(t, errs[1])
+	}
+
+	upload, err = service.Get(ctx, upload.ID, owner)
+	require.NoError(t, err)
+	assert.Equal(t, int64(30), upload.Offset)
+
+	tail, _, err := store.Open(ctx, upload.TailName())
+	require.NoError(t, err)
+	defer tail.Close()
+	data, err := io.ReadAll(tail)
+	require.NoError(t, err)
+	assert.Contains(t, []string{strings.Repeat("a", 30), strings.Repeat("b", 30)}, string(data))
+}
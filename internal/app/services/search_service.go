@@ -0,0 +1,27 @@
+package services
+
+import (
+	"context"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/inports"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+type SearchServiceImpl struct {
+	searchRepo outports.SearchRepository
+}
+
+var _ inports.SearchService = (*SearchServiceImpl)(nil)
+
+func NewSearchService(searchRepo outports.SearchRepository) *SearchServiceImpl {
+	return &SearchServiceImpl{searchRepo: searchRepo}
+}
+
+func (s *SearchServiceImpl) Search(ctx context.Context, q string, limit, offset int) (*domain.SearchResults, error) {
+	query, err := domain.NewSearchQuery(q, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return s.searchRepo.Search(ctx, query)
+}
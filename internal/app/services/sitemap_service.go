@@ -0,0 +1,98 @@
+package services
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/inports"
+	"github.com/llascola/web-backend/internal/app/outports"
+	"github.com/llascola/web-backend/internal/config"
+)
+
+type SitemapServiceImpl struct {
+	postRepo  outports.PostRepository
+	albumRepo outports.AlbumRepository
+	cfg       config.SitemapConfig
+	siteURL   string
+
+	// urls are the pages of the last generation, reused until it is CacheTTL old
+	mu          sync.Mutex
+	urls        []domain.SitemapURL
+	generatedAt time.Time
+}
+
+var _ inports.SitemapService = (*SitemapServiceImpl)(nil)
+
+func NewSitemapService(postRepo outports.PostRepository, albumRepo outports.AlbumRepository, cfg config.SitemapConfig, siteURL string) *SitemapServiceImpl {
+	return &SitemapServiceImpl{
+		postRepo:  postRepo,
+		albumRepo: albumRepo,
+		cfg:       cfg,
+		siteURL:   siteURL,
+	}
+}
+
+func (s *SitemapServiceImpl) Sitemap(ctx context.Context, page int) (*domain.Sitemap, error) {
+	urls, err := s.pages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return domain.NewSitemap(urls, s.cfg.Size, page, func(page int) string {
+		return s.siteURL + "/sitemap.xml?page=" + strconv.Itoa(page)
+	})
+}
+
+func (s *SitemapServiceImpl) Robots() domain.Robots {
+	return domain.Robots{
+		Disallow: s.cfg.Disallow,
+		Sitemap:  s.siteURL + "/sitemap.xml",
+	}
+}
+
+// pages returns the URLs of the site, generated again when the cached ones are too old.
+// The lock is held while generating so concurrent requests wait for one generation.
+func (s *SitemapServiceImpl) pages(ctx context.Context) ([]domain.SitemapURL, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.urls != nil && time.Since(s.generatedAt) < s.cfg.CacheTTL {
+		return s.urls, nil
+	}
+
+	urls := make([]domain.SitemapURL, 0, len(s.cfg.Pages))
+	for _, path := range s.cfg.Pages {
+		if !strings.HasPrefix(path, "/") {
+			path = "/" + path
+		}
+		urls = append(urls, domain.SitemapURL{Loc: s.siteURL + path})
+	}
+
+	filter := domain.PostFilter{Status: domain.PostPublished, Limit: domain.MaxPostsPage}
+	for {
+		list, err := s.postRepo.List(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		for _, post := range list.Posts {
+			urls = append(urls, domain.SitemapURL{Loc: domain.PostURL(s.siteURL, post.Slug), LastMod: post.UpdatedAt})
+		}
+		filter.Offset += len(list.Posts)
+		if len(list.Posts) == 0 || filter.Offset >= list.Total {
+			break
+		}
+	}
+
+	albums, err := s.albumRepo.FindPublic(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, album := range albums {
+		urls = append(urls, domain.SitemapURL{Loc: domain.AlbumURL(s.siteURL, album.Slug), LastMod: album.UpdatedAt})
+	}
+
+	s.urls, s.generatedAt = urls, time.Now()
+	return urls, nil
+}
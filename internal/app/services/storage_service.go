@@ -0,0 +1,356 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/inports"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+type StorageServiceImpl struct {
+	storage             outports.ObjectStore
+	multipart           outports.MultipartStorage
+	imageRepo           outports.ImageRepository
+	blobRepo            outports.BlobRepository
+	uploadRepo          outports.UploadRepository
+	resumableUploadRepo outports.ResumableUploadRepository
+}
+
+var _ inports.StorageService = (*StorageServiceImpl)(nil)
+
+func NewStorageService(storage outports.ObjectStore, multipart outports.MultipartStorage, imageRepo outports.ImageRepository, blobRepo outports.BlobRepository, uploadRepo outports.UploadRepository, resumableUploadRepo outports.ResumableUploadRepository) *StorageServiceImpl {
+	return &StorageServiceImpl{
+		storage:             storage,
+		multipart:           multipart,
+		imageRepo:           imageRepo,
+		blobRepo:            blobRepo,
+		uploadRepo:          uploadRepo,
+		resumableUploadRepo: resumableUploadRepo,
+	}
+}
+
+func (s *StorageServiceImpl) Migrate(ctx context.Context, dest outports.ObjectStore, opts domain.MigrationOptions, progress func(domain.MigrationReport)) (*domain.MigrationReport, error) {
+	report := &domain.MigrationReport{}
+	err := s.storage.List(ctx, opts.Prefix, opts.StartAfter, func(obj outports.ObjectInfo) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		copied, err := s.migrateObject(ctx, dest, obj, opts.DryRun)
+		if err == nil && opts.RewriteURLs && !opts.DryRun {
+			err = s.rewriteURLs(ctx, dest, obj.Name, report)
+		}
+		switch {
+		case err != nil:
+			report.Failures = append(report.Failures, domain.ObjectFailure{Name: obj.Name, Err: err})
+		case copied:
+			report.Copied++
+			report.Bytes += obj.Size
+		default:
+			report.Skipped++
+		}
+
+		report.Last = obj.Name
+		if progress != nil {
+			progress(*report)
+		}
+		return nil
+	})
+	return report, err
+}
+
+// migrateObject copies one object unless dest already holds the same bytes, and reports whether it copied
+func (s *StorageServiceImpl) migrateObject(ctx context.Context, dest outports.ObjectStore, obj outports.ObjectInfo, dryRun bool) (bool, error) {
+	existing, err := stat(ctx, dest, obj.Name)
+	switch {
+	case errors.Is(err, outports.ErrFileNotFound):
+	case err != nil:
+		return false, err
+	case existing.Size == obj.Size:
+		// Same size is not proof enough, a previous run may have died halfway through a write
+		sum, err := checksum(ctx, s.storage, obj.Name)
+		if err != nil {
+			return false, err
+		}
+		destSum, err := checksum(ctx, dest, obj.Name)
+		if err != nil {
+			return false, err
+		}
+		if bytes.Equal(sum, destSum) {
+			return false, nil
+		}
+	}
+	if dryRun {
+		return true, nil
+	}
+
+	file, meta, err := s.storage.Open(ctx, obj.Name)
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := dest.Save(ctx, io.TeeReader(file, hash), outports.FileMetadata{
+		Name:        obj.Name,
+		Size:        meta.Size,
+		ContentType: meta.ContentType,
+	}); err != nil {
+		return false, err
+	}
+
+	// Read the copy back, a truncated or corrupted write must not count as migrated
+	destSum, err := checksum(ctx, dest, obj.Name)
+	if err != nil {
+		return false, err
+	}
+	if !bytes.Equal(hash.Sum(nil), destSum) {
+		return false, errors.Join(domain.ErrChecksumMismatch, dest.Delete(ctx, obj.Name))
+	}
+	return true, nil
+}
+
+// rewriteURLs points the records of an object at its copy in dest, objects that are not images are left alone
+func (s *StorageServiceImpl) rewriteURLs(ctx context.Context, dest outports.ObjectStore, name string, report *domain.MigrationReport) error {
+	url := dest.URL(name)
+	// Private objects are not reachable by their plain URL, see ImageService.ImageURL
+	n, err := s.imageRepo.UpdateURL(ctx, name, domain.VisibilityPublic, url)
+	if err != nil {
+		return err
+	}
+	report.URLsRewritten += n
+
+	if err := s.blobRepo.UpdateURL(ctx, name, url); err != nil && !errors.Is(err, domain.ErrBlobNotFound) {
+		return err
+	}
+	return nil
+}
+
+// CollectGarbage reconciles storage with the records. Objects nothing refers to are orphans, e.g. files of
+// failed uploads or cached variants of deleted images, and are deleted once older than the grace period.
+func (s *StorageServiceImpl) CollectGarbage(ctx context.Context, opts domain.GCOptions) (*domain.GCReport, error) {
+	report := &domain.GCReport{}
+	now := time.Now()
+	if !opts.DryRun {
+		if err := s.expireUploads(ctx, now, report); err != nil {
+			return report, err
+		}
+	}
+
+	images, err := s.imageRepo.FindAll(ctx)
+	if err != nil {
+		return report, err
+	}
+	blobs, err := s.blobRepo.FindAll(ctx)
+	if err != nil {
+		return report, err
+	}
+	// expected holds the objects that must exist, seen marks the ones found
+	expected := make(map[string]bool, len(images))
+	imageIDs := make(map[uuid.UUID]bool, len(images))
+	for _, img := range images {
+		expected[img.StoredName] = false
+		imageIDs[img.ID] = true
+	}
+	for _, blob := range blobs {
+		expected[blob.Name] = false
+	}
+
+	cutoff := now.Add(-opts.GracePeriod)
+	err = s.storage.List(ctx, "", "", func(obj outports.ObjectInfo) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		report.Scanned++
+		if _, ok := expected[obj.Name]; ok {
+			expected[obj.Name] = true
+			return nil
+		}
+		if id, ok := domain.CachedImageID(obj.Name); ok && imageIDs[id] {
+			return nil
+		}
+		if ttl, ok := domain.StagingTTL(obj.Name); ok && obj.LastModified.After(now.Add(-ttl)) {
+			return nil
+		}
+
+		report.Orphans = append(report.Orphans, obj.Name)
+		report.OrphanBytes += obj.Size
+		if opts.DryRun || obj.LastModified.After(cutoff) {
+			return nil
+		}
+		if err := s.storage.Delete(ctx, obj.Name); err != nil {
+			report.Failures = append(report.Failures, domain.ObjectFailure{Name: obj.Name, Err: err})
+			return nil
+		}
+		report.Deleted++
+		report.DeletedBytes += obj.Size
+		return nil
+	})
+	if err != nil {
+		return report, err
+	}
+
+	for name, seen := range expected {
+		if !seen {
+			report.Missing = append(report.Missing, name)
+		}
+	}
+	sort.Strings(report.Missing)
+	return report, nil
+}
+
+// RelocateLegacyImages copies every root level image file under its prefix before pointing the records at
+// the copy, a run that dies halfway leaves the old file in place and is simply repeated.
+func (s *StorageServiceImpl) RelocateLegacyImages(ctx context.Context) (*domain.RelocationReport, error) {
+	report := &domain.RelocationReport{}
+	images, err := s.imageRepo.FindAll(ctx)
+	if err != nil {
+		return report, err
+	}
+
+	// Images sharing a file are relocated together, the file is deleted once none of them refers to it
+	var names []string
+	shared := make(map[string][]*domain.Image)
+	for _, img := range images {
+		if _, ok := img.LegacyStoredName(); !ok {
+			continue
+		}
+		if _, seen := shared[img.StoredName]; !seen {
+			names = append(names, img.StoredName)
+		}
+		shared[img.StoredName] = append(shared[img.StoredName], img)
+	}
+
+	for _, name := range names {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+		moved, err := s.relocate(ctx, name, shared[name])
+		report.Moved += moved
+		if err != nil {
+			report.Failures = append(report.Failures, domain.ObjectFailure{Name: name, Err: err})
+		}
+	}
+	return report, nil
+}
+
+// relocate copies the file under the prefix of each visibility its images have, so public and private
+// images sharing it each get their own copy, and deletes it once every image points at a copy
+func (s *StorageServiceImpl) relocate(ctx context.Context, oldName string, images []*domain.Image) (int, error) {
+	moved := 0
+	done := make(map[domain.Visibility]bool)
+	for _, img := range images {
+		if done[img.Visibility] {
+			continue
+		}
+		name, _ := img.LegacyStoredName()
+		url, err := s.copyObject(ctx, oldName, name)
+		if err != nil {
+			return moved, err
+		}
+
+		// Private objects are not reachable by their plain URL, see ImageService.ImageURL
+		if !img.IsPublic() {
+			url = ""
+		}
+		n, err := s.imageRepo.Rename(ctx, oldName, img.Visibility, name, url)
+		if err != nil {
+			return moved, err
+		}
+		moved += n
+		done[img.Visibility] = true
+	}
+
+	err := s.storage.Delete(ctx, oldName)
+	if errors.Is(err, outports.ErrFileNotFound) {
+		return moved, nil
+	}
+	return moved, err
+}
+
+// copyObject copies a stored object to another name and checks the copy by its SHA-256
+func (s *StorageServiceImpl) copyObject(ctx context.Context, from, to string) (string, error) {
+	file, meta, err := s.storage.Open(ctx, from)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	url, err := s.storage.Save(ctx, io.TeeReader(file, hash), outports.FileMetadata{
+		Name:        to,
+		Size:        meta.Size,
+		ContentType: meta.ContentType,
+	})
+	if err != nil {
+		return "", err
+	}
+	sum, err := checksum(ctx, s.storage, to)
+	if err != nil {
+		return "", err
+	}
+	if !bytes.Equal(hash.Sum(nil), sum) {
+		return "", errors.Join(domain.ErrChecksumMismatch, s.storage.Delete(ctx, to))
+	}
+	return url, nil
+}
+
+// expireUploads removes the upload records past their expiry, their staging objects become orphans.
+// Unfinished multipart uploads are not listed as objects and are aborted here instead.
+func (s *StorageServiceImpl) expireUploads(ctx context.Context, now time.Time, report *domain.GCReport) error {
+	n, err := s.uploadRepo.DeleteExpired(ctx, now)
+	if err != nil {
+		return err
+	}
+	report.ExpiredUploads += n
+
+	expired, err := s.resumableUploadRepo.FindExpired(ctx, now)
+	if err != nil {
+		return err
+	}
+	for _, upload := range expired {
+		if upload.MultipartID != "" && !upload.Completed() {
+			if err := s.multipart.AbortMultipart(ctx, upload.ObjectName, upload.MultipartID); err != nil {
+				report.Failures = append(report.Failures, domain.ObjectFailure{Name: upload.ObjectName, Err: err})
+				continue
+			}
+		}
+		if err := s.resumableUploadRepo.Delete(ctx, upload.ID); err != nil {
+			return err
+		}
+		report.ExpiredUploads++
+	}
+	return nil
+}
+
+func stat(ctx context.Context, store outports.FileStorageRepository, name string) (outports.FileMetadata, error) {
+	file, meta, err := store.Open(ctx, name)
+	if err != nil {
+		return outports.FileMetadata{}, err
+	}
+	file.Close()
+	return meta, nil
+}
+
+// checksum is the SHA-256 of a stored object
+func checksum(ctx context.Context, store outports.FileStorageRepository, name string) ([]byte, error) {
+	file, _, err := store.Open(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return nil, err
+	}
+	return hash.Sum(nil), nil
+}
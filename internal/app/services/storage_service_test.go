@@ -0,0 +1,189 @@
+package services_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/llascola/web-backend/internal/adapters/driven/repository/memory"
+	"github.com/llascola/web-backend/internal/adapters/driven/storage"
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/outports"
+	"github.com/llascola/web-backend/internal/app/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageServiceMigrate(t *testing.T) {
+	ctx := context.Background()
+	source := storage.NewMemoryAdapter("http://old/storage", []byte("secret"))
+	dest, err := storage.NewFilesystemAdapter(t.TempDir(), "http://new/storage", []byte("secret"))
+	require.NoError(t, err)
+
+	images := memory.NewImageRepository()
+	for _, name := range []string{"public/a.png", "public/b.png"} {
+		url, err := source.Save(ctx, strings.NewReader("data of "+name), outports.FileMetadata{Name: name})
+		require.NoError(t, err)
+		require.NoError(t, images.Save(ctx, &domain.Image{ID: uuid.New(), Visibility: domain.VisibilityPublic, StoredName: name, URL: url}))
+	}
+	private := &domain.Image{ID: uuid.New(), Visibility: domain.VisibilityPrivate, StoredName: "private/c.png"}
+	require.NoError(t, images.Save(ctx, private))
+	_, err = source.Save(ctx, strings.NewReader("data of private/c.png"), outports.FileMetadata{Name: "private/c.png"})
+	require.NoError(t, err)
+	service := services.NewStorageService(source, source, images, memory.NewBlobRepository(), memory.NewUploadRepository(), memory.NewResumableUploadRepository())
+
+	report, err := service.Migrate(ctx, dest, domain.MigrationOptions{DryRun: true}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 3, report.Copied)
+	_, _, err = dest.Open(ctx, "public/a.png")
+	assert.ErrorIs(t, err, outports.ErrFileNotFound)
+
+	// An interrupted run leaves a partial copy behind
+	_, err = dest.Save(ctx, strings.NewReader("data of"), outports.FileMetadata{Name: "private/c.png"})
+	require.NoError(t, err)
+	report, err = service.Migrate(ctx, dest, domain.MigrationOptions{StartAfter: "private/c.png"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.Copied)
+	assert.Equal(t, "public/b.png", report.Last)
+
+	report, err = service.Migrate(ctx, dest, domain.MigrationOptions{RewriteURLs: true}, nil)
+	require.NoError(t, err)
+	assert.Empty(t, report.Failures)
+	assert.Equal(t, 1, report.Copied)
+	assert.Equal(t, 2, report.Skipped)
+	assert.Equal(t, 2, report.URLsRewritten)
+	img, err := images.FindByID(ctx, private.ID)
+	require.NoError(t, err)
+	assert.Empty(t, img.URL, "private images are only served through signed URLs")
+
+	f, _, err := dest.Open(ctx, "private/c.png")
+	require.NoError(t, err)
+	defer f.Close()
+	buf := new(strings.Builder)
+	_, _ = io.Copy(buf, f)
+	assert.Equal(t, "data of private/c.png", buf.String())
+}
+
+func TestStorageServiceCollectGarbage(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewMemoryAdapter("http://localhost/storage", []byte("secret"))
+	images := memory.NewImageRepository()
+	resumableUploads := memory.NewResumableUploadRepository()
+	service := services.NewStorageService(store, store, images, memory.NewBlobRepository(), memory.NewUploadRepository(), resumableUploads)
+
+	kept := &domain.Image{ID: uuid.New(), StoredName: "public/kept.png"}
+	require.NoError(t, images.Save(ctx, kept))
+	require.NoError(t, images.Save(ctx, &domain.Image{ID: uuid.New(), StoredName: "public/missing.png"}))
+	for _, name := range []string{
+		"public/kept.png",
+		"public/orphan.png",
+		"cache/" + kept.ID.String() + "/w320.png",
+		"cache/" + uuid.NewString() + "/w320.png",
+		"uploads/" + uuid.NewString() + ".png",
+	} {
+		_, err := store.Save(ctx, strings.NewReader(name), outports.FileMetadata{Name: name})
+		require.NoError(t, err)
+	}
+
+	upload, err := domain.NewResumableUpload(uuid.New(), 10, nil)
+	require.NoError(t, err)
+	upload.ExpiresAt = time.Now().Add(-time.Minute)
+	require.NoError(t, resumableUploads.Save(ctx, upload))
+
+	report, err := service.CollectGarbage(ctx, domain.GCOptions{GracePeriod: time.Hour})
+	require.NoError(t, err)
+	assert.Equal(t, 5, report.Scanned)
+	assert.Len(t, report.Orphans, 2)
+	assert.Zero(t, report.Deleted, "orphans are younger than the grace period")
+	assert.Equal(t, []string{"public/missing.png"}, report.Missing)
+	assert.Equal(t, 1, report.ExpiredUploads)
+	_, err = resumableUploads.FindByID(ctx, upload.ID)
+	assert.ErrorIs(t, err, domain.ErrUploadNotFound)
+
+	report, err = service.CollectGarbage(ctx, domain.GCOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.Deleted)
+	_, _, err = store.Open(ctx, "public/orphan.png")
+	assert.ErrorIs(t, err, outports.ErrFileNotFound)
+	_, _, err = store.Open(ctx, "public/kept.png")
+	assert.NoError(t, err)
+}
+
+func TestStorageServiceRelocateLegacyImages(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewMemoryAdapter("http://localhost/storage", []byte("secret"))
+	images := memory.NewImageRepository()
+	service := services.NewStorageService(store, store, images, memory.NewBlobRepository(), memory.NewUploadRepository(), memory.NewResumableUploadRepository())
+
+	legacy := &domain.Image{ID: uuid.New(), Visibility: domain.VisibilityPublic, StoredName: "legacy.png", URL: store.URL("legacy.png")}
+	current := &domain.Image{ID: uuid.New(), Visibility: domain.VisibilityPublic, StoredName: "public/current.png"}
+	for _, img := range []*domain.Image{legacy, current} {
+		require.NoError(t, images.Save(ctx, img))
+		_, err := store.Save(ctx, strings.NewReader("data of "+img.StoredName), outports.FileMetadata{Name: img.StoredName})
+		require.NoError(t, err)
+	}
+
+	report, err := service.RelocateLegacyImages(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, report.Failures)
+	assert.Equal(t, 1, report.Moved)
+
+	img, err := images.FindByID(ctx, legacy.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "public/legacy.png", img.StoredName)
+	assert.Equal(t, store.URL("public/legacy.png"), img.URL)
+	_, _, err = store.Open(ctx, "legacy.png")
+	assert.ErrorIs(t, err, outports.ErrFileNotFound)
+	f, _, err := store.Open(ctx, "public/legacy.png")
+	require.NoError(t, err)
+	defer f.Close()
+	data, _ := io.ReadAll(f)
+	assert.Equal(t, "data of legacy.png", string(data))
+
+	report, err = service.RelocateLegacyImages(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, report.Moved)
+}
+
+func TestStorageServiceRelocateSharedLegacyFile(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewMemoryAdapter("http://localhost/storage", []byte("secret"))
+	images := memory.NewImageRepository()
+	service := services.NewStorageService(store, store, images, memory.NewBlobRepository(), memory.NewUploadRepository(), memory.NewResumableUploadRepository())
+
+	// One file behind a public and a private image
+	public := &domain.Image{ID: uuid.New(), Visibility: domain.VisibilityPublic, StoredName: "shared.png", URL: store.URL("shared.png")}
+	private := &domain.Image{ID: uuid.New(), Visibility: domain.VisibilityPrivate, StoredName: "shared.png", URL: store.URL("shared.png")}
+	for _, img := range []*domain.Image{public, private} {
+		require.NoError(t, images.Save(ctx, img))
+	}
+	_, err := store.Save(ctx, strings.NewReader("shared data"), outports.FileMetadata{Name: "shared.png"})
+	require.NoError(t, err)
+
+	report, err := service.RelocateLegacyImages(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, report.Failures)
+	assert.Equal(t, 2, report.Moved)
+
+	img, err := images.FindByID(ctx, public.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "public/shared.png", img.StoredName)
+	assert.Equal(t, store.URL("public/shared.png"), img.URL)
+	img, err = images.FindByID(ctx, private.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "private/shared.png", img.StoredName)
+	assert.Empty(t, img.URL)
+
+	for _, name := range []string{"public/shared.png", "private/shared.png"} {
+		f, _, err := store.Open(ctx, name)
+		require.NoError(t, err, name)
+		data, _ := io.ReadAll(f)
+		f.Close()
+		assert.Equal(t, "shared data", string(data))
+	}
+	_, _, err = store.Open(ctx, "shared.png")
+	assert.ErrorIs(t, err, outports.ErrFileNotFound)
+}
@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+	"slices"
+
+	"github.com/llascola/web-backend/internal/app/domain"
+	"github.com/llascola/web-backend/internal/app/inports"
+	"github.com/llascola/web-backend/internal/app/outports"
+)
+
+type TagServiceImpl struct {
+	tagRepo outports.TagRepository
+}
+
+var _ inports.TagService = (*TagServiceImpl)(nil)
+
+func NewTagService(tagRepo outports.TagRepository) *TagServiceImpl {
+	return &TagServiceImpl{tagRepo: tagRepo}
+}
+
+func (s *TagServiceImpl) ListTags(ctx context.Context, includeUnused bool) ([]domain.TagCount, error) {
+	counts, err := s.tagRepo.Counts(ctx)
+	if err != nil || includeUnused {
+		return counts, err
+	}
+	// Tags of drafts and private images only, visitors would find nothing behind them
+	return slices.DeleteFunc(counts, func(c domain.TagCount) bool {
+		return c.Posts == 0 && c.Images == 0
+	}), nil
+}
+
+func (s *TagServiceImpl) RenameTag(ctx context.Context, slug, name string) (*domain.Tag, error) {
+	tag, err := domain.NewTag(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.tagRepo.Rename(ctx, slug, tag); err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
+
+func (s *TagServiceImpl) MergeTags(ctx context.Context, sources []string, target string) error {
+	// The repository checks that every source exists by counting them, a repeated source would miss
+	sources = slices.Compact(slices.Sorted(slices.Values(sources)))
+	if len(sources) == 0 || slices.Contains(sources, target) {
+		return domain.ErrInvalidMerge
+	}
+	return s.tagRepo.Merge(ctx, sources, target)
+}
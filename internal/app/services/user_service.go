@@ -28,3 +28,20 @@ func (s *UserServiceImpl) GetProfile(ctx context.Context, userID uuid.UUID) (*do
 func (s *UserServiceImpl) DeleteUser(ctx context.Context, userID uuid.UUID) error {
 	return s.userRepo.Delete(ctx, userID)
 }
+
+// SetUploadLimits replaces the per user overrides, zero values fall back to the role limits
+func (s *UserServiceImpl) SetUploadLimits(ctx context.Context, userID uuid.UUID, limits domain.UploadLimits) (*domain.User, error) {
+	if err := limits.Validate(); err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	user.Limits = limits
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
@@ -2,8 +2,22 @@ package config
 
 import (
 	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/llascola/web-backend/internal/app/domain"
 )
 
+const defaultAllowedSizes = "320x0,640x0,1280x0,1920x0"
+
+// Upload limits per role, admins are only bound by domain.MaxImageSize
+const (
+	defaultMemberLimits = "max_size=5MB,max_total=500MB,max_files=1000"
+	defaultAdminLimits  = ""
+)
+
+// defaultPublicPolicy only exposes the public/ prefix, private images stay behind signed URLs
 const defaultPublicPolicy = `{
     "Version": "2012-10-17",
     "Statement": [
@@ -11,19 +25,36 @@ const defaultPublicPolicy = `{
             "Effect": "Allow",
             "Principal": {"AWS": ["*"]},
             "Action": ["s3:GetObject"],
-            "Resource": ["arn:aws:s3:::%s/*"]
+            "Resource": ["arn:aws:s3:::%s/public/*"]
         }
     ]
 }`
 
 type MinIOConfig struct {
-	Endpoint  string
-	AccessKey string
-	SecretKey string
-	RootUser  string
-	RootPass  string
-	Bucket    string
-	Policy    string
+	Endpoint string
+	// PublicEndpoint is the host used in presigned URLs, defaults to Endpoint
+	PublicEndpoint string
+	AccessKey      string
+	SecretKey      string
+	RootUser       string
+	RootPass       string
+	Bucket         string
+	Policy         string
+}
+
+type StorageConfig struct {
+	// Driver selects the storage adapter, "minio" (default) or "fs"
+	Driver string
+	// Root is the directory the fs driver stores files in
+	Root string
+	// BaseURL is where the router serves the fs storage routes
+	BaseURL string
+	// SigningKey signs fs download and upload URLs. Empty uses a random key, URLs then die with the process.
+	SigningKey []byte
+	// GCInterval is how often serve collects orphaned objects, zero disables the job
+	GCInterval time.Duration
+	// GCGracePeriod spares orphans younger than this, their upload may still be in flight
+	GCGracePeriod time.Duration
 }
 
 type PostgresConfig struct {
@@ -35,11 +66,119 @@ type PostgresConfig struct {
 	SSLMode  string
 }
 
+type ImageConfig struct {
+	// SigningKey verifies signed transformation URLs. Empty disables signed URLs.
+	SigningKey []byte
+	// AllowedSizes can be requested without a signature
+	AllowedSizes []domain.ImageSize
+	// KeepCopyright preserves copyright and author when stripping metadata
+	KeepCopyright bool
+	// Limits are the upload limits of each role, users may override them individually
+	Limits map[domain.UserRole]domain.UploadLimits
+}
+
+type PostConfig struct {
+	// PreviewKey signs the preview links of unpublished posts. Empty uses a random key, links then die with the process.
+	PreviewKey []byte
+	// PreviewTTL is how long a preview link works
+	PreviewTTL time.Duration
+	// SchedulerInterval is how often serve publishes the scheduled posts that are due, zero disables the job
+	SchedulerInterval time.Duration
+}
+
+type FeedConfig struct {
+	Title       string
+	Description string
+	// Author is credited for every post, feeds readers show it when entries name nobody
+	Author string
+	// FullContent puts whole posts in the feeds, otherwise only their summary
+	FullContent bool
+	// Size is how many of the latest posts a feed lists
+	Size int
+}
+
+type SitemapConfig struct {
+	// Pages are the paths of the static pages of the site, e.g. / and /about
+	Pages []string
+	// Size is how many URLs a sitemap holds before the site is split behind a sitemap index
+	Size int
+	// CacheTTL is how long a generated sitemap is reused before posts and albums are read again
+	CacheTTL time.Duration
+	// Disallow are the path prefixes robots.txt keeps crawlers out of, "/" hides the whole site
+	Disallow []string
+}
+
+type SearchConfig struct {
+	// Language is the Postgres text search configuration, e.g. english to match word stems.
+	// simple only lowercases, which suits a site written in several languages.
+	Language string
+}
+
+// MailConfig is the SMTP server emails are sent through, they are only logged without a host
+type MailConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	// From is the sender, e.g. "Blog <blog@example.com>"
+	From string
+}
+
+type CommentConfig struct {
+	// RateLimit is how many comments a client IP may post per RateWindow, 0 disables the limit
+	RateLimit  int
+	RateWindow time.Duration
+}
+
+type ContactConfig struct {
+	// To are the addresses contact messages are forwarded to, without any they stay in the admin inbox
+	To []string
+	// RateLimit is how many messages a client IP may send per RateWindow, 0 disables the limit
+	RateLimit  int
+	RateWindow time.Duration
+}
+
+// CaptchaConfig selects who checks the challenges of the contact form
+type CaptchaConfig struct {
+	// Provider is turnstile, hcaptcha or recaptcha. fake only accepts the token of the fake
+	// verifier, for tests and local development. Empty disables the captcha.
+	Provider string
+	Secret   string
+}
+
+type RedirectConfig struct {
+	// CacheTTL is how long the redirect table is served from memory, changes made through
+	// other instances take up to this long to apply
+	CacheTTL time.Duration
+}
+
+// ModeMemory runs the application on in-memory repositories and storage, nothing survives a restart
+const ModeMemory = "memory"
+
 type Config struct {
+	// Mode is ModeMemory for a development server without Postgres or MinIO, empty otherwise
+	Mode string
+	// SiteURL is the public address of the site, links handed out to readers start with it
+	SiteURL     string
+	Storage     StorageConfig
 	MinIO       MinIOConfig
 	Postgres    PostgresConfig
+	Images      ImageConfig
+	Posts       PostConfig
+	Feeds       FeedConfig
+	Sitemap     SitemapConfig
+	Search      SearchConfig
+	Mail        MailConfig
+	Comments    CommentConfig
+	Contact     ContactConfig
+	Captcha     CaptchaConfig
+	Redirects   RedirectConfig
 	JWTKeys     map[string]JWTKey
 	ActiveKeyID string
+
+	// TrustedProxies are the addresses or CIDRs allowed to set X-Forwarded-For. Empty trusts none,
+	// clients are then told apart by the address they connect from, e.g. for rate limits.
+	TrustedProxies []string
 }
 
 type JWTKey struct {
@@ -48,20 +187,40 @@ type JWTKey struct {
 }
 
 func Load() *Config {
+	// MINIO_POLICY=none leaves the bucket policy untouched
 	policy := os.Getenv("MINIO_POLICY")
-	if policy == "" {
+	switch policy {
+	case "":
 		policy = defaultPublicPolicy
+	case "none":
+		policy = ""
 	}
 
 	keyID := os.Getenv("JWT_KEY_ID")
 
+	allowedSizes := os.Getenv("IMAGE_ALLOWED_SIZES")
+	if allowedSizes == "" {
+		allowedSizes = defaultAllowedSizes
+	}
+
 	return &Config{
+		Mode:    os.Getenv("APP_MODE"),
+		SiteURL: strings.TrimSuffix(getEnv("SITE_URL", "http://localhost:8080"), "/"),
+		Storage: StorageConfig{
+			Driver:        getEnv("STORAGE_DRIVER", "minio"),
+			Root:          getEnv("STORAGE_FS_ROOT", "./data"),
+			BaseURL:       getEnv("STORAGE_BASE_URL", "http://localhost:8080/storage"),
+			SigningKey:    []byte(os.Getenv("STORAGE_SIGNING_KEY")),
+			GCInterval:    getDuration("STORAGE_GC_INTERVAL", 0),
+			GCGracePeriod: getDuration("STORAGE_GC_GRACE", 24*time.Hour),
+		},
 		MinIO: MinIOConfig{
-			Endpoint: os.Getenv("MINIO_ENDPOINT"),
-			RootUser: os.Getenv("MINIO_ROOT_USER"),
-			RootPass: os.Getenv("MINIO_ROOT_PASSWORD"),
-			Bucket:   os.Getenv("MINIO_BUCKET"),
-			Policy:   policy,
+			Endpoint:       os.Getenv("MINIO_ENDPOINT"),
+			PublicEndpoint: os.Getenv("MINIO_PUBLIC_ENDPOINT"),
+			RootUser:       os.Getenv("MINIO_ROOT_USER"),
+			RootPass:       os.Getenv("MINIO_ROOT_PASSWORD"),
+			Bucket:         os.Getenv("MINIO_BUCKET"),
+			Policy:         policy,
 		},
 		Postgres: PostgresConfig{
 			Host:     os.Getenv("POSTGRES_HOST"),
@@ -71,6 +230,59 @@ func Load() *Config {
 			DBName:   os.Getenv("POSTGRES_DB"),
 			SSLMode:  os.Getenv("POSTGRES_SSLMODE"),
 		},
+		Images: ImageConfig{
+			SigningKey:    []byte(os.Getenv("IMAGE_SIGNING_KEY")),
+			AllowedSizes:  parseSizes(allowedSizes),
+			KeepCopyright: os.Getenv("IMAGE_KEEP_COPYRIGHT") == "true",
+			Limits: map[domain.UserRole]domain.UploadLimits{
+				domain.RoleMember: parseLimits(getEnv("IMAGE_LIMITS_MEMBER", defaultMemberLimits)),
+				domain.RoleAdmin:  parseLimits(getEnv("IMAGE_LIMITS_ADMIN", defaultAdminLimits)),
+			},
+		},
+		Posts: PostConfig{
+			PreviewKey:        []byte(os.Getenv("POST_PREVIEW_KEY")),
+			PreviewTTL:        getDuration("POST_PREVIEW_TTL", 7*24*time.Hour),
+			SchedulerInterval: getDuration("POST_SCHEDULER_INTERVAL", time.Minute),
+		},
+		Feeds: FeedConfig{
+			Title:       getEnv("FEED_TITLE", "Blog"),
+			Description: os.Getenv("FEED_DESCRIPTION"),
+			Author:      os.Getenv("FEED_AUTHOR"),
+			FullContent: getEnv("FEED_CONTENT", "full") != "excerpt",
+			Size:        getInt("FEED_SIZE", 20),
+		},
+		Sitemap: SitemapConfig{
+			Pages:    parseList(getEnv("SITEMAP_PAGES", "/")),
+			Size:     getInt("SITEMAP_SIZE", domain.MaxSitemapURLs),
+			CacheTTL: getDuration("SITEMAP_CACHE_TTL", 10*time.Minute),
+			Disallow: parseList(getEnv("ROBOTS_DISALLOW", "/api/,/auth/,/storage/signed/")),
+		},
+		Search: SearchConfig{
+			Language: getEnv("SEARCH_LANGUAGE", "simple"),
+		},
+		Mail: MailConfig{
+			Host:     os.Getenv("SMTP_HOST"),
+			Port:     getInt("SMTP_PORT", 587),
+			Username: os.Getenv("SMTP_USERNAME"),
+			Password: os.Getenv("SMTP_PASSWORD"),
+			From:     getEnv("MAIL_FROM", "web-backend <noreply@localhost>"),
+		},
+		Comments: CommentConfig{
+			RateLimit:  getInt("COMMENT_RATE_LIMIT", 5),
+			RateWindow: getDuration("COMMENT_RATE_WINDOW", 10*time.Minute),
+		},
+		Contact: ContactConfig{
+			To:         parseList(os.Getenv("CONTACT_TO")),
+			RateLimit:  getInt("CONTACT_RATE_LIMIT", 3),
+			RateWindow: getDuration("CONTACT_RATE_WINDOW", time.Hour),
+		},
+		Captcha: CaptchaConfig{
+			Provider: os.Getenv("CAPTCHA_PROVIDER"),
+			Secret:   os.Getenv("CAPTCHA_SECRET"),
+		},
+		Redirects: RedirectConfig{
+			CacheTTL: getDuration("REDIRECT_CACHE_TTL", time.Minute),
+		},
 
 		JWTKeys: map[string]JWTKey{
 			keyID: {
@@ -78,6 +290,99 @@ func Load() *Config {
 				Algorithm: os.Getenv("JWT_ALGORITHM"),
 			},
 		},
-		ActiveKeyID: keyID,
+		ActiveKeyID:    keyID,
+		TrustedProxies: parseList(os.Getenv("TRUSTED_PROXIES")),
+	}
+}
+
+// parseSizes reads a comma separated list of WIDTHxHEIGHT pairs, skipping malformed entries
+func parseSizes(list string) []domain.ImageSize {
+	var sizes []domain.ImageSize
+	for _, entry := range strings.Split(list, ",") {
+		w, h, ok := strings.Cut(strings.TrimSpace(entry), "x")
+		if !ok {
+			continue
+		}
+		width, errW := strconv.Atoi(w)
+		height, errH := strconv.Atoi(h)
+		if errW != nil || errH != nil {
+			continue
+		}
+		sizes = append(sizes, domain.ImageSize{Width: width, Height: height})
+	}
+	return sizes
+}
+
+// parseList reads a comma separated list, skipping empty entries
+func parseList(list string) []string {
+	var entries []string
+	for _, entry := range strings.Split(list, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// getEnv returns the variable, or fallback when it is unset. Set but empty is kept, it may mean "no limits".
+func getEnv(key, fallback string) string {
+	if value, ok := os.LookupEnv(key); ok {
+		return value
+	}
+	return fallback
+}
+
+// getDuration reads a time.ParseDuration value, fallback when it is unset or malformed
+func getDuration(key string, fallback time.Duration) time.Duration {
+	d, err := time.ParseDuration(os.Getenv(key))
+	if err != nil {
+		return fallback
+	}
+	return d
+}
+
+// getInt reads an integer, fallback when it is unset or malformed
+func getInt(key string, fallback int) int {
+	n, err := strconv.Atoi(os.Getenv(key))
+	if err != nil {
+		return fallback
+	}
+	return n
+}
+
+// parseLimits reads "max_size=5MB,max_total=1GB,max_files=100,types=image/jpeg|image/png",
+// every key is optional and malformed entries are skipped
+func parseLimits(list string) domain.UploadLimits {
+	var limits domain.UploadLimits
+	for _, entry := range strings.Split(list, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "max_size":
+			limits.MaxFileSize, _ = parseBytes(value)
+		case "max_total":
+			limits.MaxTotalBytes, _ = parseBytes(value)
+		case "max_files":
+			limits.MaxFiles, _ = strconv.Atoi(value)
+		case "types":
+			limits.AllowedTypes = strings.Split(value, "|")
+		}
+	}
+	return limits
+}
+
+// parseBytes reads a byte count with an optional KB, MB or GB suffix (powers of 1024)
+func parseBytes(value string) (int64, error) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	multiplier := int64(1)
+	for suffix, m := range map[string]int64{"KB": 1 << 10, "MB": 1 << 20, "GB": 1 << 30} {
+		if strings.HasSuffix(value, suffix) {
+			value, multiplier = strings.TrimSuffix(value, suffix), m
+			break
+		}
 	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	return n * multiplier, err
 }